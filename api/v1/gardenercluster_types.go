@@ -62,13 +62,35 @@ type Shoot struct {
 // Kubeconfig defines the desired kubeconfig location
 type Kubeconfig struct {
 	Secret Secret `json:"secret"`
+	// AdditionalSecrets are further secrets the kubeconfig is written to, they are rotated together with Secret and removed on deletion.
+	// The name of every additional secret must be set, the namespace of Secret is used when the namespace is not set.
+	// Additional secrets are supported in the kcp-system namespace only.
+	// +optional
+	AdditionalSecrets []Secret `json:"additionalSecrets,omitempty"`
+	// Managed controls whether the controller creates and rotates the kubeconfig secrets, it is true when not set.
+	// Set it to false when the kubeconfig is managed by another system, the finalizer and the cleanup on deletion are still handled.
+	// +optional
+	Managed *bool `json:"managed,omitempty"`
+}
+
+// IsManaged returns whether the controller manages the kubeconfig secrets
+func (k Kubeconfig) IsManaged() bool {
+	return k.Managed == nil || *k.Managed
 }
 
 // SecretKeyRef defines the location, and structure of the secret containing kubeconfig
 type Secret struct {
-	Name      string `json:"name"`
-	Namespace string `json:"namespace"`
-	Key       string `json:"key"`
+	// Name of the secret, the secret name template of the controller is used when not set.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Namespace of the secret, the default namespace of the controller is used when not set.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Key of the kubeconfig in the secret data.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key"`
 }
 
 type State string
@@ -88,6 +110,11 @@ const (
 	ConditionReasonFailedToDeleteSecret    ConditionReason = "ConditionReasonFailedToDeleteSecret"
 	ConditionReasonFailedToUpdateSecret    ConditionReason = "FailedToUpdateSecret"
 	ConditionReasonFailedToGetKubeconfig   ConditionReason = "FailedToGetKubeconfig"
+	ConditionReasonSecretNamespaceNotSet   ConditionReason = "SecretNamespaceNotSet"
+	ConditionReasonSecretNameNotSet        ConditionReason = "SecretNameNotSet"
+	ConditionReasonSecretKeyInvalid        ConditionReason = "SecretKeyInvalid"
+	ConditionReasonAdditionalSecretInvalid ConditionReason = "AdditionalSecretInvalid"
+	ConditionReasonManagementDisabled      ConditionReason = "KubeconfigManagementDisabled"
 )
 
 type ConditionType string
@@ -107,6 +134,28 @@ type GardenerClusterStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// KubeconfigExpiration is the time when the kubeconfig stored in the secret expires.
+	// +optional
+	KubeconfigExpiration *metav1.Time `json:"kubeconfigExpiration,omitempty"`
+
+	// LastSuccessfulSyncTime is the time of the last reconciliation that wrote the kubeconfig secret or the additional secrets without an error.
+	// +optional
+	LastSuccessfulSyncTime *metav1.Time `json:"lastSuccessfulSyncTime,omitempty"`
+
+	// ConsecutiveFailureCount is the number of reconciliations that failed since the last successful one.
+	// +optional
+	ConsecutiveFailureCount int `json:"consecutiveFailureCount,omitempty"`
+}
+
+// GetConditions returns the conditions of the GardenerCluster status
+func (cluster *GardenerCluster) GetConditions() []metav1.Condition {
+	return cluster.Status.Conditions
+}
+
+// SetConditions replaces the conditions of the GardenerCluster status
+func (cluster *GardenerCluster) SetConditions(conditions []metav1.Condition) {
+	cluster.Status.Conditions = conditions
 }
 
 func (cluster *GardenerCluster) UpdateConditionForReadyState(conditionType ConditionType, reason ConditionReason, conditionStatus metav1.ConditionStatus) {
@@ -118,6 +167,7 @@ func (cluster *GardenerCluster) UpdateConditionForReadyState(conditionType Condi
 		LastTransitionTime: metav1.Now(),
 		Reason:             string(reason),
 		Message:            getMessage(reason),
+		ObservedGeneration: cluster.Generation,
 	}
 	meta.RemoveStatusCondition(&cluster.Status.Conditions, condition.Type)
 	meta.SetStatusCondition(&cluster.Status.Conditions, condition)
@@ -132,6 +182,7 @@ func (cluster *GardenerCluster) UpdateConditionForErrorState(conditionType Condi
 		LastTransitionTime: metav1.Now(),
 		Reason:             string(reason),
 		Message:            fmt.Sprintf("%s Error: %s", getMessage(reason), error.Error()),
+		ObservedGeneration: cluster.Generation,
 	}
 	meta.RemoveStatusCondition(&cluster.Status.Conditions, condition.Type)
 	meta.SetStatusCondition(&cluster.Status.Conditions, condition)
@@ -143,6 +194,8 @@ func getMessage(reason ConditionReason) string {
 		return "Secret created successfully."
 	case ConditionReasonKubeconfigSecretRotated:
 		return "Secret rotated successfully."
+	case ConditionReasonManagementDisabled:
+		return "Kubeconfig management disabled."
 	case ConditionReasonFailedToCreateSecret:
 		return "Failed to create secret."
 	case ConditionReasonFailedToUpdateSecret:
@@ -151,6 +204,14 @@ func getMessage(reason ConditionReason) string {
 		return "Failed to get secret."
 	case ConditionReasonFailedToGetKubeconfig:
 		return "Failed to get kubeconfig."
+	case ConditionReasonSecretNamespaceNotSet:
+		return "Secret namespace not set."
+	case ConditionReasonSecretNameNotSet:
+		return "Secret name not set."
+	case ConditionReasonSecretKeyInvalid:
+		return "Secret key invalid."
+	case ConditionReasonAdditionalSecretInvalid:
+		return "Additional secret invalid."
 
 	default:
 		return "Unknown condition"
@@ -21,6 +21,7 @@ import (
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	registrycache "github.com/kyma-project/kim-snatch/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -35,6 +36,8 @@ import (
 const (
 	Finalizer                              = "runtime-controller.infrastructure-manager.kyma-project.io/deletion-hook"
 	AnnotationGardenerCloudDelConfirmation = "confirmation.gardener.cloud/deletion"
+	// DefaultKubeconfigSecretKey is the key of the kubeconfig in the secret data of the GardenerCluster when the Runtime does not specify it
+	DefaultKubeconfigSecretKey = "config"
 )
 
 const (
@@ -62,12 +65,15 @@ const (
 type RuntimeConditionType string
 
 const (
-	ConditionTypeRuntimeProvisioned      RuntimeConditionType = "Provisioned"
-	ConditionTypeRuntimeKubeconfigReady  RuntimeConditionType = "KubeconfigReady"
-	ConditionTypeOidcAndCMsConfigured    RuntimeConditionType = "OidcAndConfigMapConfigured"
-	ConditionTypeRuntimeConfigured       RuntimeConditionType = "Configured"
-	ConditionTypeRuntimeDeprovisioned    RuntimeConditionType = "Deprovisioned"
-	ConditionTypeRegistryCacheConfigured RuntimeConditionType = "RegistryCacheConfigured"
+	ConditionTypeRuntimeProvisioned        RuntimeConditionType = "Provisioned"
+	ConditionTypeRuntimeKubeconfigReady    RuntimeConditionType = "KubeconfigReady"
+	ConditionTypeOidcAndCMsConfigured      RuntimeConditionType = "OidcAndConfigMapConfigured"
+	ConditionTypeRuntimeConfigured         RuntimeConditionType = "Configured"
+	ConditionTypeRuntimeDeprovisioned      RuntimeConditionType = "Deprovisioned"
+	ConditionTypeRegistryCacheConfigured   RuntimeConditionType = "RegistryCacheConfigured"
+	ConditionTypeKubernetesVersionExpiring RuntimeConditionType = "KubernetesVersionExpiring"
+	ConditionTypeAuditLog                  RuntimeConditionType = "AuditLogConfigured"
+	ConditionTypePlacement                 RuntimeConditionType = "Placement"
 )
 
 type RuntimeConditionReason string
@@ -88,20 +94,34 @@ const (
 	ConditionReasonGardenerCRDeleted       = RuntimeConditionReason("GardenerClusterCRDeleted")
 	ConditionReasonGardenerShootDeleted    = RuntimeConditionReason("GardenerShootDeleted")
 	ConditionReasonStructuredConfigDeleted = RuntimeConditionReason("StructuredConfigDeleted")
+	ConditionReasonDeletionProtected       = RuntimeConditionReason("DeletionProtected")
 	ConditionReasonConversionError         = RuntimeConditionReason("ConversionErr")
 	ConditionReasonCreationError           = RuntimeConditionReason("CreationErr")
 	ConditionReasonGardenerError           = RuntimeConditionReason("GardenerErr")
+	ConditionReasonGardenerRateLimited     = RuntimeConditionReason("GardenerRateLimited")
 	ConditionReasonKubernetesAPIErr        = RuntimeConditionReason("KubernetesErr")
+	ConditionReasonOperationTimeout        = RuntimeConditionReason("OperationTimeout")
+	ConditionReasonImmutableFieldChanged   = RuntimeConditionReason("ImmutableFieldChanged")
+	ConditionReasonHAChangeBlocked         = RuntimeConditionReason("HighAvailabilityChangeBlocked")
+
+	ConditionReasonAuditLogError      = RuntimeConditionReason("AuditLogErr")
+	ConditionReasonAuditLogConfigured = RuntimeConditionReason("AuditLogConfigured")
+	ConditionReasonAuditLogSkipped    = RuntimeConditionReason("AuditLogSkipped")
+
+	ConditionReasonAdministratorsConfigured  = RuntimeConditionReason("AdministratorsConfigured")
+	ConditionReasonOidcAndCMsConfigured      = RuntimeConditionReason("OidcAndConfigMapsConfigured")
+	ConditionReasonOidcError                 = RuntimeConditionReason("OidcConfigurationErr")
+	ConditionReasonKymaSystemNSError         = RuntimeConditionReason("KymaSystemNSError")
+	ConditionReasonNetworkPoliciesError      = RuntimeConditionReason("NetworkPoliciesError")
+	ConditionReasonSeedNotFound              = RuntimeConditionReason("SeedNotFound")
+	ConditionReasonGardenerNamespaceNotFound = RuntimeConditionReason("GardenerNamespaceNotFound")
 
-	ConditionReasonAuditLogError = RuntimeConditionReason("AuditLogErr")
+	ConditionReasonRegistryCacheConfigured = RuntimeConditionReason("RegistryCacheConfigured")
 
-	ConditionReasonAdministratorsConfigured = RuntimeConditionReason("AdministratorsConfigured")
-	ConditionReasonOidcAndCMsConfigured     = RuntimeConditionReason("OidcAndConfigMapsConfigured")
-	ConditionReasonOidcError                = RuntimeConditionReason("OidcConfigurationErr")
-	ConditionReasonKymaSystemNSError        = RuntimeConditionReason("KymaSystemNSError")
-	ConditionReasonSeedNotFound             = RuntimeConditionReason("SeedNotFound")
+	ConditionReasonKubernetesVersionExpiring  = RuntimeConditionReason("KubernetesVersionExpiring")
+	ConditionReasonKubernetesVersionSupported = RuntimeConditionReason("KubernetesVersionSupported")
 
-	ConditionReasonRegistryCacheConfigured = RuntimeConditionReason("RegistryCacheConfigured")
+	ConditionReasonPlacementReported = RuntimeConditionReason("PlacementReported")
 
 	ConditionReasonRegistryCacheError                            = RuntimeConditionReason("RegistryCacheError")
 	ConditionReasonRegistryCacheGardenClusterConfigurationFailed = RuntimeConditionReason("RegistryCacheGardenClusterConfigurationFailed")
@@ -114,6 +134,7 @@ const (
 //+kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.shoot.region"
 //+kubebuilder:printcolumn:name="STATE",type=string,JSONPath=`.status.state`
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+//+kubebuilder:storageversion
 
 // Runtime is the Schema for the runtimes API
 type Runtime struct {
@@ -165,26 +186,146 @@ type RuntimeStatus struct {
 	ShootLastOperation *gardener.LastOperation `json:"shootLastOperation,omitempty" protobuf:"bytes,5,opt,name=lastOperation"`
 
 	// LastError indicates the last occurred error for an operation on a Gardener's `shoot` resource.
-	ShootLastErrors    []gardener.LastError    `json:"shootLastErrors,omitempty" protobuf:"bytes,6,rep,name=lastErrors"`
+	ShootLastErrors []gardener.LastError `json:"shootLastErrors,omitempty" protobuf:"bytes,6,rep,name=lastErrors"`
+
+	// ShootCredentialsRotation indicates the phases of the credentials rotations of Gardener's `shoot`.
+	ShootCredentialsRotation *CredentialsRotationStatus `json:"shootCredentialsRotation,omitempty"`
+}
+
+// CredentialsRotationStatus contains the phases of the credentials rotations of Gardener's `shoot`, the phase is empty when the rotation was never started.
+type CredentialsRotationStatus struct {
+	CertificateAuthorities gardener.CredentialsRotationPhase `json:"certificateAuthorities,omitempty"`
+	ServiceAccountKey      gardener.CredentialsRotationPhase `json:"serviceAccountKey,omitempty"`
+	ETCDEncryptionKey      gardener.CredentialsRotationPhase `json:"etcdEncryptionKey,omitempty"`
 }
 
 type RuntimeShoot struct {
-	Name                string                 `json:"name"`
-	Purpose             gardener.ShootPurpose  `json:"purpose"`
-	PlatformRegion      string                 `json:"platformRegion"`
-	Region              string                 `json:"region"`
-	LicenceType         *string                `json:"licenceType,omitempty"`
-	SecretBindingName   string                 `json:"secretBindingName"`
+	Name           string                `json:"name"`
+	Purpose        gardener.ShootPurpose `json:"purpose"`
+	PlatformRegion string                `json:"platformRegion"`
+	Region         string                `json:"region"`
+	LicenceType    *string               `json:"licenceType,omitempty"`
+	// +optional
+	SecretBindingName   string                 `json:"secretBindingName,omitempty"`
 	EnforceSeedLocation *bool                  `json:"enforceSeedLocation,omitempty"`
+	CloudProfileName    *string                `json:"cloudProfileName,omitempty"`
 	Kubernetes          Kubernetes             `json:"kubernetes,omitempty"`
 	Provider            Provider               `json:"provider"`
 	Networking          Networking             `json:"networking"`
 	ControlPlane        *gardener.ControlPlane `json:"controlPlane,omitempty"`
+	Addons              *Addons                `json:"addons,omitempty"`
+	SystemComponents    *SystemComponents      `json:"systemComponents,omitempty"`
+	DNSEntries          *DNSEntries            `json:"dnsEntries,omitempty"`
+	// FeatureFlags enables experimental features of the converter for this Runtime only.
+	FeatureFlags map[string]bool `json:"featureFlags,omitempty"`
+	// KubeconfigSecretKey is the key of the kubeconfig in the secret data of the GardenerCluster, `config` is used when not set.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	KubeconfigSecretKey string `json:"kubeconfigSecretKey,omitempty"`
+}
+
+// Addons contains the settings of the addons managed by Gardener in the shoot.
+type Addons struct {
+	// KubernetesDashboard enables the legacy kubernetes-dashboard addon.
+	KubernetesDashboard *bool `json:"kubernetesDashboard,omitempty"`
+	// NginxIngress enables the nginx-ingress addon.
+	NginxIngress *bool `json:"nginxIngress,omitempty"`
+}
+
+// SystemComponents contains the settings of the system components running in the data plane of the shoot.
+type SystemComponents struct {
+	NodeLocalDNS *NodeLocalDNS `json:"nodeLocalDNS,omitempty"`
+}
+
+// NodeLocalDNS contains the settings of the node local DNS cache running on every node of the shoot.
+type NodeLocalDNS struct {
+	// Enabled indicates whether node local DNS is enabled.
+	Enabled bool `json:"enabled"`
+	// ForceTCPToClusterDNS forces TCP for the connection from node local DNS to the cluster DNS, Gardener enforces TCP if unspecified.
+	ForceTCPToClusterDNS *bool `json:"forceTCPToClusterDNS,omitempty"`
+	// ForceTCPToUpstreamDNS forces TCP for the connection from node local DNS to the upstream DNS, Gardener enforces TCP if unspecified.
+	ForceTCPToUpstreamDNS *bool `json:"forceTCPToUpstreamDNS,omitempty"`
+	// DisableForwardToUpstreamDNS disables forwarding of requests for external domains to the upstream DNS.
+	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty"`
+}
+
+// DNSEntries contains the settings of the DNS entries created by the workloads for domains beyond the primary domain of the shoot.
+type DNSEntries struct {
+	// Enabled replicates the DNS providers created in the shoot, so that the shoot-dns-service extension syncs their DNS entries.
+	Enabled bool `json:"enabled"`
 }
 
 type Kubernetes struct {
-	Version       *string   `json:"version,omitempty"`
-	KubeAPIServer APIServer `json:"kubeAPIServer,omitempty"`
+	Version               *string                `json:"version,omitempty"`
+	KubeAPIServer         APIServer              `json:"kubeAPIServer,omitempty"`
+	ClusterAutoscaler     *ClusterAutoscaler     `json:"clusterAutoscaler,omitempty"`
+	Kubelet               *KubeletConfig         `json:"kubelet,omitempty"`
+	KubeScheduler         *KubeScheduler         `json:"kubeScheduler,omitempty"`
+	KubeControllerManager *KubeControllerManager `json:"kubeControllerManager,omitempty"`
+	KubeProxy             *KubeProxy             `json:"kubeProxy,omitempty"`
+	// ForcedUpdate pre-approves or blocks the update Gardener forces when the Kubernetes version of the shoot goes out of support.
+	// Approved enables automatic Kubernetes version updates in the shoot maintenance, Blocked disables them regardless of the converter configuration.
+	// Gardener still updates versions which are already expired.
+	// +kubebuilder:validation:Enum=Approved;Blocked
+	// +optional
+	ForcedUpdate *ForcedVersionUpdate `json:"forcedUpdate,omitempty"`
+}
+
+type ForcedVersionUpdate string
+
+const (
+	ForcedVersionUpdateApproved ForcedVersionUpdate = "Approved"
+	ForcedVersionUpdateBlocked  ForcedVersionUpdate = "Blocked"
+)
+
+// KubeProxy contains the configuration of the kube-proxy running in the shoot.
+type KubeProxy struct {
+	// Enabled indicates whether kube-proxy is deployed. It can only be disabled for networking types replacing kube-proxy.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// KubeControllerManager contains the configuration of the kube-controller-manager running in the shoot.
+type KubeControllerManager struct {
+	// PodEvictionTimeout defines the grace period for deleting pods on failed nodes.
+	// Gardener forbids this field starting from Kubernetes 1.33.
+	PodEvictionTimeout *metav1.Duration `json:"podEvictionTimeout,omitempty"`
+	// NodeMonitorGracePeriod defines the grace period before an unresponsive node is marked unhealthy.
+	// Gardener defaults it to 40s when not set.
+	NodeMonitorGracePeriod *metav1.Duration `json:"nodeMonitorGracePeriod,omitempty"`
+	// FeatureGates contains the feature gates of the kube-controller-manager, gate names must not be empty.
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+}
+
+// KubeScheduler contains the configuration of the kube-scheduler running in the shoot.
+type KubeScheduler struct {
+	// KubeMaxPDVols configures the KUBE_MAX_PD_VOLS environment variable of the kube-scheduler.
+	//+kubebuilder:validation:Pattern=`^[0-9]+$`
+	KubeMaxPDVols *string `json:"kubeMaxPDVols,omitempty"`
+}
+
+// KubeletConfig contains the image pull and pod capacity settings of the kubelet applied to every worker pool.
+// Values set in the kubelet configuration of a worker pool take precedence.
+type KubeletConfig struct {
+	// SerializeImagePulls describes whether the images are pulled one at a time.
+	SerializeImagePulls *bool `json:"serializeImagePulls,omitempty"`
+	// RegistryPullQPS is the limit of registry pulls per second. Setting it to 0 means no limit.
+	//+kubebuilder:validation:Minimum=0
+	RegistryPullQPS *int32 `json:"registryPullQPS,omitempty"`
+	// RegistryBurst is the maximum size of bursty pulls, it is only used if registryPullQPS is greater than 0.
+	//+kubebuilder:validation:Minimum=0
+	RegistryBurst *int32 `json:"registryBurst,omitempty"`
+	// MaxPods is the maximum number of pods per node, it overrides the default of the converter configuration.
+	// The node CIDR of every node must have room for twice as many pod IPs.
+	//+kubebuilder:validation:Minimum=1
+	MaxPods *int32 `json:"maxPods,omitempty"`
+}
+
+// ClusterAutoscaler contains the configuration of the cluster autoscaler running in the shoot.
+type ClusterAutoscaler struct {
+	// Expander defines the algorithm used by the cluster autoscaler to select the worker pool during scale up.
+	//+kubebuilder:validation:Enum=least-waste;most-pods;priority;random
+	Expander *gardener.ExpanderMode `json:"expander,omitempty"`
 }
 
 // OIDCConfig contains configuration settings for the OIDC provider.
@@ -194,9 +335,53 @@ type OIDCConfig struct {
 	JWKS                []byte `json:"jwks,omitempty"`
 }
 
+// OIDCConfigReferences contains references to the keys holding values of the OIDC config.
+type OIDCConfigReferences struct {
+	// CABundle references the key of a ConfigMap holding the PEM encoded CA bundle used to verify the OIDC issuer.
+	CABundle *corev1.ConfigMapKeySelector `json:"caBundle,omitempty"`
+}
+
 type APIServer struct {
 	OidcConfig           gardener.OIDCConfig `json:"oidcConfig,omitempty"`
 	AdditionalOidcConfig *[]OIDCConfig       `json:"additionalOidcConfig,omitempty"`
+	// OidcConfigFrom references the ConfigMap in the namespace of the Runtime holding values of OidcConfig.
+	// The values are resolved before the shoot is created or updated and take precedence over the values set in OidcConfig.
+	OidcConfigFrom       *OIDCConfigReferences `json:"oidcConfigFrom,omitempty"`
+	ServiceAccountConfig *ServiceAccountConfig `json:"serviceAccountConfig,omitempty"`
+	// DefaultNotReadyTolerationSeconds indicates the tolerationSeconds of the toleration for notReady:NoExecute
+	// that is added by default to every pod that does not already have such a toleration.
+	//+kubebuilder:validation:Minimum=0
+	DefaultNotReadyTolerationSeconds *int64 `json:"defaultNotReadyTolerationSeconds,omitempty"`
+	// DefaultUnreachableTolerationSeconds indicates the tolerationSeconds of the toleration for unreachable:NoExecute
+	// that is added by default to every pod that does not already have such a toleration.
+	//+kubebuilder:validation:Minimum=0
+	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
+	// Logging contains the log verbosity of the kube-apiserver, Gardener defaults are used when not set.
+	Logging *APIServerLogging `json:"logging,omitempty"`
+	// EventTTL controls the amount of time to retain events, Gardener defaults are used when not set.
+	// It must not be negative or longer than 7 days.
+	EventTTL *metav1.Duration `json:"eventTTL,omitempty"`
+}
+
+// APIServerLogging contains the log verbosity settings of the kube-apiserver.
+type APIServerLogging struct {
+	// Verbosity is the kube-apiserver log verbosity level.
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=10
+	Verbosity *int32 `json:"verbosity,omitempty"`
+	// HTTPAccessVerbosity is the kube-apiserver access logs level.
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=10
+	HTTPAccessVerbosity *int32 `json:"httpAccessVerbosity,omitempty"`
+}
+
+// ServiceAccountConfig contains the settings of the service account token issuer of the kube-apiserver.
+type ServiceAccountConfig struct {
+	// Issuer is the identifier of the service account token issuer, it must be a URL.
+	// Defaults to the URL of the API server.
+	Issuer *string `json:"issuer,omitempty"`
+	// AcceptedIssuers is an additional set of issuers that are used to determine which service account tokens are accepted.
+	AcceptedIssuers []string `json:"acceptedIssuers,omitempty"`
 }
 
 type Provider struct {
@@ -206,18 +391,74 @@ type Provider struct {
 	AdditionalWorkers    *[]gardener.Worker    `json:"additionalWorkers,omitempty"`
 	ControlPlaneConfig   *runtime.RawExtension `json:"controlPlaneConfig,omitempty"`
 	InfrastructureConfig *runtime.RawExtension `json:"infrastructureConfig,omitempty"`
+	// ExistingNetwork deploys the shoot into an existing network of the hyperscaler account instead of creating a new one.
+	// It is only applied when the shoot is created.
+	// +optional
+	ExistingNetwork *ExistingNetwork `json:"existingNetwork,omitempty"`
+	// WorkerKubernetesVersionPolicies controls the Kubernetes version of the worker pools, keyed by the worker pool name.
+	// Worker pools without a policy keep the Kubernetes version set in the worker pool.
+	// +optional
+	WorkerKubernetesVersionPolicies map[string]WorkerKubernetesVersionPolicy `json:"workerKubernetesVersionPolicies,omitempty"`
+}
+
+// WorkerKubernetesVersionPolicy defines how the Kubernetes version of a worker pool follows the Kubernetes version of the shoot.
+// +kubebuilder:validation:Enum=inherit;pinned
+type WorkerKubernetesVersionPolicy string
+
+const (
+	// WorkerKubernetesVersionInherit makes the worker pool follow the Kubernetes version of the shoot
+	WorkerKubernetesVersionInherit WorkerKubernetesVersionPolicy = "inherit"
+	// WorkerKubernetesVersionPinned keeps the worker pool on its Kubernetes version when the shoot is upgraded
+	WorkerKubernetesVersionPinned WorkerKubernetesVersionPolicy = "pinned"
+)
+
+// ExistingNetwork references an existing network, the entry matching the provider type of the Runtime is required.
+type ExistingNetwork struct {
+	// +optional
+	AWS *AWSExistingNetwork `json:"aws,omitempty"`
+	// +optional
+	Azure *AzureExistingNetwork `json:"azure,omitempty"`
+	// +optional
+	GCP *GCPExistingNetwork `json:"gcp,omitempty"`
+}
+
+// AWSExistingNetwork references an existing AWS VPC, the subnets for the worker zones are created in it.
+type AWSExistingNetwork struct {
+	// VPCID is the ID of the existing VPC.
+	VPCID string `json:"vpcID"`
+}
+
+// AzureExistingNetwork references an existing Azure VNet.
+type AzureExistingNetwork struct {
+	// VNetName is the name of the existing VNet.
+	VNetName string `json:"vnetName"`
+	// ResourceGroup is the resource group of the existing VNet.
+	ResourceGroup string `json:"resourceGroup"`
+}
+
+// GCPExistingNetwork references an existing GCP VPC.
+type GCPExistingNetwork struct {
+	// VPCName is the name of the existing VPC.
+	VPCName string `json:"vpcName"`
 }
 
 type Networking struct {
 	Type     *string `json:"type,omitempty"`
 	Pods     string  `json:"pods"`
-	Nodes    string  `json:"nodes"`
+	Nodes    string  `json:"nodes,omitempty"`
 	Services string  `json:"services"`
+	// ProviderConfig is the network config of the networking type, for example the overlay mode or the MTU of Cilium or Calico.
+	// It is validated against the networking type, which must be set.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty"`
 }
 
 type Security struct {
-	Administrators []string           `json:"administrators"`
-	Networking     NetworkingSecurity `json:"networking"`
+	Administrators []string `json:"administrators"`
+	// AdministratorGroups are the groups granted cluster-admin access in addition to the users listed in Administrators
+	// +optional
+	AdministratorGroups []string           `json:"administratorGroups,omitempty"`
+	Networking          NetworkingSecurity `json:"networking"`
 }
 
 type NetworkingSecurity struct {
@@ -245,6 +486,16 @@ func init() {
 	SchemeBuilder.Register(&Runtime{}, &RuntimeList{})
 }
 
+// GetConditions returns the conditions of the Runtime status
+func (k *Runtime) GetConditions() []metav1.Condition {
+	return k.Status.Conditions
+}
+
+// SetConditions replaces the conditions of the Runtime status
+func (k *Runtime) SetConditions(conditions []metav1.Condition) {
+	k.Status.Conditions = conditions
+}
+
 func (k *Runtime) UpdateStateReady(c RuntimeConditionType, r RuntimeConditionReason, msg string) {
 	k.Status.State = RuntimeStateReady
 	condition := metav1.Condition{
@@ -253,6 +504,7 @@ func (k *Runtime) UpdateStateReady(c RuntimeConditionType, r RuntimeConditionRea
 		LastTransitionTime: metav1.Now(),
 		Reason:             string(r),
 		Message:            msg,
+		ObservedGeneration: k.Generation,
 	}
 	meta.SetStatusCondition(&k.Status.Conditions, condition)
 }
@@ -270,6 +522,7 @@ func (k *Runtime) UpdateStateDeletion(c RuntimeConditionType, r RuntimeCondition
 		LastTransitionTime: metav1.Now(),
 		Reason:             string(r),
 		Message:            msg,
+		ObservedGeneration: k.Generation,
 	}
 	meta.SetStatusCondition(&k.Status.Conditions, condition)
 }
@@ -287,6 +540,7 @@ func (k *Runtime) UpdateStatePending(c RuntimeConditionType, r RuntimeConditionR
 		LastTransitionTime: metav1.Now(),
 		Reason:             string(r),
 		Message:            msg,
+		ObservedGeneration: k.Generation,
 	}
 	meta.SetStatusCondition(&k.Status.Conditions, condition)
 }
@@ -299,6 +553,14 @@ func (k *Runtime) IsProvisioningCompletedStatusSet() bool {
 	return k.Status.ProvisioningCompleted
 }
 
+func (k *Runtime) GetKubeconfigSecretKey() string {
+	if k.Spec.Shoot.KubeconfigSecretKey == "" {
+		return DefaultKubeconfigSecretKey
+	}
+
+	return k.Spec.Shoot.KubeconfigSecretKey
+}
+
 func (k *Runtime) IsStateWithConditionSet(runtimeState State, c RuntimeConditionType, r RuntimeConditionReason) bool {
 	if k.Status.State != runtimeState {
 		return false
@@ -331,6 +593,13 @@ func (k *Runtime) IsConditionSetWithStatus(c RuntimeConditionType, r RuntimeCond
 	return false
 }
 
+// IsConditionObservedForCurrentGeneration tells whether the condition was set for the current spec of the Runtime.
+// It returns false when the condition is not set or was set for an older generation.
+func (k *Runtime) IsConditionObservedForCurrentGeneration(c RuntimeConditionType) bool {
+	condition := meta.FindStatusCondition(k.Status.Conditions, string(c))
+	return condition != nil && condition.ObservedGeneration == k.Generation
+}
+
 func (k *Runtime) ValidateRequiredLabels() error {
 	var requiredLabelKeys = []string{
 		LabelKymaInstanceID,
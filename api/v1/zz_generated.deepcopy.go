@@ -22,6 +22,7 @@ package v1
 
 import (
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -41,6 +42,36 @@ func (in *APIServer) DeepCopyInto(out *APIServer) {
 			}
 		}
 	}
+	if in.OidcConfigFrom != nil {
+		in, out := &in.OidcConfigFrom, &out.OidcConfigFrom
+		*out = new(OIDCConfigReferences)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountConfig != nil {
+		in, out := &in.ServiceAccountConfig, &out.ServiceAccountConfig
+		*out = new(ServiceAccountConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultNotReadyTolerationSeconds != nil {
+		in, out := &in.DefaultNotReadyTolerationSeconds, &out.DefaultNotReadyTolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DefaultUnreachableTolerationSeconds != nil {
+		in, out := &in.DefaultUnreachableTolerationSeconds, &out.DefaultUnreachableTolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(APIServerLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventTTL != nil {
+		in, out := &in.EventTTL, &out.EventTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServer.
@@ -53,6 +84,136 @@ func (in *APIServer) DeepCopy() *APIServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerLogging) DeepCopyInto(out *APIServerLogging) {
+	*out = *in
+	if in.Verbosity != nil {
+		in, out := &in.Verbosity, &out.Verbosity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HTTPAccessVerbosity != nil {
+		in, out := &in.HTTPAccessVerbosity, &out.HTTPAccessVerbosity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLogging.
+func (in *APIServerLogging) DeepCopy() *APIServerLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSExistingNetwork) DeepCopyInto(out *AWSExistingNetwork) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSExistingNetwork.
+func (in *AWSExistingNetwork) DeepCopy() *AWSExistingNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSExistingNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Addons) DeepCopyInto(out *Addons) {
+	*out = *in
+	if in.KubernetesDashboard != nil {
+		in, out := &in.KubernetesDashboard, &out.KubernetesDashboard
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NginxIngress != nil {
+		in, out := &in.NginxIngress, &out.NginxIngress
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Addons.
+func (in *Addons) DeepCopy() *Addons {
+	if in == nil {
+		return nil
+	}
+	out := new(Addons)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureExistingNetwork) DeepCopyInto(out *AzureExistingNetwork) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureExistingNetwork.
+func (in *AzureExistingNetwork) DeepCopy() *AzureExistingNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureExistingNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
+	*out = *in
+	if in.Expander != nil {
+		in, out := &in.Expander, &out.Expander
+		*out = new(v1beta1.ExpanderMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscaler.
+func (in *ClusterAutoscaler) DeepCopy() *ClusterAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialsRotationStatus) DeepCopyInto(out *CredentialsRotationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialsRotationStatus.
+func (in *CredentialsRotationStatus) DeepCopy() *CredentialsRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialsRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSEntries) DeepCopyInto(out *DNSEntries) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSEntries.
+func (in *DNSEntries) DeepCopy() *DNSEntries {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSEntries)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Egress) DeepCopyInto(out *Egress) {
 	*out = *in
@@ -68,6 +229,36 @@ func (in *Egress) DeepCopy() *Egress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExistingNetwork) DeepCopyInto(out *ExistingNetwork) {
+	*out = *in
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSExistingNetwork)
+		**out = **in
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureExistingNetwork)
+		**out = **in
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPExistingNetwork)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExistingNetwork.
+func (in *ExistingNetwork) DeepCopy() *ExistingNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ExistingNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -89,12 +280,27 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPExistingNetwork) DeepCopyInto(out *GCPExistingNetwork) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPExistingNetwork.
+func (in *GCPExistingNetwork) DeepCopy() *GCPExistingNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPExistingNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenerCluster) DeepCopyInto(out *GardenerCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -151,7 +357,7 @@ func (in *GardenerClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GardenerClusterSpec) DeepCopyInto(out *GardenerClusterSpec) {
 	*out = *in
-	out.Kubeconfig = in.Kubeconfig
+	in.Kubeconfig.DeepCopyInto(&out.Kubeconfig)
 	out.Shoot = in.Shoot
 }
 
@@ -175,6 +381,14 @@ func (in *GardenerClusterStatus) DeepCopyInto(out *GardenerClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.KubeconfigExpiration != nil {
+		in, out := &in.KubeconfigExpiration, &out.KubeconfigExpiration
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulSyncTime != nil {
+		in, out := &in.LastSuccessfulSyncTime, &out.LastSuccessfulSyncTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerClusterStatus.
@@ -218,10 +432,92 @@ func (in *Ingress) DeepCopy() *Ingress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeControllerManager) DeepCopyInto(out *KubeControllerManager) {
+	*out = *in
+	if in.PodEvictionTimeout != nil {
+		in, out := &in.PodEvictionTimeout, &out.PodEvictionTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NodeMonitorGracePeriod != nil {
+		in, out := &in.NodeMonitorGracePeriod, &out.NodeMonitorGracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeControllerManager.
+func (in *KubeControllerManager) DeepCopy() *KubeControllerManager {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeControllerManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxy) DeepCopyInto(out *KubeProxy) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxy.
+func (in *KubeProxy) DeepCopy() *KubeProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeScheduler) DeepCopyInto(out *KubeScheduler) {
+	*out = *in
+	if in.KubeMaxPDVols != nil {
+		in, out := &in.KubeMaxPDVols, &out.KubeMaxPDVols
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeScheduler.
+func (in *KubeScheduler) DeepCopy() *KubeScheduler {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeScheduler)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kubeconfig) DeepCopyInto(out *Kubeconfig) {
 	*out = *in
 	out.Secret = in.Secret
+	if in.AdditionalSecrets != nil {
+		in, out := &in.AdditionalSecrets, &out.AdditionalSecrets
+		*out = make([]Secret, len(*in))
+		copy(*out, *in)
+	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kubeconfig.
@@ -234,6 +530,41 @@ func (in *Kubeconfig) DeepCopy() *Kubeconfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	if in.SerializeImagePulls != nil {
+		in, out := &in.SerializeImagePulls, &out.SerializeImagePulls
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RegistryPullQPS != nil {
+		in, out := &in.RegistryPullQPS, &out.RegistryPullQPS
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RegistryBurst != nil {
+		in, out := &in.RegistryBurst, &out.RegistryBurst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 	*out = *in
@@ -243,6 +574,36 @@ func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 		**out = **in
 	}
 	in.KubeAPIServer.DeepCopyInto(&out.KubeAPIServer)
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(ClusterAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		*out = new(KubeletConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeScheduler != nil {
+		in, out := &in.KubeScheduler, &out.KubeScheduler
+		*out = new(KubeScheduler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeControllerManager != nil {
+		in, out := &in.KubeControllerManager, &out.KubeControllerManager
+		*out = new(KubeControllerManager)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(KubeProxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForcedUpdate != nil {
+		in, out := &in.ForcedUpdate, &out.ForcedUpdate
+		*out = new(ForcedVersionUpdate)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kubernetes.
@@ -263,6 +624,11 @@ func (in *Networking) DeepCopyInto(out *Networking) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networking.
@@ -291,6 +657,36 @@ func (in *NetworkingSecurity) DeepCopy() *NetworkingSecurity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
+	*out = *in
+	if in.ForceTCPToClusterDNS != nil {
+		in, out := &in.ForceTCPToClusterDNS, &out.ForceTCPToClusterDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForceTCPToUpstreamDNS != nil {
+		in, out := &in.ForceTCPToUpstreamDNS, &out.ForceTCPToUpstreamDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableForwardToUpstreamDNS != nil {
+		in, out := &in.DisableForwardToUpstreamDNS, &out.DisableForwardToUpstreamDNS
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNS.
+func (in *NodeLocalDNS) DeepCopy() *NodeLocalDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLocalDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
 	*out = *in
@@ -312,6 +708,26 @@ func (in *OIDCConfig) DeepCopy() *OIDCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCConfigReferences) DeepCopyInto(out *OIDCConfigReferences) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCConfigReferences.
+func (in *OIDCConfigReferences) DeepCopy() *OIDCConfigReferences {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCConfigReferences)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Provider) DeepCopyInto(out *Provider) {
 	*out = *in
@@ -343,6 +759,18 @@ func (in *Provider) DeepCopyInto(out *Provider) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExistingNetwork != nil {
+		in, out := &in.ExistingNetwork, &out.ExistingNetwork
+		*out = new(ExistingNetwork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerKubernetesVersionPolicies != nil {
+		in, out := &in.WorkerKubernetesVersionPolicies, &out.WorkerKubernetesVersionPolicies
+		*out = make(map[string]WorkerKubernetesVersionPolicy, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Provider.
@@ -427,6 +855,11 @@ func (in *RuntimeShoot) DeepCopyInto(out *RuntimeShoot) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CloudProfileName != nil {
+		in, out := &in.CloudProfileName, &out.CloudProfileName
+		*out = new(string)
+		**out = **in
+	}
 	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
 	in.Provider.DeepCopyInto(&out.Provider)
 	in.Networking.DeepCopyInto(&out.Networking)
@@ -435,6 +868,28 @@ func (in *RuntimeShoot) DeepCopyInto(out *RuntimeShoot) {
 		*out = new(v1beta1.ControlPlane)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = new(Addons)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SystemComponents != nil {
+		in, out := &in.SystemComponents, &out.SystemComponents
+		*out = new(SystemComponents)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSEntries != nil {
+		in, out := &in.DNSEntries, &out.DNSEntries
+		*out = new(DNSEntries)
+		**out = **in
+	}
+	if in.FeatureFlags != nil {
+		in, out := &in.FeatureFlags, &out.FeatureFlags
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeShoot.
@@ -493,6 +948,11 @@ func (in *RuntimeStatus) DeepCopyInto(out *RuntimeStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ShootCredentialsRotation != nil {
+		in, out := &in.ShootCredentialsRotation, &out.ShootCredentialsRotation
+		*out = new(CredentialsRotationStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeStatus.
@@ -528,6 +988,11 @@ func (in *Security) DeepCopyInto(out *Security) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdministratorGroups != nil {
+		in, out := &in.AdministratorGroups, &out.AdministratorGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	in.Networking.DeepCopyInto(&out.Networking)
 }
 
@@ -541,6 +1006,31 @@ func (in *Security) DeepCopy() *Security {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountConfig) DeepCopyInto(out *ServiceAccountConfig) {
+	*out = *in
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(string)
+		**out = **in
+	}
+	if in.AcceptedIssuers != nil {
+		in, out := &in.AcceptedIssuers, &out.AcceptedIssuers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountConfig.
+func (in *ServiceAccountConfig) DeepCopy() *ServiceAccountConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Shoot) DeepCopyInto(out *Shoot) {
 	*out = *in
@@ -555,3 +1045,23 @@ func (in *Shoot) DeepCopy() *Shoot {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemComponents) DeepCopyInto(out *SystemComponents) {
+	*out = *in
+	if in.NodeLocalDNS != nil {
+		in, out := &in.NodeLocalDNS, &out.NodeLocalDNS
+		*out = new(NodeLocalDNS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemComponents.
+func (in *SystemComponents) DeepCopy() *SystemComponents {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemComponents)
+	in.DeepCopyInto(out)
+	return out
+}
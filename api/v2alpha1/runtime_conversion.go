@@ -0,0 +1,137 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2alpha1
+
+import (
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this Runtime to the hub version (v1).
+func (r *Runtime) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*imv1.Runtime)
+	src := r.DeepCopy()
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = imv1.RuntimeSpec{
+		Shoot:    convertShootTo(src.Spec.Shoot),
+		Security: convertSecurityTo(src.Spec.Security),
+		Caching:  src.Spec.Caching,
+	}
+	dst.Status = src.Status
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1) to this version.
+func (r *Runtime) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*imv1.Runtime).DeepCopy()
+
+	r.ObjectMeta = src.ObjectMeta
+	r.Spec = RuntimeSpec{
+		Shoot:    convertShootFrom(src.Spec.Shoot),
+		Security: convertSecurityFrom(src.Spec.Security),
+		Caching:  src.Spec.Caching,
+	}
+	r.Status = src.Status
+
+	return nil
+}
+
+func convertShootTo(shoot RuntimeShoot) imv1.RuntimeShoot {
+	return imv1.RuntimeShoot{
+		Name:                shoot.Name,
+		Purpose:             shoot.Purpose,
+		PlatformRegion:      shoot.PlatformRegion,
+		Region:              shoot.Region,
+		LicenceType:         shoot.LicenceType,
+		SecretBindingName:   shoot.SecretBindingName,
+		EnforceSeedLocation: shoot.EnforceSeedLocation,
+		CloudProfileName:    shoot.CloudProfileName,
+		Kubernetes:          shoot.Kubernetes,
+		Provider:            imv1.Provider(shoot.Provider),
+		Networking:          convertNetworkingTo(shoot.Networking),
+		ControlPlane:        shoot.ControlPlane,
+		Addons:              shoot.Addons,
+		SystemComponents:    shoot.SystemComponents,
+		DNSEntries:          shoot.DNSEntries,
+		FeatureFlags:        shoot.FeatureFlags,
+		KubeconfigSecretKey: shoot.KubeconfigSecretKey,
+	}
+}
+
+func convertShootFrom(shoot imv1.RuntimeShoot) RuntimeShoot {
+	return RuntimeShoot{
+		Name:                shoot.Name,
+		Purpose:             shoot.Purpose,
+		PlatformRegion:      shoot.PlatformRegion,
+		Region:              shoot.Region,
+		LicenceType:         shoot.LicenceType,
+		SecretBindingName:   shoot.SecretBindingName,
+		EnforceSeedLocation: shoot.EnforceSeedLocation,
+		CloudProfileName:    shoot.CloudProfileName,
+		Kubernetes:          shoot.Kubernetes,
+		Provider:            Provider(shoot.Provider),
+		Networking:          convertNetworkingFrom(shoot.Networking),
+		ControlPlane:        shoot.ControlPlane,
+		Addons:              shoot.Addons,
+		SystemComponents:    shoot.SystemComponents,
+		DNSEntries:          shoot.DNSEntries,
+		FeatureFlags:        shoot.FeatureFlags,
+		KubeconfigSecretKey: shoot.KubeconfigSecretKey,
+	}
+}
+
+// Nodes CIDR is optional for some providers, v2alpha1 represents it as a pointer instead of an empty string
+func convertNetworkingTo(networking Networking) imv1.Networking {
+	converted := imv1.Networking{
+		Type:           networking.Type,
+		Pods:           networking.Pods,
+		Services:       networking.Services,
+		ProviderConfig: networking.ProviderConfig,
+	}
+
+	if networking.Nodes != nil {
+		converted.Nodes = *networking.Nodes
+	}
+
+	return converted
+}
+
+func convertNetworkingFrom(networking imv1.Networking) Networking {
+	converted := Networking{
+		Type:           networking.Type,
+		Pods:           networking.Pods,
+		Services:       networking.Services,
+		ProviderConfig: networking.ProviderConfig,
+	}
+
+	if networking.Nodes != "" {
+		nodes := networking.Nodes
+		converted.Nodes = &nodes
+	}
+
+	return converted
+}
+
+func convertSecurityTo(security Security) imv1.Security {
+	return imv1.Security(security)
+}
+
+func convertSecurityFrom(security imv1.Security) Security {
+	return Security(security)
+}
@@ -0,0 +1,182 @@
+package v2alpha1
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	registrycache "github.com/kyma-project/kim-snatch/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestRuntimeConversion(t *testing.T) {
+	t.Run("Should convert hub Runtime to v2alpha1 and back without data loss", func(t *testing.T) {
+		// given
+		hub := fixHubRuntime()
+
+		// when
+		var spoke Runtime
+		err := spoke.ConvertFrom(hub.DeepCopy())
+		require.NoError(t, err)
+
+		var converted imv1.Runtime
+		err = spoke.ConvertTo(&converted)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, hub, converted)
+		require.NotNil(t, spoke.Spec.Shoot.Networking.Nodes)
+		assert.Equal(t, hub.Spec.Shoot.Networking.Nodes, *spoke.Spec.Shoot.Networking.Nodes)
+	})
+
+	t.Run("Should convert v2alpha1 Runtime to hub and back without data loss", func(t *testing.T) {
+		// given
+		var spoke Runtime
+		require.NoError(t, spoke.ConvertFrom(ptr.To(fixHubRuntime())))
+
+		// when
+		var hub imv1.Runtime
+		err := spoke.ConvertTo(&hub)
+		require.NoError(t, err)
+
+		var converted Runtime
+		err = converted.ConvertFrom(&hub)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, spoke, converted)
+	})
+
+	t.Run("Should omit nodes CIDR when it is empty in the hub", func(t *testing.T) {
+		// given
+		hub := fixHubRuntime()
+		hub.Spec.Shoot.Networking.Nodes = ""
+
+		// when
+		var spoke Runtime
+		err := spoke.ConvertFrom(&hub)
+		require.NoError(t, err)
+
+		var converted imv1.Runtime
+		err = spoke.ConvertTo(&converted)
+		require.NoError(t, err)
+
+		// then
+		assert.Nil(t, spoke.Spec.Shoot.Networking.Nodes)
+		assert.Equal(t, hub, converted)
+	})
+
+	t.Run("Should not share memory between converted objects", func(t *testing.T) {
+		// given
+		hub := fixHubRuntime()
+
+		// when
+		var spoke Runtime
+		err := spoke.ConvertFrom(&hub)
+		require.NoError(t, err)
+		spoke.Spec.Security.Administrators[0] = "changed@example.com"
+		spoke.Spec.Shoot.Provider.Workers[0].Name = "changed"
+
+		// then
+		assert.Equal(t, "admin@example.com", hub.Spec.Security.Administrators[0])
+		assert.Equal(t, "worker", hub.Spec.Shoot.Provider.Workers[0].Name)
+	})
+}
+
+func fixHubRuntime() imv1.Runtime {
+	return imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runtime",
+			Namespace: "kcp-system",
+			Labels: map[string]string{
+				imv1.LabelKymaRuntimeID: "runtime-id",
+			},
+		},
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name:                "shoot",
+				Purpose:             gardener.ShootPurposeProduction,
+				PlatformRegion:      "cf-eu11",
+				Region:              "eu-central-1",
+				LicenceType:         ptr.To("TestDevelopmentAndDemo"),
+				SecretBindingName:   "secret-binding",
+				EnforceSeedLocation: ptr.To(true),
+				CloudProfileName:    ptr.To("aws-custom"),
+				Kubernetes: imv1.Kubernetes{
+					Version: ptr.To("1.31"),
+					KubeAPIServer: imv1.APIServer{
+						OidcConfig: gardener.OIDCConfig{
+							ClientID:  ptr.To("client-id"),
+							IssuerURL: ptr.To("https://issuer.example.com"),
+						},
+					},
+				},
+				Provider: imv1.Provider{
+					Type: "aws",
+					Workers: []gardener.Worker{
+						{
+							Name:    "worker",
+							Machine: gardener.Machine{Type: "m6i.large"},
+							Minimum: 1,
+							Maximum: 3,
+							Zones:   []string{"eu-central-1a"},
+						},
+					},
+					InfrastructureConfig: &runtime.RawExtension{Raw: []byte(`{"kind":"InfrastructureConfig"}`)},
+				},
+				Networking: imv1.Networking{
+					Type:     ptr.To("calico"),
+					Pods:     "100.64.0.0/12",
+					Nodes:    "10.250.0.0/16",
+					Services: "100.104.0.0/13",
+				},
+				Addons: &imv1.Addons{
+					KubernetesDashboard: ptr.To(false),
+				},
+				SystemComponents: &imv1.SystemComponents{
+					NodeLocalDNS: &imv1.NodeLocalDNS{
+						Enabled:              true,
+						ForceTCPToClusterDNS: ptr.To(false),
+					},
+				},
+				DNSEntries: &imv1.DNSEntries{
+					Enabled: true,
+				},
+				FeatureFlags: map[string]bool{
+					"experimental": true,
+				},
+				KubeconfigSecretKey: "kubeconfig",
+				ControlPlane: &gardener.ControlPlane{
+					HighAvailability: &gardener.HighAvailability{
+						FailureTolerance: gardener.FailureTolerance{Type: gardener.FailureToleranceTypeZone},
+					},
+				},
+			},
+			Security: imv1.Security{
+				Administrators:      []string{"admin@example.com"},
+				AdministratorGroups: []string{"admins"},
+				Networking: imv1.NetworkingSecurity{
+					Filter: imv1.Filter{
+						Ingress: &imv1.Ingress{Enabled: true},
+						Egress:  imv1.Egress{Enabled: true},
+					},
+				},
+			},
+			Caching: []imv1.ImageRegistryCache{
+				{
+					Name:      "cache",
+					Namespace: "default",
+					UID:       "uid",
+					Config:    registrycache.RegistryCacheConfigSpec{Upstream: "quay.io"},
+				},
+			},
+		},
+		Status: imv1.RuntimeStatus{
+			State: imv1.RuntimeStateReady,
+		},
+	}
+}
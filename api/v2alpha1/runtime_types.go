@@ -0,0 +1,121 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2alpha1
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.shoot.provider.type"
+//+kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.shoot.region"
+//+kubebuilder:printcolumn:name="STATE",type=string,JSONPath=`.status.state`
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Runtime is the Schema for the runtimes API
+type Runtime struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuntimeSpec        `json:"spec,omitempty"`
+	Status imv1.RuntimeStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RuntimeList contains a list of Runtime
+type RuntimeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Runtime `json:"items"`
+}
+
+// RuntimeSpec defines the desired state of Runtime
+type RuntimeSpec struct {
+	Shoot    RuntimeShoot              `json:"shoot"`
+	Security Security                  `json:"security"`
+	Caching  []imv1.ImageRegistryCache `json:"imageRegistryCache,omitempty"`
+}
+
+type RuntimeShoot struct {
+	Name           string                `json:"name"`
+	Purpose        gardener.ShootPurpose `json:"purpose"`
+	PlatformRegion string                `json:"platformRegion"`
+	Region         string                `json:"region"`
+	LicenceType    *string               `json:"licenceType,omitempty"`
+	// +optional
+	SecretBindingName   string                 `json:"secretBindingName,omitempty"`
+	EnforceSeedLocation *bool                  `json:"enforceSeedLocation,omitempty"`
+	CloudProfileName    *string                `json:"cloudProfileName,omitempty"`
+	Kubernetes          imv1.Kubernetes        `json:"kubernetes,omitempty"`
+	Provider            Provider               `json:"provider"`
+	Networking          Networking             `json:"networking"`
+	ControlPlane        *gardener.ControlPlane `json:"controlPlane,omitempty"`
+	Addons              *imv1.Addons           `json:"addons,omitempty"`
+	SystemComponents    *imv1.SystemComponents `json:"systemComponents,omitempty"`
+	DNSEntries          *imv1.DNSEntries       `json:"dnsEntries,omitempty"`
+	FeatureFlags        map[string]bool        `json:"featureFlags,omitempty"`
+	// KubeconfigSecretKey is the key of the kubeconfig in the secret data of the GardenerCluster, `config` is used when not set.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	KubeconfigSecretKey string `json:"kubeconfigSecretKey,omitempty"`
+}
+
+type Provider struct {
+	//+kubebuilder:validation:Enum=aws;azure;gcp;openstack
+	Type                 string                `json:"type"`
+	Workers              []gardener.Worker     `json:"workers"`
+	AdditionalWorkers    *[]gardener.Worker    `json:"additionalWorkers,omitempty"`
+	ControlPlaneConfig   *runtime.RawExtension `json:"controlPlaneConfig,omitempty"`
+	InfrastructureConfig *runtime.RawExtension `json:"infrastructureConfig,omitempty"`
+	// ExistingNetwork deploys the shoot into an existing network of the hyperscaler account instead of creating a new one.
+	// It is only applied when the shoot is created.
+	// +optional
+	ExistingNetwork *imv1.ExistingNetwork `json:"existingNetwork,omitempty"`
+	// WorkerKubernetesVersionPolicies controls the Kubernetes version of the worker pools, keyed by the worker pool name.
+	// Worker pools without a policy keep the Kubernetes version set in the worker pool.
+	// +optional
+	WorkerKubernetesVersionPolicies map[string]imv1.WorkerKubernetesVersionPolicy `json:"workerKubernetesVersionPolicies,omitempty"`
+}
+
+type Networking struct {
+	Type     *string `json:"type,omitempty"`
+	Pods     string  `json:"pods"`
+	Nodes    *string `json:"nodes,omitempty"`
+	Services string  `json:"services"`
+	// ProviderConfig is the network config of the networking type, for example the overlay mode or the MTU of Cilium or Calico.
+	// It is validated against the networking type, which must be set.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty"`
+}
+
+type Security struct {
+	Administrators []string `json:"administrators"`
+	// AdministratorGroups are the groups granted cluster-admin access in addition to the users listed in Administrators
+	// +optional
+	AdministratorGroups []string                `json:"administratorGroups,omitempty"`
+	Networking          imv1.NetworkingSecurity `json:"networking"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Runtime{}, &RuntimeList{})
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Default values for the leader election configuration, they match the controller-runtime defaults
+const (
+	defaultLeaderElectionID            = "f1c68560.kyma-project.io"
+	defaultLeaderElectionLeaseDuration = 15 * time.Second
+	defaultLeaderElectionRenewDeadline = 10 * time.Second
+	defaultLeaderElectionRetryPeriod   = 2 * time.Second
+)
+
+type leaderElectionConfig struct {
+	enabled       bool
+	id            string
+	namespace     string
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+}
+
+func (c *leaderElectionConfig) bindFlags(flagSet *flag.FlagSet) {
+	flagSet.BoolVar(&c.enabled, "leader-elect", false,
+		"Enable leader election for controller manager. "+
+			"Enabling this will ensure there is only one active controller manager.")
+	flagSet.StringVar(&c.id, "leader-elect-id", defaultLeaderElectionID, "Name of the Lease resource used for leader election")
+	flagSet.StringVar(&c.namespace, "leader-elect-namespace", "", "Namespace in which the leader election Lease resource is created. When empty, the namespace the manager is running in is used")
+	flagSet.DurationVar(&c.leaseDuration, "leader-elect-lease-duration", defaultLeaderElectionLeaseDuration, "Duration that non-leader candidates will wait to force acquire leadership")
+	flagSet.DurationVar(&c.renewDeadline, "leader-elect-renew-deadline", defaultLeaderElectionRenewDeadline, "Duration that the acting leader will retry refreshing leadership before giving up. It must be shorter than the lease duration")
+	flagSet.DurationVar(&c.retryPeriod, "leader-elect-retry-period", defaultLeaderElectionRetryPeriod, "Duration the leader election clients should wait between tries of actions")
+}
+
+func (c leaderElectionConfig) applyTo(options *ctrl.Options) {
+	options.LeaderElection = c.enabled
+	options.LeaderElectionID = c.id
+	options.LeaderElectionNamespace = c.namespace
+	options.LeaseDuration = &c.leaseDuration
+	options.RenewDeadline = &c.renewDeadline
+	options.RetryPeriod = &c.retryPeriod
+}
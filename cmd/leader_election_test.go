@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestLeaderElectionConfig(t *testing.T) {
+	t.Run("Should populate manager options with defaults suitable for a single replica", func(t *testing.T) {
+		// given
+		var config leaderElectionConfig
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		config.bindFlags(flagSet)
+		require.NoError(t, flagSet.Parse(nil))
+
+		// when
+		options := ctrl.Options{}
+		config.applyTo(&options)
+
+		// then
+		assert.False(t, options.LeaderElection)
+		assert.Equal(t, defaultLeaderElectionID, options.LeaderElectionID)
+		assert.Empty(t, options.LeaderElectionNamespace)
+		assert.Equal(t, defaultLeaderElectionLeaseDuration, *options.LeaseDuration)
+		assert.Equal(t, defaultLeaderElectionRenewDeadline, *options.RenewDeadline)
+		assert.Equal(t, defaultLeaderElectionRetryPeriod, *options.RetryPeriod)
+	})
+
+	t.Run("Should populate manager options from flags", func(t *testing.T) {
+		// given
+		var config leaderElectionConfig
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		config.bindFlags(flagSet)
+		require.NoError(t, flagSet.Parse([]string{
+			"--leader-elect",
+			"--leader-elect-id=kim-leader",
+			"--leader-elect-namespace=kcp-system",
+			"--leader-elect-lease-duration=30s",
+			"--leader-elect-renew-deadline=20s",
+			"--leader-elect-retry-period=5s",
+		}))
+
+		// when
+		options := ctrl.Options{}
+		config.applyTo(&options)
+
+		// then
+		assert.True(t, options.LeaderElection)
+		assert.Equal(t, "kim-leader", options.LeaderElectionID)
+		assert.Equal(t, "kcp-system", options.LeaderElectionNamespace)
+		assert.Equal(t, 30*time.Second, *options.LeaseDuration)
+		assert.Equal(t, 20*time.Second, *options.RenewDeadline)
+		assert.Equal(t, 5*time.Second, *options.RetryPeriod)
+	})
+}
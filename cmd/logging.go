@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"go.uber.org/zap/zapcore"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	logFormatJSON    = "json"
+	logFormatConsole = "console"
+)
+
+// loggingConfig selects the encoding and the level of the logs, when a value is empty the zap flags are used
+type loggingConfig struct {
+	format string
+	level  string
+}
+
+func (c *loggingConfig) bindFlags(flagSet *flag.FlagSet) {
+	flagSet.StringVar(&c.format, "log-format", "", "Encoding of the logs, one of 'json' or 'console'. When empty, the encoding is selected by the zap flags")
+	flagSet.StringVar(&c.level, "log-level", "", "Minimal level of the logs, one of 'debug', 'info', 'warn' or 'error'. When empty, the level is selected by the zap flags")
+}
+
+// applyTo must be called after the flags are parsed, so the encoder is created with the options of the zap flags
+func (c loggingConfig) applyTo(options *zap.Options) error {
+	switch c.format {
+	case "":
+	case logFormatJSON:
+		zap.JSONEncoder(options.EncoderConfigOptions...)(options)
+	case logFormatConsole:
+		zap.ConsoleEncoder(options.EncoderConfigOptions...)(options)
+	default:
+		return fmt.Errorf("invalid log format %q, expected one of '%s' or '%s'", c.format, logFormatJSON, logFormatConsole)
+	}
+
+	if c.level == "" {
+		return nil
+	}
+
+	level, err := zapcore.ParseLevel(c.level)
+	if err != nil {
+		return fmt.Errorf("invalid log level %q: %w", c.level, err)
+	}
+
+	zap.Level(level)(options)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func TestLoggingConfig(t *testing.T) {
+	t.Run("Should produce parseable JSON lines at the configured level", func(t *testing.T) {
+		// given
+		var config loggingConfig
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		config.bindFlags(flagSet)
+		require.NoError(t, flagSet.Parse([]string{"--log-format=json", "--log-level=info"}))
+
+		var output bytes.Buffer
+		options := zap.Options{Development: true, DestWriter: &output}
+
+		// when
+		require.NoError(t, config.applyTo(&options))
+
+		logger := zap.New(zap.UseFlagOptions(&options)).WithName("runtime-fsm")
+		logger.Info("Reconciling Runtime", "Runtime", "test-runtime")
+		logger.V(1).Info("Debug details")
+		logger.Error(assert.AnError, "Failed to patch shoot")
+
+		// then
+		var lines []map[string]any
+		scanner := bufio.NewScanner(&output)
+		for scanner.Scan() {
+			var line map[string]any
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &line), scanner.Text())
+			lines = append(lines, line)
+		}
+
+		require.Len(t, lines, 2)
+		assert.Equal(t, "Reconciling Runtime", lines[0]["msg"])
+		assert.Equal(t, "runtime-fsm", lines[0]["logger"])
+		assert.Equal(t, "test-runtime", lines[0]["Runtime"])
+		assert.Equal(t, "Failed to patch shoot", lines[1]["msg"])
+		assert.Equal(t, "error", lines[1]["level"])
+	})
+
+	t.Run("Should keep the zap options when no flags are set", func(t *testing.T) {
+		// given
+		var config loggingConfig
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		config.bindFlags(flagSet)
+		require.NoError(t, flagSet.Parse(nil))
+
+		options := zap.Options{}
+
+		// when
+		err := config.applyTo(&options)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, options.Encoder)
+		assert.Nil(t, options.Level)
+	})
+
+	for tname, args := range map[string][]string{
+		"Should fail for unknown log format": {"--log-format=xml"},
+		"Should fail for unknown log level":  {"--log-level=verbose"},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			var config loggingConfig
+			flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+			config.bindFlags(flagSet)
+			require.NoError(t, flagSet.Parse(args))
+
+			// when
+			err := config.applyTo(&zap.Options{})
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
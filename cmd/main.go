@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	registrycachecontroller "github.com/kyma-project/infrastructure-manager/internal/controller/registrycache"
@@ -35,10 +36,13 @@ import (
 	"github.com/go-logr/logr"
 	validator "github.com/go-playground/validator/v10"
 	infrastructuremanagerv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	infrastructuremanagerv2alpha1 "github.com/kyma-project/infrastructure-manager/api/v2alpha1"
 	kubeconfigcontroller "github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
 	runtimecontroller "github.com/kyma-project/infrastructure-manager/internal/controller/runtime"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm"
+	"github.com/kyma-project/infrastructure-manager/internal/tracing"
+	"github.com/kyma-project/infrastructure-manager/internal/webhook"
 	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/kubeconfig"
@@ -49,10 +53,12 @@ import (
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -69,6 +75,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(infrastructuremanagerv1.AddToScheme(scheme))
+	utilruntime.Must(infrastructuremanagerv2alpha1.AddToScheme(scheme))
 	utilruntime.Must(rbacv1.AddToScheme(scheme))
 	utilruntime.Must(gardeneroidc.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
@@ -89,14 +96,22 @@ const (
 	defaultShootReconcileRequeueDuration = 30 * time.Second
 	defaultRuntimeCtrlWorkersCnt         = 25
 	defaultGardenerClusterCtrlWorkersCnt = 25
+	defaultShootOperationTimeout         = 0
+	defaultK8sVersionExpiryWarningPeriod = 30 * 24 * time.Hour
+	defaultKubeconfigSecretNamespace     = "kcp-system"
+	defaultOIDCIssuerValidationTimeout   = 3 * time.Second
+	defaultOrphanedSecretsSweepPeriod    = time.Hour
 )
 
 func main() {
 	var metricsAddr string
-	var enableLeaderElection bool
+	var leaderElection leaderElectionConfig
+	var logging loggingConfig
+	var tracingCfg tracingConfig
 	var probeAddr string
 	var gardenerKubeconfigPath string
 	var gardenerProjectName string
+	var finalizer string
 	var minimalRotationTimeRatio float64
 	var expirationTime time.Duration
 	var gardenerCtrlReconciliationTimeout time.Duration
@@ -105,19 +120,33 @@ func main() {
 	var runtimeCtrlGardenerRateLimiterBurst int
 	var runtimeCtrlWorkersCnt int
 	var gardenerClusterCtrlWorkersCnt int
+	var gardenerClusterDefaultSecretNamespace string
+	var gardenerClusterSecretNameTemplate string
+	var orphanedSecretsSweepPeriod time.Duration
+	var orphanedSecretsDeletionEnabled bool
 	var converterConfigFilepath string
+	var validateConfigOnly bool
 	var auditLogMandatory bool
+	var auditLogUseSeedProvider bool
+	var logShootDiff bool
 	var registryCacheConfigControllerEnabled bool
+	var shootOperationTimeout time.Duration
+	var kubernetesVersionExpiryWarningPeriod time.Duration
+	var conversionWebhookEnabled bool
+	var oidcIssuerValidationEnabled bool
+	var oidcIssuerValidationTimeout time.Duration
+	var oidcIssuerValidationFail bool
 
 	//Kubebuilder related parameters:
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to. Monitoring and alerting tools can use this endpoint to collect application specific metrics during runtime")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to. Kubernetes is using the probe endpoint to determine the health state of the application process")
-	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
-		"Enable leader election for controller manager. "+
-			"Enabling this will ensure there is only one active controller manager.")
+	leaderElection.bindFlags(flag.CommandLine)
+	logging.bindFlags(flag.CommandLine)
+	tracingCfg.bindFlags(flag.CommandLine)
 	//Gardener related parameters:
 	flag.StringVar(&gardenerKubeconfigPath, "gardener-kubeconfig-path", "/gardener/kubeconfig/kubeconfig", "Path to the kubeconfig file by KIM to access the for Gardener cluster")
 	flag.StringVar(&gardenerProjectName, "gardener-project-name", "gardener-project", "Name of the Gardener project which is used for storing Shoot definitions")
+	flag.StringVar(&finalizer, "finalizer", infrastructuremanagerv1.Finalizer, "Finalizer added to the Runtime and GardenerCluster CRs by the controllers. Instances of KIM running against the same cluster must use different finalizers")
 
 	// Kubeconfig Controller specific parameters:
 	flag.Float64Var(&minimalRotationTimeRatio, "minimal-rotation-time", defaultMinimalRotationTimeRatio, "The ratio determines what is the minimal time that needs to pass to rotate the kubeconfig of Shoot clusters. "+
@@ -126,36 +155,95 @@ func main() {
 	flag.DurationVar(&expirationTime, "kubeconfig-expiration-time", defaultExpirationTime, "Expiration time is the maximum age of a Shoot kubeconfig until it is considered as invalid")
 	flag.DurationVar(&gardenerCtrlReconciliationTimeout, "gardener-ctrl-reconcilation-timeout", defaultGardenerReconciliationTimeout, "Timeout duration for reconiling a kubeconfig for Gardener Cluster Controller. The reconciliation of a kubeconfig is cancelled when this timeout is reached")
 	flag.IntVar(&gardenerClusterCtrlWorkersCnt, "gardener-cluster-ctrl-workers-cnt", defaultGardenerClusterCtrlWorkersCnt, "Number of workers running in parallel for Gardener Cluster Controller. The number of parallel workers has an impact on the amount of requests send to the Gardener cluster")
+	flag.StringVar(&gardenerClusterDefaultSecretNamespace, "gardener-cluster-default-secret-namespace", defaultKubeconfigSecretNamespace, "Namespace of the kubeconfig secret used for GardenerCluster CRs which do not set the secret namespace")
+	flag.DurationVar(&orphanedSecretsSweepPeriod, "orphaned-kubeconfig-secrets-sweep-period", defaultOrphanedSecretsSweepPeriod, "Period of the sweep reporting kubeconfig secrets whose GardenerCluster no longer exists. The sweep is disabled when set to 0")
+	flag.BoolVar(&orphanedSecretsDeletionEnabled, "orphaned-kubeconfig-secrets-deletion-enabled", false, "Feature flag to delete the orphaned kubeconfig secrets found by the sweep instead of only reporting them")
+	flag.StringVar(&gardenerClusterSecretNameTemplate, "gardener-cluster-secret-name-template", "", "Template of the kubeconfig secret name used for GardenerCluster CRs which do not set the secret name, rendered with the shoot name (for example `kubeconfig-{{.ShootName}}`)")
 
 	// Runtime Controller specific parameters:
 	flag.DurationVar(&runtimeCtrlGardenerRequestTimeout, "gardener-request-timeout", defaultGardenerRequestTimeout, "Timeout duration for Gardener client for Runtime Controller. Requests to the Gardener cluster are cancelled when this timeout is reached")
 	flag.IntVar(&runtimeCtrlGardenerRateLimiterQPS, "gardener-ratelimiter-qps", defaultGardenerRateLimiterQPS, "Gardener client rate limiter QPS (queries per seconds) for Runtime Controller. The queries per second has direct impact on the load produced for the Gardener cluster (see https://cloud.google.com/config-connector/docs/how-to/customize-controller-manager-rate-limit)")
 	flag.IntVar(&runtimeCtrlGardenerRateLimiterBurst, "gardener-ratelimiter-burst", defaultGardenerRateLimiterBurst, "Gardener client rate limiter burst for Runtime Controller. The burst value allows for more requests than the qps limit for short periods (see https://cloud.google.com/config-connector/docs/how-to/customize-controller-manager-rate-limit)")
 	flag.IntVar(&runtimeCtrlWorkersCnt, "runtime-ctrl-workers-cnt", defaultRuntimeCtrlWorkersCnt, "Number of workers running in parallel for Runtime Controller. The number of parallel workers has an impact on the amount of requests send to the Gardener cluster")
+	flag.DurationVar(&shootOperationTimeout, "shoot-operation-timeout", defaultShootOperationTimeout, "Maximum time a Shoot operation may stay in progress without any update from Gardener before the Runtime is marked as failed. The check is disabled when set to 0")
+	flag.DurationVar(&kubernetesVersionExpiryWarningPeriod, "kubernetes-version-expiry-warning-period", defaultK8sVersionExpiryWarningPeriod, "Time before the expiration of the Shoot's Kubernetes version, taken from the cloud profile, from which the Runtime reports the version as expiring. The check is disabled when set to 0")
 	flag.StringVar(&converterConfigFilepath, "converter-config-filepath", "/converter-config/converter_config.json", "File path to the gardener shoot converter configuration.")
+	flag.BoolVar(&validateConfigOnly, "validate-config", false, "Validate the converter configuration and the audit log tenant configuration, then exit. The exit code is non-zero when the configuration is invalid")
 
 	//Feature flags:
 	flag.BoolVar(&auditLogMandatory, "audit-log-mandatory", true, "Feature flag to enable strict mode for audit log configuration. When enabled this feature, a Shoot cluster will only be created when an auditlog tenant exists (this is defined in the auditlog mapping configuration file)")
+	flag.BoolVar(&auditLogUseSeedProvider, "audit-log-use-seed-provider", false, "Feature flag to select the audit log configuration by the provider and region of the seed the Shoot cluster is scheduled on instead of the provider and region of the Runtime")
+	flag.BoolVar(&logShootDiff, "log-shoot-diff", false, "Feature flag to log at debug level the Shoot fields changed when a Runtime update is applied. Values of sensitive fields are redacted")
 	flag.BoolVar(&registryCacheConfigControllerEnabled, "registry-cache-config-controller-enabled", false, "Feature flag to enable registry cache config controller")
+	flag.BoolVar(&conversionWebhookEnabled, "conversion-webhook-enabled", false, "Feature flag to enable the conversion webhook for Runtime API versions. It requires the webhook server certificates to be mounted")
+	flag.BoolVar(&oidcIssuerValidationEnabled, "oidc-issuer-validation-enabled", false, "Feature flag to enable the validating webhook checking that the OIDC issuers of a Runtime serve a valid discovery document. It requires the webhook server certificates to be mounted")
+	flag.DurationVar(&oidcIssuerValidationTimeout, "oidc-issuer-validation-timeout", defaultOIDCIssuerValidationTimeout, "Timeout for fetching the discovery document of an OIDC issuer by the validating webhook")
+	flag.BoolVar(&oidcIssuerValidationFail, "oidc-issuer-validation-fail", false, "Reject Runtimes with unreachable OIDC issuers instead of returning an admission warning")
 
 	opts := zap.Options{}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	loggingErr := logging.applyTo(&opts)
 	logger := zap.New(zap.UseFlagOptions(&opts))
 	ctrl.SetLogger(logger)
 
+	if loggingErr != nil {
+		setupLog.Error(loggingErr, "invalid logging configuration")
+		os.Exit(1)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingCfg.otlpEndpoint, tracingCfg.otlpInsecure)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
+	if runtimeCtrlWorkersCnt < 1 {
+		setupLog.Error(fmt.Errorf("invalid value %d", runtimeCtrlWorkersCnt), "runtime-ctrl-workers-cnt must be greater than 0")
+		os.Exit(1)
+	}
+
+	if errs := validation.IsQualifiedName(finalizer); len(errs) > 0 {
+		setupLog.Error(fmt.Errorf("invalid value %q: %s", finalizer, strings.Join(errs, ", ")), "finalizer must be a qualified name")
+		os.Exit(1)
+	}
+
+	// load converter configuration
+	getReader := func() (io.Reader, error) {
+		return os.Open(converterConfigFilepath)
+	}
+	var config config.Config
+	if err = config.Load(getReader); err != nil {
+		setupLog.Error(err, "unable to load converter configuration")
+		os.Exit(1)
+	}
+
+	if err = config.Validate(); err != nil {
+		setupLog.Error(err, "invalid converter configuration")
+		os.Exit(1)
+	}
+
+	auditLogDataMap, err := loadAuditLogDataMap(config.ConverterConfig.AuditLog.TenantConfigPath)
+	if err != nil {
+		setupLog.Error(err, "invalid audit log tenant configuration")
+		os.Exit(1)
+	}
+
+	if validateConfigOnly {
+		setupLog.Info("configuration is valid", "converterConfigFilepath", converterConfigFilepath)
+		os.Exit(0)
+	}
+
 	restConfig := ctrl.GetConfigOrDie()
 
-	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
+	mgrOptions := ctrl.Options{
 		Metrics: metricsserver.Options{
 			BindAddress: metricsAddr,
 		},
 
 		Scheme:                 scheme,
 		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "f1c68560.kyma-project.io",
 		Cache:                  restrictWatchedNamespace(),
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
@@ -168,7 +256,10 @@ func main() {
 		// if you are doing or is intended to do any operation such as perform cleanups
 		// after the manager stops then its usage might be unsafe.
 		// LeaderElectionReleaseOnCancel: true,
-	})
+	}
+	leaderElection.applyTo(&mgrOptions)
+
+	mgr, err := ctrl.NewManager(restConfig, mgrOptions)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
@@ -189,6 +280,12 @@ func main() {
 		int64(expirationTime.Seconds()))
 
 	rotationPeriod := time.Duration(minimalRotationTimeRatio*expirationTime.Minutes()) * time.Minute
+	secretNameTemplate, err := kubeconfigcontroller.ParseSecretNameTemplate(gardenerClusterSecretNameTemplate)
+	if err != nil {
+		setupLog.Error(err, "invalid kubeconfig secret name template")
+		os.Exit(1)
+	}
+
 	metrics := metrics.NewMetrics()
 	if err = kubeconfigcontroller.NewGardenerClusterController(
 		mgr,
@@ -197,32 +294,21 @@ func main() {
 		rotationPeriod,
 		minimalRotationTimeRatio,
 		gardenerCtrlReconciliationTimeout,
+		gardenerClusterDefaultSecretNamespace,
+		secretNameTemplate,
+		finalizer,
 		metrics,
+		clock.RealClock{},
 	).SetupWithManager(mgr, gardenerClusterCtrlWorkersCnt); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "GardenerCluster")
 		os.Exit(1)
 	}
 
-	// load converter configuration
-	getReader := func() (io.Reader, error) {
-		return os.Open(converterConfigFilepath)
-	}
-	var config config.Config
-	if err = config.Load(getReader); err != nil {
-		setupLog.Error(err, "unable to load converter configuration")
-		os.Exit(1)
-	}
-
-	validate := validator.New(validator.WithRequiredStructEnabled())
-	if err = validate.Struct(config); err != nil {
-		setupLog.Error(err, "invalid converter configuration")
-		os.Exit(1)
-	}
-
-	auditLogDataMap, err := loadAuditLogDataMap(config.ConverterConfig.AuditLog.TenantConfigPath)
-	if err != nil {
-		setupLog.Error(err, "invalid audit log tenant configuration")
-		os.Exit(1)
+	if orphanedSecretsSweepPeriod > 0 {
+		if err = mgr.Add(kubeconfigcontroller.NewOrphanedSecretsSweeper(mgr.GetClient(), mgr.GetAPIReader(), logger.WithName("orphaned-secrets-sweeper"), orphanedSecretsSweepPeriod, orphanedSecretsDeletionEnabled)); err != nil {
+			setupLog.Error(err, "unable to add orphaned kubeconfig secrets sweeper")
+			os.Exit(1)
+		}
 	}
 
 	cfg := fsm.RCCfg{
@@ -231,13 +317,17 @@ func main() {
 		RequeueDurationShootDelete:           defaultShootDeleteRequeueDuration,
 		RequeueDurationShootReconcile:        defaultShootReconcileRequeueDuration,
 		ControlPlaneRequeueDuration:          defaultControlPlaneRequeueDuration,
-		Finalizer:                            infrastructuremanagerv1.Finalizer,
+		Finalizer:                            finalizer,
 		ShootNamesapace:                      gardenerNamespace,
 		Config:                               config,
 		AuditLogMandatory:                    auditLogMandatory,
 		Metrics:                              metrics,
 		AuditLogging:                         auditLogDataMap,
+		AuditLogUseSeedProvider:              auditLogUseSeedProvider,
+		LogShootDiff:                         logShootDiff,
 		RegistryCacheConfigControllerEnabled: registryCacheConfigControllerEnabled,
+		ShootOperationTimeout:                shootOperationTimeout,
+		KubernetesVersionExpiryWarningPeriod: kubernetesVersionExpiryWarningPeriod,
 	}
 
 	runtimeReconciler := runtimecontroller.NewRuntimeReconciler(
@@ -253,6 +343,21 @@ func main() {
 		os.Exit(1)
 	}
 
+	if conversionWebhookEnabled {
+		if err = (&infrastructuremanagerv1.Runtime{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Runtime")
+			os.Exit(1)
+		}
+	}
+
+	if oidcIssuerValidationEnabled {
+		oidcIssuerValidator := webhook.NewOIDCIssuerValidator(oidcIssuerValidationTimeout, oidcIssuerValidationFail)
+		if err = oidcIssuerValidator.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "RuntimeOIDCIssuer")
+			os.Exit(1)
+		}
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err = mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -267,8 +372,8 @@ func main() {
 	refreshRuntimeMetrics(restConfig, logger, metrics)
 
 	if registryCacheConfigControllerEnabled {
-		registryCacheConfigReconciler := registrycachecontroller.NewRegistryCacheConfigReconciler(mgr, logger, func(secret corev1.Secret) (registrycachecontroller.RegistryCache, error) {
-			runtimeClient, err := gardener.GetRuntimeClient(secret)
+		registryCacheConfigReconciler := registrycachecontroller.NewRegistryCacheConfigReconciler(mgr, logger, func(secret corev1.Secret, kubeconfigSecretKey string) (registrycachecontroller.RegistryCache, error) {
+			runtimeClient, err := gardener.GetRuntimeClient(secret, kubeconfigSecretKey)
 
 			if err != nil {
 				return nil, err
@@ -288,6 +393,10 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "unable to export the remaining tracing spans")
+	}
 }
 
 func initGardenerClients(kubeconfigPath string, namespace string, timeout time.Duration, rlQPS, rlBurst int) (client.Client, gardenerapis.ShootInterface, client.SubResourceClient, error) {
@@ -388,6 +497,11 @@ func restrictWatchedNamespace() cache.Options {
 					"kcp-system": {},
 				},
 			},
+			&corev1.ConfigMap{}: {
+				Namespaces: map[string]cache.Config{
+					"kcp-system": {},
+				},
+			},
 			&infrastructuremanagerv1.Runtime{}: {
 				Namespaces: map[string]cache.Config{
 					"kcp-system": {},
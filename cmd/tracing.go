@@ -0,0 +1,14 @@
+package main
+
+import "flag"
+
+// tracingConfig selects the OTLP endpoint the tracing spans are exported to, tracing is disabled when the endpoint is empty
+type tracingConfig struct {
+	otlpEndpoint string
+	otlpInsecure bool
+}
+
+func (c *tracingConfig) bindFlags(flagSet *flag.FlagSet) {
+	flagSet.StringVar(&c.otlpEndpoint, "tracing-otlp-endpoint", "", "Address (host:port) of the OTLP gRPC endpoint the tracing spans of the Runtime reconciliations are exported to. Tracing is disabled when empty")
+	flagSet.BoolVar(&c.otlpInsecure, "tracing-otlp-insecure", false, "Disable TLS for the connection to the OTLP endpoint")
+}
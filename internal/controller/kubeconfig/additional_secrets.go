@@ -0,0 +1,162 @@
+package kubeconfig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// additionalSecretsNamespace is the only namespace the manager caches secrets of and is allowed to write secrets to
+const additionalSecretsNamespace = "kcp-system"
+
+// defaultAdditionalSecrets sets the namespace of the kubeconfig secret for the additional secrets without namespace.
+// The change is not persisted, the CR spec is left as it is.
+func defaultAdditionalSecrets(cluster *imv1.GardenerCluster) error {
+	seen := map[types.NamespacedName]bool{
+		{Name: cluster.Spec.Kubeconfig.Secret.Name, Namespace: cluster.Spec.Kubeconfig.Secret.Namespace}: true,
+	}
+
+	for i := range cluster.Spec.Kubeconfig.AdditionalSecrets {
+		additionalSecret := &cluster.Spec.Kubeconfig.AdditionalSecrets[i]
+		if additionalSecret.Name == "" {
+			return errors.Errorf("name of additional kubeconfig secret %d is not set", i)
+		}
+
+		if additionalSecret.Namespace == "" {
+			additionalSecret.Namespace = cluster.Spec.Kubeconfig.Secret.Namespace
+		}
+
+		if additionalSecret.Namespace != additionalSecretsNamespace {
+			return errors.Errorf("additional kubeconfig secret `%s` must be in namespace `%s`, namespace `%s` is not supported", additionalSecret.Name, additionalSecretsNamespace, additionalSecret.Namespace)
+		}
+
+		key := types.NamespacedName{Name: additionalSecret.Name, Namespace: additionalSecret.Namespace}
+		if seen[key] {
+			return errors.Errorf("kubeconfig secret `%s` in namespace `%s` is set more than once", key.Name, key.Namespace)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// syncAdditionalSecrets writes the kubeconfig of the cluster secret to every additional secret and removes the additional secrets no longer listed in the CR.
+// It reports whether any additional secret was created, updated or deleted.
+func (controller *GardenerClusterController) syncAdditionalSecrets(ctx context.Context, cluster *imv1.GardenerCluster, kubeconfig []byte, lastSyncTime time.Time) (bool, error) {
+	keep := make(map[types.NamespacedName]bool, len(cluster.Spec.Kubeconfig.AdditionalSecrets))
+	written := false
+
+	for _, additionalSecret := range cluster.Spec.Kubeconfig.AdditionalSecrets {
+		secretWritten, err := controller.syncAdditionalSecret(ctx, cluster, additionalSecret, kubeconfig, lastSyncTime)
+		if err != nil {
+			return written, err
+		}
+		written = written || secretWritten
+		keep[types.NamespacedName{Name: additionalSecret.Name, Namespace: additionalSecret.Namespace}] = true
+	}
+
+	deleted, err := controller.deleteAdditionalSecrets(ctx, cluster.Name, keep)
+	return written || deleted, err
+}
+
+func (controller *GardenerClusterController) syncAdditionalSecret(ctx context.Context, cluster *imv1.GardenerCluster, additionalSecret imv1.Secret, kubeconfig []byte, lastSyncTime time.Time) (bool, error) {
+	lastSync := lastSyncTime.UTC().Format(time.RFC3339)
+
+	var secret corev1.Secret
+	err := controller.Get(ctx, types.NamespacedName{Name: additionalSecret.Name, Namespace: additionalSecret.Namespace}, &secret)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to get additional kubeconfig secret %s in namespace %s: %w", additionalSecret.Name, additionalSecret.Namespace, err)
+	}
+
+	if k8serrors.IsNotFound(err) {
+		newSecret := corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        additionalSecret.Name,
+				Namespace:   additionalSecret.Namespace,
+				Labels:      additionalSecretLabels(*cluster),
+				Annotations: map[string]string{lastKubeconfigSyncAnnotation: lastSync},
+			},
+			Data: map[string][]byte{additionalSecret.Key: kubeconfig},
+		}
+
+		if err := controller.Create(ctx, &newSecret); err != nil {
+			return false, fmt.Errorf("failed to create additional kubeconfig secret %s in namespace %s: %w", additionalSecret.Name, additionalSecret.Namespace, err)
+		}
+
+		controller.log.V(log_level.DEBUG).Info(fmt.Sprintf("Additional secret %s has been created in %s namespace.", newSecret.Name, newSecret.Namespace), loggingContextFromCluster(cluster)...)
+		return true, nil
+	}
+
+	if secret.Labels[additionalSecretClusterCRNameLabel] != cluster.Name {
+		return false, errors.Errorf("secret `%s` in namespace `%s` is not an additional kubeconfig secret of the cluster", secret.Name, secret.Namespace)
+	}
+
+	if secret.Annotations[lastKubeconfigSyncAnnotation] == lastSync && bytes.Equal(secret.Data[additionalSecret.Key], kubeconfig) {
+		return false, nil
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[additionalSecret.Key] = kubeconfig
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[lastKubeconfigSyncAnnotation] = lastSync
+
+	if err := controller.Update(ctx, &secret); err != nil {
+		return false, fmt.Errorf("failed to update additional kubeconfig secret %s in namespace %s: %w", additionalSecret.Name, additionalSecret.Namespace, err)
+	}
+
+	controller.log.V(log_level.DEBUG).Info(fmt.Sprintf("Additional secret %s has been updated in %s namespace.", secret.Name, secret.Namespace), loggingContextFromCluster(cluster)...)
+	return true, nil
+}
+
+// deleteAdditionalSecrets removes the additional kubeconfig secrets of the cluster, except the ones to keep, and reports whether any secret was removed
+func (controller *GardenerClusterController) deleteAdditionalSecrets(ctx context.Context, clusterCRName string, keep map[types.NamespacedName]bool) (bool, error) {
+	var secretList corev1.SecretList
+	err := controller.List(ctx, &secretList, client.MatchingLabels{additionalSecretClusterCRNameLabel: clusterCRName})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return false, err
+	}
+
+	deleted := false
+	for i := range secretList.Items {
+		secret := &secretList.Items[i]
+		if keep[types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}] {
+			continue
+		}
+
+		if err := controller.Delete(ctx, secret); err != nil && !k8serrors.IsNotFound(err) {
+			return deleted, err
+		}
+		deleted = true
+	}
+
+	return deleted, nil
+}
+
+// additionalSecretLabels copies the labels of the cluster except the shoot name, the kubeconfig secret is the only secret looked up by the shoot name
+func additionalSecretLabels(cluster imv1.GardenerCluster) map[string]string {
+	labels := map[string]string{}
+
+	for key, val := range cluster.Labels {
+		labels[key] = val
+	}
+	delete(labels, shootNameLabel)
+	labels["operator.kyma-project.io/managed-by"] = "infrastructure-manager"
+	labels[additionalSecretClusterCRNameLabel] = cluster.Name
+
+	return labels
+}
@@ -0,0 +1,171 @@
+package kubeconfig
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	kubeconfig_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig/mocks"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Additional kubeconfig secrets", func() {
+	const (
+		clusterName     = "additional-cluster"
+		clusterNs       = "kcp-system"
+		shootName       = "additional-shoot"
+		customFinalizer = "custom.kyma-project.io/deletion-hook"
+	)
+
+	var (
+		ctx               = context.Background()
+		startTime         = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		rotationThreshold = time.Duration(rotationPeriodRatio * float64(TestKubeconfigRotationPeriod))
+		requestForCluster = ctrl.Request{NamespacedName: types.NamespacedName{Name: clusterName, Namespace: clusterNs}}
+		additionalSecrets = []imv1.Secret{
+			{Name: "kubeconfig-copy", Key: "config"},
+			{Name: "kubeconfig-copy-2", Namespace: "kcp-system", Key: "kubeconfig"},
+		}
+
+		setupController = func(objects ...client.Object) (*GardenerClusterController, client.Client, *clocktesting.FakeClock) {
+			cluster := fixGardenerClusterCR(clusterName, clusterNs, shootName, "kubeconfig-"+clusterName)
+			cluster.Spec.Kubeconfig.AdditionalSecrets = additionalSecrets
+			cluster.Finalizers = []string{customFinalizer}
+
+			kubeconfigProvider := &kubeconfig_mocks.KubeconfigProvider{}
+			kubeconfigProvider.On("Fetch", mock.Anything, shootName).Return("kubeconfig1", nil).Once()
+			kubeconfigProvider.On("Fetch", mock.Anything, shootName).Return("kubeconfig2", nil)
+
+			fakeClock := clocktesting.NewFakeClock(startTime)
+			controller, kcpClient := newTestGardenerClusterController(cluster).
+				WithObjects(objects...).
+				WithKubeconfigProvider(kubeconfigProvider).
+				WithFinalizer(customFinalizer).
+				WithClock(fakeClock).
+				Build()
+
+			return controller, kcpClient, fakeClock
+		}
+
+		expectAdditionalSecrets = func(kcpClient client.Client, kubeconfig string, lastSync time.Time) {
+			for _, additionalSecret := range []types.NamespacedName{
+				{Name: "kubeconfig-copy", Namespace: clusterNs},
+				{Name: "kubeconfig-copy-2", Namespace: clusterNs},
+			} {
+				var secret corev1.Secret
+				Expect(kcpClient.Get(ctx, additionalSecret, &secret)).To(Succeed())
+				Expect(secret.Labels).To(HaveKeyWithValue(additionalSecretClusterCRNameLabel, clusterName))
+				Expect(secret.Labels).ToNot(HaveKey(shootNameLabel))
+				Expect(secret.Annotations).To(HaveKeyWithValue(lastKubeconfigSyncAnnotation, lastSync.Format(time.RFC3339)))
+				Expect(secret.Data).To(HaveLen(1))
+				for _, value := range secret.Data {
+					Expect(string(value)).To(Equal(kubeconfig))
+				}
+			}
+		}
+
+		additionalSecretsOfCluster = func(kcpClient client.Client) []corev1.Secret {
+			var secretList corev1.SecretList
+			Expect(kcpClient.List(ctx, &secretList, client.MatchingLabels{additionalSecretClusterCRNameLabel: clusterName})).To(Succeed())
+			return secretList.Items
+		}
+	)
+
+	It("Should create, rotate and remove the kubeconfig in every additional secret", func() {
+		controller, kcpClient, fakeClock := setupController()
+
+		By("Creating the additional secrets")
+		_, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+		expectAdditionalSecrets(kcpClient, "kubeconfig1", startTime)
+
+		By("Rotating the additional secrets together with the kubeconfig secret")
+		fakeClock.Step(rotationThreshold)
+		_, err = controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+		expectAdditionalSecrets(kcpClient, "kubeconfig2", startTime.Add(rotationThreshold))
+
+		var primarySecret corev1.Secret
+		Expect(kcpClient.Get(ctx, types.NamespacedName{Name: "kubeconfig-" + clusterName, Namespace: clusterNs}, &primarySecret)).To(Succeed())
+		Expect(string(primarySecret.Data["config"])).To(Equal("kubeconfig2"))
+
+		By("Removing all the secrets on deletion")
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(kcpClient.Delete(ctx, &cluster)).To(Succeed())
+
+		_, err = controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(additionalSecretsOfCluster(kcpClient)).To(BeEmpty())
+		err = kcpClient.Get(ctx, types.NamespacedName{Name: "kubeconfig-" + clusterName, Namespace: clusterNs}, &primarySecret)
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should remove the additional secret no longer listed in the CR", func() {
+		controller, kcpClient, _ := setupController()
+
+		_, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(additionalSecretsOfCluster(kcpClient)).To(HaveLen(2))
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		cluster.Spec.Kubeconfig.AdditionalSecrets = additionalSecrets[:1]
+		Expect(kcpClient.Update(ctx, &cluster)).To(Succeed())
+
+		_, err = controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		remaining := additionalSecretsOfCluster(kcpClient)
+		Expect(remaining).To(HaveLen(1))
+		Expect(remaining[0].Name).To(Equal("kubeconfig-copy"))
+	})
+
+	It("Should not overwrite a secret not managed for the cluster", func() {
+		foreignSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig-copy", Namespace: clusterNs},
+			Data:       map[string][]byte{"config": []byte("foreign")},
+		}
+		controller, kcpClient, _ := setupController(foreignSecret)
+
+		_, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).To(HaveOccurred())
+
+		var secret corev1.Secret
+		Expect(kcpClient.Get(ctx, client.ObjectKeyFromObject(foreignSecret), &secret)).To(Succeed())
+		Expect(string(secret.Data["config"])).To(Equal("foreign"))
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Status.State).To(Equal(imv1.ErrorState))
+	})
+	It("Should reject an additional secret outside of the kcp-system namespace", func() {
+		controller, kcpClient, _ := setupController()
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		cluster.Spec.Kubeconfig.AdditionalSecrets = []imv1.Secret{{Name: "kubeconfig-copy", Namespace: "other-namespace", Key: "config"}}
+		Expect(kcpClient.Update(ctx, &cluster)).To(Succeed())
+
+		_, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(additionalSecretsOfCluster(kcpClient)).To(BeEmpty())
+
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Status.State).To(Equal(imv1.ErrorState))
+		Expect(cluster.Status.Conditions).To(HaveLen(1))
+		Expect(cluster.Status.Conditions[0].Reason).To(Equal(string(imv1.ConditionReasonAdditionalSecretInvalid)))
+		Expect(cluster.Status.Conditions[0].Message).To(ContainSubstring("other-namespace"))
+	})
+})
@@ -0,0 +1,80 @@
+package kubeconfig
+
+import (
+	"context"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("GardenerCluster finalizer", func() {
+	const (
+		clusterName     = "finalizer-cluster"
+		clusterNs       = "kcp-system"
+		shootName       = "finalizer-shoot"
+		customFinalizer = "custom.kyma-project.io/deletion-hook"
+	)
+
+	var (
+		ctx               = context.Background()
+		requestForCluster = ctrl.Request{NamespacedName: types.NamespacedName{Name: clusterName, Namespace: clusterNs}}
+
+		setupController = func(finalizers ...string) (*GardenerClusterController, client.Client) {
+			cluster := fixGardenerClusterCR(clusterName, clusterNs, shootName, "kubeconfig-"+clusterName)
+			cluster.Finalizers = finalizers
+
+			return newTestGardenerClusterController(cluster).
+				WithFinalizer(customFinalizer).
+				Build()
+		}
+
+		kubeconfigSecrets = func(kcpClient client.Client) []corev1.Secret {
+			var secretList corev1.SecretList
+			Expect(kcpClient.List(ctx, &secretList, client.MatchingLabels{clusterCRNameLabel: clusterName})).To(Succeed())
+			return secretList.Items
+		}
+	)
+
+	It("Should add the configured finalizer and remove it together with the secret on deletion", func() {
+		controller, kcpClient := setupController()
+
+		By("Adding the finalizer")
+		_, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Finalizers).To(ConsistOf(customFinalizer))
+		Expect(kubeconfigSecrets(kcpClient)).To(HaveLen(1))
+
+		By("Removing the secret and the finalizer")
+		Expect(kcpClient.Delete(ctx, &cluster)).To(Succeed())
+
+		_, err = controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(kubeconfigSecrets(kcpClient)).To(BeEmpty())
+		err = kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("Should not remove the finalizer of other instance", func() {
+		controller, kcpClient := setupController(imv1.Finalizer)
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(kcpClient.Delete(ctx, &cluster)).To(Succeed())
+
+		_, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Finalizers).To(ConsistOf(imv1.Finalizer))
+	})
+})
@@ -19,11 +19,13 @@ package kubeconfig
 import (
 	"context"
 	"fmt"
+	"text/template"
 	"time"
 
 	"github.com/go-logr/logr"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/status"
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -32,10 +34,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	pkgctrl "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
@@ -43,6 +47,9 @@ const (
 	lastKubeconfigSyncAnnotation      = "operator.kyma-project.io/last-sync"
 	forceKubeconfigRotationAnnotation = "operator.kyma-project.io/force-kubeconfig-rotation"
 	clusterCRNameLabel                = "operator.kyma-project.io/cluster-name"
+	// additionalSecretClusterCRNameLabel marks the additional kubeconfig secrets, it differs from clusterCRNameLabel so the secret of the cluster stays unique
+	additionalSecretClusterCRNameLabel = "operator.kyma-project.io/additional-secret-of-cluster"
+	shootNameLabel                     = "kyma-project.io/shoot-name"
 
 	rotationPeriodRatio = 0.95
 )
@@ -56,10 +63,14 @@ type GardenerClusterController struct {
 	rotationPeriod           time.Duration
 	minimalRotationTimeRatio float64
 	gardenerRequestTimeout   time.Duration
+	defaultSecretNamespace   string
+	secretNameTemplate       *template.Template
+	finalizer                string
 	metrics                  metrics.Metrics
+	clock                    clock.PassiveClock
 }
 
-func NewGardenerClusterController(mgr ctrl.Manager, kubeconfigProvider KubeconfigProvider, logger logr.Logger, rotationPeriod time.Duration, minimalRotationTimeRatio float64, gardenerRequestTimeout time.Duration, metrics metrics.Metrics) *GardenerClusterController {
+func NewGardenerClusterController(mgr ctrl.Manager, kubeconfigProvider KubeconfigProvider, logger logr.Logger, rotationPeriod time.Duration, minimalRotationTimeRatio float64, gardenerRequestTimeout time.Duration, defaultSecretNamespace string, secretNameTemplate *template.Template, finalizer string, metrics metrics.Metrics, clock clock.PassiveClock) *GardenerClusterController {
 	return &GardenerClusterController{
 		Client:                   mgr.GetClient(),
 		Scheme:                   mgr.GetScheme(),
@@ -68,7 +79,11 @@ func NewGardenerClusterController(mgr ctrl.Manager, kubeconfigProvider Kubeconfi
 		rotationPeriod:           rotationPeriod,
 		minimalRotationTimeRatio: minimalRotationTimeRatio,
 		gardenerRequestTimeout:   gardenerRequestTimeout,
+		defaultSecretNamespace:   defaultSecretNamespace,
+		secretNameTemplate:       secretNameTemplate,
+		finalizer:                finalizer,
 		metrics:                  metrics,
+		clock:                    clock,
 	}
 }
 
@@ -114,10 +129,61 @@ func (controller *GardenerClusterController) Reconcile(ctx context.Context, req
 		return controller.resultWithoutRequeue(&cluster), err
 	}
 
+	if !cluster.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, controller.handleDeletion(reconciliationContext, req, &cluster)
+	}
+
+	observedStatus := *cluster.Status.DeepCopy()
+
+	if err := controller.addFinalizerIfNotSet(reconciliationContext, &cluster); err != nil {
+		controller.log.Error(err, "Failed to add finalizer", loggingContext(req)...)
+		return controller.resultWithoutRequeue(&cluster), err
+	}
+
+	if !cluster.Spec.Kubeconfig.IsManaged() {
+		controller.log.Info("Kubeconfig management is disabled, the kubeconfig secrets are left untouched.", loggingContext(req)...)
+		controller.metrics.CleanUpKubeconfigExpiration(cluster.Name)
+		cluster.Status.KubeconfigExpiration = nil
+		cluster.UpdateConditionForReadyState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonManagementDisabled, metav1.ConditionFalse)
+		if err := controller.persistStatusChange(reconciliationContext, &cluster, observedStatus); err != nil {
+			return controller.resultWithoutRequeue(&cluster), err
+		}
+		return controller.resultWithoutRequeue(&cluster), nil
+	}
+
+	if err := controller.defaultSecretNameIfNotSet(&cluster); err != nil {
+		cluster.UpdateConditionForErrorState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonSecretNameNotSet, err)
+		recordSyncFailure(&cluster)
+		_ = controller.persistStatusChange(reconciliationContext, &cluster, observedStatus)
+		return controller.resultWithoutRequeue(&cluster), nil
+	}
+
+	if err := controller.defaultSecretNamespaceIfNotSet(&cluster); err != nil {
+		cluster.UpdateConditionForErrorState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonSecretNamespaceNotSet, err)
+		recordSyncFailure(&cluster)
+		_ = controller.persistStatusChange(reconciliationContext, &cluster, observedStatus)
+		return controller.resultWithoutRequeue(&cluster), nil
+	}
+
+	if err := defaultAdditionalSecrets(&cluster); err != nil {
+		cluster.UpdateConditionForErrorState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonAdditionalSecretInvalid, err)
+		recordSyncFailure(&cluster)
+		_ = controller.persistStatusChange(reconciliationContext, &cluster, observedStatus)
+		return controller.resultWithoutRequeue(&cluster), nil
+	}
+
+	if err := validateSecretKeys(cluster); err != nil {
+		cluster.UpdateConditionForErrorState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonSecretKeyInvalid, err)
+		recordSyncFailure(&cluster)
+		_ = controller.persistStatusChange(reconciliationContext, &cluster, observedStatus)
+		return controller.resultWithoutRequeue(&cluster), nil
+	}
+
 	secret, err := controller.getSecret(reconciliationContext, cluster.Spec.Shoot.Name)
 	if err != nil && !k8serrors.IsNotFound(err) {
 		cluster.UpdateConditionForErrorState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonFailedToGetSecret, err)
-		_ = controller.persistStatusChange(reconciliationContext, &cluster)
+		recordSyncFailure(&cluster)
+		_ = controller.persistStatusChange(reconciliationContext, &cluster, observedStatus)
 		return controller.resultWithoutRequeue(&cluster), err
 	}
 
@@ -127,8 +193,9 @@ func (controller *GardenerClusterController) Reconcile(ctx context.Context, req
 	}
 
 	lastSyncTime, _ := findLastSyncTime(annotations)
-	now := time.Now().UTC()
+	now := controller.clock.Now().UTC()
 	requeueAfter := nextRequeue(now, lastSyncTime, controller.rotationPeriod, rotationPeriodRatio)
+	requeueAfter = requeueBeforeExpiration(&cluster, now, requeueAfter)
 
 	controller.log.V(log_level.DEBUG).WithValues(loggingContextFromCluster(&cluster)...).Info("rotation params",
 		"lastSync", lastSyncTime.Format("2006-01-02 15:04:05"),
@@ -136,9 +203,10 @@ func (controller *GardenerClusterController) Reconcile(ctx context.Context, req
 		"gardenerRequestTimeout", controller.gardenerRequestTimeout.String(),
 	)
 
-	kubeconfigStatus, err := controller.handleKubeconfig(reconciliationContext, secret, &cluster, now)
+	kubeconfigStatus, kubeconfig, err := controller.handleKubeconfig(reconciliationContext, secret, &cluster, now)
 	if err != nil {
-		_ = controller.persistStatusChange(reconciliationContext, &cluster)
+		recordSyncFailure(&cluster)
+		_ = controller.persistStatusChange(reconciliationContext, &cluster, observedStatus)
 		// if a claster was not found in gardener,
 		// CRD should not be rereconciled
 		if k8serrors.IsNotFound(err) {
@@ -147,21 +215,109 @@ func (controller *GardenerClusterController) Reconcile(ctx context.Context, req
 		return controller.resultWithoutRequeue(&cluster), err
 	}
 
+	// the kubeconfig secret was created, modified or rotated
+	synced := kubeconfigStatus != ksZero
+
 	// there was a request to rotate the kubeconfig
 	if kubeconfigStatus == ksRotated {
 		err = controller.removeForceRotationAnnotation(reconciliationContext, &cluster)
 		if err != nil {
 			return controller.resultWithoutRequeue(&cluster), err
 		}
+	} else {
+		if kubeconfigStatus != ksZero {
+			lastSyncTime = now
+		}
+
+		written, err := controller.syncAdditionalSecrets(reconciliationContext, &cluster, kubeconfig, lastSyncTime)
+		if err != nil {
+			cluster.UpdateConditionForErrorState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonFailedToUpdateSecret, err)
+			recordSyncFailure(&cluster)
+			_ = controller.persistStatusChange(reconciliationContext, &cluster, observedStatus)
+			return controller.resultWithoutRequeue(&cluster), err
+		}
+		synced = synced || written
 	}
 
-	if err := controller.persistStatusChange(reconciliationContext, &cluster); err != nil {
+	recordSyncSuccess(&cluster, now, synced)
+	if err := controller.persistStatusChange(reconciliationContext, &cluster, observedStatus); err != nil {
 		return controller.resultWithoutRequeue(&cluster), err
 	}
 
 	return controller.resultWithRequeue(&cluster, requeueAfter), nil
 }
 
+// recordSyncSuccess resets the failure counter and stores the sync time when any secret was written during the reconciliation
+func recordSyncSuccess(cluster *imv1.GardenerCluster, now time.Time, synced bool) {
+	if synced {
+		cluster.Status.LastSuccessfulSyncTime = &metav1.Time{Time: now}
+	}
+	cluster.Status.ConsecutiveFailureCount = 0
+}
+
+func recordSyncFailure(cluster *imv1.GardenerCluster) {
+	cluster.Status.ConsecutiveFailureCount++
+}
+
+// defaultSecretNameIfNotSet renders the secret name template of the controller when the secret name is not set in the CR.
+// The change is not persisted, the CR spec is left as it is.
+func (controller *GardenerClusterController) defaultSecretNameIfNotSet(cluster *imv1.GardenerCluster) error {
+	if cluster.Spec.Kubeconfig.Secret.Name != "" {
+		return nil
+	}
+
+	if controller.secretNameTemplate == nil {
+		return errors.New("name of the kubeconfig secret is not set and no secret name template is configured")
+	}
+
+	secretName, err := renderSecretName(controller.secretNameTemplate, cluster.Spec.Shoot.Name)
+	if err != nil {
+		return err
+	}
+	cluster.Spec.Kubeconfig.Secret.Name = secretName
+
+	return nil
+}
+
+// defaultSecretNamespaceIfNotSet sets the default namespace of the controller when the secret namespace is not set in the CR.
+// The change is not persisted, the CR spec is left as it is.
+func (controller *GardenerClusterController) defaultSecretNamespaceIfNotSet(cluster *imv1.GardenerCluster) error {
+	if cluster.Spec.Kubeconfig.Secret.Namespace == "" {
+		cluster.Spec.Kubeconfig.Secret.Namespace = controller.defaultSecretNamespace
+	}
+
+	if cluster.Spec.Kubeconfig.Secret.Namespace == "" {
+		return errors.Errorf("namespace of secret `%s` is not set and no default namespace is configured", cluster.Spec.Kubeconfig.Secret.Name)
+	}
+
+	return nil
+}
+
+func (controller *GardenerClusterController) addFinalizerIfNotSet(ctx context.Context, cluster *imv1.GardenerCluster) error {
+	if controller.finalizer == "" || !controllerutil.AddFinalizer(cluster, controller.finalizer) {
+		return nil
+	}
+
+	return controller.Update(ctx, cluster)
+}
+
+// handleDeletion removes the kubeconfig secret of the cluster being deleted and releases the cluster by removing the finalizer
+func (controller *GardenerClusterController) handleDeletion(ctx context.Context, req ctrl.Request, cluster *imv1.GardenerCluster) error {
+	if !controllerutil.ContainsFinalizer(cluster, controller.finalizer) {
+		return nil
+	}
+
+	if err := controller.deleteKubeconfigSecret(ctx, cluster.Name); err != nil {
+		controller.log.Error(err, "Failed to delete kubeconfig secret", loggingContext(req)...)
+		return err
+	}
+
+	controller.unsetMetrics(req)
+	controllerutil.RemoveFinalizer(cluster, controller.finalizer)
+
+	return controller.Update(ctx, cluster)
+}
+
 func (controller *GardenerClusterController) unsetMetrics(req ctrl.Request) {
 	controller.metrics.CleanUpGardenerClusterGauge(req.Name)
 	controller.metrics.CleanUpKubeconfigExpiration(req.Name)
@@ -189,8 +345,11 @@ func (controller *GardenerClusterController) resultWithoutRequeue(cluster *imv1.
 	return ctrl.Result{}
 }
 
-func (controller *GardenerClusterController) persistStatusChange(reconciliationContext context.Context, cluster *imv1.GardenerCluster) error {
-	err := controller.Status().Update(reconciliationContext, cluster)
+// persistStatusChange patches the status of the cluster only when it differs from the status observed at the start of the reconciliation,
+// conditions which were not changed by the reconciliation keep their latest state
+func (controller *GardenerClusterController) persistStatusChange(reconciliationContext context.Context, cluster *imv1.GardenerCluster, observedStatus imv1.GardenerClusterStatus) error {
+	status.KeepTransitionTimes(observedStatus.Conditions, cluster.Status.Conditions)
+	_, err := status.PatchIfChanged(reconciliationContext, controller.Client, cluster, observedStatus, cluster.Status, observedStatus.Conditions)
 	if err != nil {
 		controller.log.Error(err, "status update failed")
 	}
@@ -198,6 +357,10 @@ func (controller *GardenerClusterController) persistStatusChange(reconciliationC
 }
 
 func (controller *GardenerClusterController) deleteKubeconfigSecret(reconciliationContext context.Context, clusterCRName string) error {
+	if _, err := controller.deleteAdditionalSecrets(reconciliationContext, clusterCRName, nil); err != nil {
+		return err
+	}
+
 	selector := client.MatchingLabels(map[string]string{
 		clusterCRNameLabel: clusterCRName,
 	})
@@ -225,7 +388,7 @@ func (controller *GardenerClusterController) getSecret(ctx context.Context, shoo
 	var secretList corev1.SecretList
 
 	shootNameSelector := client.MatchingLabels(map[string]string{
-		"kyma-project.io/shoot-name": shootName,
+		shootNameLabel: shootName,
 	})
 
 	err := controller.List(ctx, &secretList, shootNameSelector)
@@ -255,11 +418,12 @@ const (
 	ksRotated
 )
 
-func (controller *GardenerClusterController) handleKubeconfig(ctx context.Context, secret *corev1.Secret, cluster *imv1.GardenerCluster, now time.Time) (kubeconfigStatus, error) {
+// handleKubeconfig creates or rotates the kubeconfig secret of the cluster when needed, it returns the kubeconfig stored in the secret
+func (controller *GardenerClusterController) handleKubeconfig(ctx context.Context, secret *corev1.Secret, cluster *imv1.GardenerCluster, now time.Time) (kubeconfigStatus, []byte, error) {
 	kubeconfig, err := controller.KubeconfigProvider.Fetch(ctx, cluster.Spec.Shoot.Name)
 	if err != nil {
 		cluster.UpdateConditionForErrorState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonFailedToGetKubeconfig, err)
-		return ksZero, err
+		return ksZero, nil, err
 	}
 
 	if secretRotationForced(cluster) {
@@ -269,31 +433,63 @@ func (controller *GardenerClusterController) handleKubeconfig(ctx context.Contex
 		// delete secret containing kubeconfig to be rotated
 		if err := controller.removeKubeconfig(ctx, cluster, secret); err != nil {
 			cluster.UpdateConditionForErrorState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonFailedToDeleteSecret, err)
-			return ksZero, err
+			return ksZero, nil, err
 		}
 
 		controller.metrics.CleanUpKubeconfigExpiration(cluster.Name)
 
-		return ksRotated, nil
+		return ksRotated, nil, nil
 	}
 
 	if !secretNeedsToBeRotated(cluster, secret, controller.rotationPeriod, now) {
 		message := fmt.Sprintf("Secret %s in namespace %s does not need to be rotated yet.", cluster.Spec.Kubeconfig.Secret.Name, cluster.Spec.Kubeconfig.Secret.Namespace)
 		controller.log.V(log_level.DEBUG).Info(message, loggingContextFromCluster(cluster)...)
 		cluster.UpdateConditionForReadyState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonKubeconfigSecretCreated, metav1.ConditionTrue)
+		controller.updateKubeconfigExpiration(cluster, secret.Data[cluster.Spec.Kubeconfig.Secret.Key])
 		controller.metrics.SetKubeconfigExpiration(*secret, controller.rotationPeriod, controller.minimalRotationTimeRatio)
-		return ksZero, nil
+		return ksZero, secret.Data[cluster.Spec.Kubeconfig.Secret.Key], nil
 	}
 
 	if secret != nil {
-		return ksModified, controller.updateExistingSecret(ctx, kubeconfig, cluster, secret, now)
+		return ksModified, []byte(kubeconfig), controller.updateExistingSecret(ctx, kubeconfig, cluster, secret, now)
 	}
 
-	return ksCreated, controller.createNewSecret(ctx, kubeconfig, cluster, now)
+	return ksCreated, []byte(kubeconfig), controller.createNewSecret(ctx, kubeconfig, cluster, now)
 }
 
 func secretNeedsToBeRotated(cluster *imv1.GardenerCluster, secret *corev1.Secret, rotationPeriod time.Duration, now time.Time) bool {
-	return secretRotationTimePassed(secret, rotationPeriod, now) || secretRotationForced(cluster)
+	return secretRotationTimePassed(secret, rotationPeriod, now) || secretRotationForced(cluster) || kubeconfigExpired(cluster, now)
+}
+
+func kubeconfigExpired(cluster *imv1.GardenerCluster, now time.Time) bool {
+	expiration := cluster.Status.KubeconfigExpiration
+	return expiration != nil && !now.Before(expiration.Time)
+}
+
+// requeueBeforeExpiration shortens the requeue period when the kubeconfig expires before the next scheduled rotation
+func requeueBeforeExpiration(cluster *imv1.GardenerCluster, now time.Time, requeueAfter time.Duration) time.Duration {
+	expiration := cluster.Status.KubeconfigExpiration
+	if expiration == nil {
+		return requeueAfter
+	}
+
+	untilExpiration := expiration.Sub(now)
+	if untilExpiration > 0 && untilExpiration < requeueAfter {
+		return untilExpiration
+	}
+
+	return requeueAfter
+}
+
+func (controller *GardenerClusterController) updateKubeconfigExpiration(cluster *imv1.GardenerCluster, kubeconfig []byte) {
+	expiration, err := parseKubeconfigExpiration(kubeconfig)
+	if err != nil {
+		controller.log.V(log_level.DEBUG).Info("Unable to determine kubeconfig expiration", append(loggingContextFromCluster(cluster), "error", err.Error())...)
+		cluster.Status.KubeconfigExpiration = nil
+		return
+	}
+
+	cluster.Status.KubeconfigExpiration = &metav1.Time{Time: expiration}
 }
 
 func secretRotationTimePassed(secret *corev1.Secret, rotationPeriod time.Duration, now time.Time) bool {
@@ -332,6 +528,7 @@ func (controller *GardenerClusterController) createNewSecret(ctx context.Context
 	}
 
 	cluster.UpdateConditionForReadyState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonKubeconfigSecretCreated, metav1.ConditionTrue)
+	controller.updateKubeconfigExpiration(cluster, []byte(kubeconfig))
 	controller.metrics.SetKubeconfigExpiration(newSecret, controller.rotationPeriod, controller.minimalRotationTimeRatio)
 	message := fmt.Sprintf("Secret %s has been created in %s namespace.", newSecret.Name, newSecret.Namespace)
 	controller.log.V(log_level.DEBUG).Info(message, loggingContextFromCluster(cluster)...)
@@ -374,6 +571,7 @@ func (controller *GardenerClusterController) updateExistingSecret(ctx context.Co
 	}
 
 	cluster.UpdateConditionForReadyState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonKubeconfigSecretRotated, metav1.ConditionTrue)
+	controller.updateKubeconfigExpiration(cluster, []byte(kubeconfig))
 	controller.metrics.SetKubeconfigExpiration(*existingSecret, controller.rotationPeriod, controller.minimalRotationTimeRatio)
 
 	message := fmt.Sprintf("Secret %s has been updated in %s namespace.", existingSecret.Name, existingSecret.Namespace)
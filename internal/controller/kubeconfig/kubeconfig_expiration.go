@@ -0,0 +1,83 @@
+package kubeconfig
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var errExpirationNotFound = errors.New("kubeconfig does not contain credentials with an expiration time")
+
+// parseKubeconfigExpiration returns the expiration time of the credentials used by the current context of the kubeconfig.
+// Both client certificates and JWT tokens are supported.
+func parseKubeconfigExpiration(kubeconfig []byte) (time.Time, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to parse kubeconfig")
+	}
+
+	context, found := config.Contexts[config.CurrentContext]
+	if !found {
+		return time.Time{}, errors.Errorf("current context `%s` not found in kubeconfig", config.CurrentContext)
+	}
+
+	authInfo, found := config.AuthInfos[context.AuthInfo]
+	if !found {
+		return time.Time{}, errors.Errorf("user `%s` not found in kubeconfig", context.AuthInfo)
+	}
+
+	if len(authInfo.ClientCertificateData) > 0 {
+		return certificateExpiration(authInfo.ClientCertificateData)
+	}
+
+	if authInfo.Token != "" {
+		return tokenExpiration(authInfo.Token)
+	}
+
+	return time.Time{}, errExpirationNotFound
+}
+
+func certificateExpiration(certificateData []byte) (time.Time, error) {
+	block, _ := pem.Decode(certificateData)
+	if block == nil {
+		return time.Time{}, errors.New("failed to decode client certificate")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to parse client certificate")
+	}
+
+	return certificate.NotAfter.UTC(), nil
+}
+
+func tokenExpiration(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errExpirationNotFound
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to decode token payload")
+	}
+
+	var claims struct {
+		Expiration int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to parse token claims")
+	}
+
+	if claims.Expiration == 0 {
+		return time.Time{}, errExpirationNotFound
+	}
+
+	return time.Unix(claims.Expiration, 0).UTC(), nil
+}
@@ -0,0 +1,128 @@
+package kubeconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	kubeconfig_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig/mocks"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Kubeconfig expiration", func() {
+	var knownExpiration = time.Date(2030, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	DescribeTable("parseKubeconfigExpiration", func(kubeconfig []byte, expected time.Time, expectError bool) {
+		expiration, err := parseKubeconfigExpiration(kubeconfig)
+
+		if expectError {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expiration).To(Equal(expected))
+	},
+		Entry("should read expiration from client certificate", fixKubeconfigWithCertificate(knownExpiration), knownExpiration, false),
+		Entry("should read expiration from token", fixKubeconfigWithToken(fixToken(knownExpiration)), knownExpiration, false),
+		Entry("should fail for token without expiration", fixKubeconfigWithToken("opaque-token"), time.Time{}, true),
+		Entry("should fail for kubeconfig without credentials", fixKubeconfig(&clientcmdapi.AuthInfo{}), time.Time{}, true),
+		Entry("should fail for invalid kubeconfig", []byte("not a kubeconfig"), time.Time{}, true),
+	)
+
+	It("Should populate the kubeconfig expiration in GardenerCluster status", func() {
+		const (
+			clusterName = "expiration-cluster"
+			clusterNs   = "kcp-system"
+			shootName   = "expiration-shoot"
+		)
+
+		ctx := context.Background()
+		kubeconfigProvider := &kubeconfig_mocks.KubeconfigProvider{}
+		kubeconfigProvider.On("Fetch", mock.Anything, shootName).Return(string(fixKubeconfigWithCertificate(knownExpiration)), nil)
+
+		controller, kcpClient := newTestGardenerClusterController(fixGardenerClusterCR(clusterName, clusterNs, shootName, "kubeconfig-"+clusterName)).
+			WithKubeconfigProvider(kubeconfigProvider).
+			WithClock(clocktesting.NewFakeClock(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))).
+			Build()
+
+		key := types.NamespacedName{Name: clusterName, Namespace: clusterNs}
+		_, err := controller.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+
+		var actual imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, key, &actual)).To(Succeed())
+		Expect(actual.Status.KubeconfigExpiration).ToNot(BeNil())
+		Expect(actual.Status.KubeconfigExpiration.UTC()).To(Equal(knownExpiration))
+	})
+
+	DescribeTable("requeueBeforeExpiration", func(expiration *metav1.Time, expected time.Duration) {
+		now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		cluster := imv1.GardenerCluster{Status: imv1.GardenerClusterStatus{KubeconfigExpiration: expiration}}
+
+		Expect(requeueBeforeExpiration(&cluster, now, time.Hour)).To(Equal(expected))
+	},
+		Entry("should keep requeue when expiration is unknown", nil, time.Hour),
+		Entry("should keep requeue when kubeconfig expires later", &metav1.Time{Time: time.Date(2030, 1, 1, 2, 0, 0, 0, time.UTC)}, time.Hour),
+		Entry("should requeue at expiration when kubeconfig expires earlier", &metav1.Time{Time: time.Date(2030, 1, 1, 0, 10, 0, 0, time.UTC)}, 10*time.Minute),
+	)
+})
+
+func fixKubeconfigWithCertificate(notAfter time.Time) []byte {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "admin"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, &template, &template, &privateKey.PublicKey, privateKey)
+	Expect(err).ToNot(HaveOccurred())
+
+	return fixKubeconfig(&clientcmdapi.AuthInfo{
+		ClientCertificateData: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}),
+	})
+}
+
+func fixKubeconfigWithToken(token string) []byte {
+	return fixKubeconfig(&clientcmdapi.AuthInfo{Token: token})
+}
+
+func fixToken(expiration time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, expiration.Unix())))
+	return fmt.Sprintf("%s.%s.signature", header, payload)
+}
+
+func fixKubeconfig(authInfo *clientcmdapi.AuthInfo) []byte {
+	config := clientcmdapi.Config{
+		Clusters:       map[string]*clientcmdapi.Cluster{"shoot": {Server: "https://api.shoot.example.com"}},
+		AuthInfos:      map[string]*clientcmdapi.AuthInfo{"admin": authInfo},
+		Contexts:       map[string]*clientcmdapi.Context{"shoot": {Cluster: "shoot", AuthInfo: "admin"}},
+		CurrentContext: "shoot",
+	}
+
+	kubeconfig, err := clientcmd.Write(config)
+	Expect(err).ToNot(HaveOccurred())
+
+	return kubeconfig
+}
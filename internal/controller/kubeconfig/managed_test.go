@@ -0,0 +1,72 @@
+package kubeconfig
+
+import (
+	"context"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	kubeconfig_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig/mocks"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Kubeconfig management disabled", func() {
+	const (
+		clusterName = "unmanaged-cluster"
+		clusterNs   = "kcp-system"
+		shootName   = "unmanaged-shoot"
+		finalizer   = "test.kyma-project.io/unmanaged"
+	)
+
+	It("Should not manage the kubeconfig secret until the management is enabled", func() {
+		ctx := context.Background()
+		key := types.NamespacedName{Name: clusterName, Namespace: clusterNs}
+
+		cluster := fixGardenerClusterCR(clusterName, clusterNs, shootName, "kubeconfig-"+clusterName)
+		cluster.Spec.Kubeconfig.Managed = ptr.To(false)
+
+		kubeconfigProvider := &kubeconfig_mocks.KubeconfigProvider{}
+		kubeconfigProvider.On("Fetch", mock.Anything, shootName).Return("kubeconfig", nil)
+
+		controller, kcpClient := newTestGardenerClusterController(cluster).
+			WithKubeconfigProvider(kubeconfigProvider).
+			WithFinalizer(finalizer).
+			Build()
+
+		listSecrets := func() []corev1.Secret {
+			var secretList corev1.SecretList
+			Expect(kcpClient.List(ctx, &secretList, client.MatchingLabels{"kyma-project.io/shoot-name": shootName})).To(Succeed())
+			return secretList.Items
+		}
+
+		By("Skipping the secret while the management is disabled")
+		_, err := controller.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listSecrets()).To(BeEmpty())
+		kubeconfigProvider.AssertNotCalled(GinkgoT(), "Fetch", mock.Anything, mock.Anything)
+
+		var actual imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, key, &actual)).To(Succeed())
+		Expect(actual.Finalizers).To(ContainElement(finalizer))
+		Expect(actual.Status.Conditions).To(HaveLen(1))
+		Expect(actual.Status.Conditions[0].Reason).To(Equal(string(imv1.ConditionReasonManagementDisabled)))
+		Expect(actual.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+
+		By("Creating the secret once the management is enabled")
+		actual.Spec.Kubeconfig.Managed = ptr.To(true)
+		Expect(kcpClient.Update(ctx, &actual)).To(Succeed())
+
+		_, err = controller.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listSecrets()).To(HaveLen(1))
+
+		Expect(kcpClient.Get(ctx, key, &actual)).To(Succeed())
+		Expect(actual.Status.Conditions[0].Reason).To(Equal(string(imv1.ConditionReasonKubeconfigSecretCreated)))
+	})
+})
@@ -0,0 +1,123 @@
+package kubeconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OrphanedSecretsSweeper periodically looks for the kubeconfig secrets whose GardenerCluster no longer exists,
+// for example because the GardenerCluster was deleted while the controller was down or the secret labels drifted.
+// The orphaned secrets are reported in the log, they are deleted only when the deletion is enabled.
+// The GardenerClusters are listed from the cache, a secret is reported only when the API reader confirms its GardenerCluster does not exist,
+// so the secret of a GardenerCluster created after the cached list is never deleted.
+type OrphanedSecretsSweeper struct {
+	client.Client  // KcpClient
+	apiReader      client.Reader
+	log            logr.Logger
+	period         time.Duration
+	deleteOrphaned bool
+}
+
+func NewOrphanedSecretsSweeper(kcpClient client.Client, apiReader client.Reader, logger logr.Logger, period time.Duration, deleteOrphaned bool) *OrphanedSecretsSweeper {
+	return &OrphanedSecretsSweeper{
+		Client:         kcpClient,
+		apiReader:      apiReader,
+		log:            logger,
+		period:         period,
+		deleteOrphaned: deleteOrphaned,
+	}
+}
+
+// Start runs the sweep every period until the context is cancelled
+func (sweeper *OrphanedSecretsSweeper) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := sweeper.sweep(ctx); err != nil {
+			sweeper.log.Error(err, "Failed to sweep orphaned kubeconfig secrets")
+		}
+	}, sweeper.period)
+
+	return nil
+}
+
+// sweep returns the orphaned kubeconfig secrets, they are deleted when the deletion is enabled
+func (sweeper *OrphanedSecretsSweeper) sweep(ctx context.Context) ([]corev1.Secret, error) {
+	var clusterList imv1.GardenerClusterList
+	if err := sweeper.List(ctx, &clusterList); err != nil {
+		return nil, err
+	}
+
+	clusters := make(map[string]bool, len(clusterList.Items))
+	for _, cluster := range clusterList.Items {
+		clusters[cluster.Name] = true
+	}
+
+	var secretList corev1.SecretList
+	if err := sweeper.List(ctx, &secretList, client.MatchingLabels{"operator.kyma-project.io/managed-by": "infrastructure-manager"}); err != nil {
+		return nil, err
+	}
+
+	var orphanedSecrets []corev1.Secret
+	for _, secret := range secretList.Items {
+		clusterName := secretClusterName(secret)
+		if clusterName != "" && clusters[clusterName] {
+			continue
+		}
+
+		if clusterName != "" {
+			exists, err := sweeper.clusterExists(ctx, clusterName)
+			if err != nil {
+				return orphanedSecrets, err
+			}
+			if exists {
+				continue
+			}
+		}
+
+		orphanedSecrets = append(orphanedSecrets, secret)
+		sweeper.log.Info("Found orphaned kubeconfig secret", "Secret", secret.Name, "Namespace", secret.Namespace, "GardenerCluster", clusterName, "deletionEnabled", sweeper.deleteOrphaned)
+
+		if !sweeper.deleteOrphaned {
+			continue
+		}
+
+		if err := sweeper.Delete(ctx, &secret); err != nil && !k8serrors.IsNotFound(err) {
+			return orphanedSecrets, err
+		}
+		sweeper.log.Info("Deleted orphaned kubeconfig secret", "Secret", secret.Name, "Namespace", secret.Namespace)
+	}
+
+	return orphanedSecrets, nil
+}
+
+// clusterExists reads the GardenerClusters directly from the API server, the cache may not contain a GardenerCluster created recently.
+// The GardenerClusters are listed as the secret does not carry the namespace of its GardenerCluster.
+func (sweeper *OrphanedSecretsSweeper) clusterExists(ctx context.Context, clusterName string) (bool, error) {
+	var clusterList imv1.GardenerClusterList
+	if err := sweeper.apiReader.List(ctx, &clusterList); err != nil {
+		return false, err
+	}
+
+	for _, cluster := range clusterList.Items {
+		if cluster.Name == clusterName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// secretClusterName returns the name of the GardenerCluster the kubeconfig secret is written for, it is empty when the labels are missing
+func secretClusterName(secret corev1.Secret) string {
+	if clusterName := secret.Labels[clusterCRNameLabel]; clusterName != "" {
+		return clusterName
+	}
+
+	return secret.Labels[additionalSecretClusterCRNameLabel]
+}
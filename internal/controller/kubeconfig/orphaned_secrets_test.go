@@ -0,0 +1,105 @@
+package kubeconfig
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Orphaned kubeconfig secrets sweeper", func() {
+	const clusterNs = "kcp-system"
+
+	var (
+		ctx = context.Background()
+
+		fixManagedSecret = func(name string, labels map[string]string) *corev1.Secret {
+			secretLabels := map[string]string{"operator.kyma-project.io/managed-by": "infrastructure-manager"}
+			for key, val := range labels {
+				secretLabels[key] = val
+			}
+
+			return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: clusterNs, Labels: secretLabels}}
+		}
+
+		// setupSweeper builds the sweeper, the clusters created recently are returned by the API reader only and are missing in the cache
+		setupSweeper = func(deleteOrphaned bool, createdClusters ...client.Object) (*OrphanedSecretsSweeper, client.Client) {
+			scheme := runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(imv1.AddToScheme(scheme)).To(Succeed())
+
+			cluster := fixGardenerClusterCR("existing-cluster", clusterNs, "existing-shoot", "kubeconfig-existing-cluster")
+			kcpClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					&cluster,
+					fixManagedSecret("kubeconfig-existing-cluster", map[string]string{clusterCRNameLabel: "existing-cluster"}),
+					fixManagedSecret("kubeconfig-copy-existing-cluster", map[string]string{additionalSecretClusterCRNameLabel: "existing-cluster"}),
+					fixManagedSecret("kubeconfig-deleted-cluster", map[string]string{clusterCRNameLabel: "deleted-cluster"}),
+					fixManagedSecret("kubeconfig-drifted", nil),
+					&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unmanaged", Namespace: clusterNs}},
+				).
+				Build()
+
+			apiReader := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(append(createdClusters, &cluster)...).
+				Build()
+
+			return NewOrphanedSecretsSweeper(kcpClient, apiReader, logr.Discard(), 0, deleteOrphaned), kcpClient
+		}
+
+		secretNames = func(secrets []corev1.Secret) []string {
+			var names []string
+			for _, secret := range secrets {
+				names = append(names, secret.Name)
+			}
+			return names
+		}
+
+		remainingSecrets = func(kcpClient client.Client) []string {
+			var secretList corev1.SecretList
+			Expect(kcpClient.List(ctx, &secretList)).To(Succeed())
+			return secretNames(secretList.Items)
+		}
+	)
+
+	It("Should report the orphaned secrets without deleting them", func() {
+		sweeper, kcpClient := setupSweeper(false)
+
+		orphanedSecrets, err := sweeper.sweep(ctx)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secretNames(orphanedSecrets)).To(ConsistOf("kubeconfig-deleted-cluster", "kubeconfig-drifted"))
+		Expect(remainingSecrets(kcpClient)).To(HaveLen(5))
+	})
+
+	It("Should delete only the orphaned secrets when the deletion is enabled", func() {
+		sweeper, kcpClient := setupSweeper(true)
+
+		orphanedSecrets, err := sweeper.sweep(ctx)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secretNames(orphanedSecrets)).To(ConsistOf("kubeconfig-deleted-cluster", "kubeconfig-drifted"))
+		Expect(remainingSecrets(kcpClient)).To(ConsistOf("kubeconfig-existing-cluster", "kubeconfig-copy-existing-cluster", "unmanaged"))
+	})
+
+	It("Should not delete the secret of a cluster missing in the cache", func() {
+		createdCluster := fixGardenerClusterCR("deleted-cluster", clusterNs, "created-shoot", "kubeconfig-deleted-cluster")
+		sweeper, kcpClient := setupSweeper(true, &createdCluster)
+
+		orphanedSecrets, err := sweeper.sweep(ctx)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secretNames(orphanedSecrets)).To(ConsistOf("kubeconfig-drifted"))
+		Expect(remainingSecrets(kcpClient)).To(ConsistOf("kubeconfig-existing-cluster", "kubeconfig-copy-existing-cluster", "kubeconfig-deleted-cluster", "unmanaged"))
+	})
+})
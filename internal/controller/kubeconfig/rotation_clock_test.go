@@ -0,0 +1,102 @@
+package kubeconfig
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Kubeconfig rotation driven by the controller clock", func() {
+	const (
+		clusterName    = "clock-cluster"
+		clusterNs      = "kcp-system"
+		shootName      = "clock-shoot"
+		rotationPeriod = 10 * time.Hour
+	)
+
+	var (
+		startTime          = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		rotationThreshold  = time.Duration(rotationPeriodRatio * float64(rotationPeriod))
+		requestForCluster  = ctrl.Request{NamespacedName: types.NamespacedName{Name: clusterName, Namespace: clusterNs}}
+		ctx                = context.Background()
+		fakeClock          *clocktesting.FakeClock
+		controller         *GardenerClusterController
+		kcpClient          client.Client
+		lastSyncAnnotation = func() string {
+			var secretList corev1.SecretList
+			Expect(kcpClient.List(ctx, &secretList, client.MatchingLabels{"kyma-project.io/shoot-name": shootName})).To(Succeed())
+			Expect(secretList.Items).To(HaveLen(1))
+			return secretList.Items[0].Annotations[lastKubeconfigSyncAnnotation]
+		}
+	)
+
+	BeforeEach(func() {
+		fakeClock = clocktesting.NewFakeClock(startTime)
+		controller, kcpClient = newTestGardenerClusterController(fixGardenerClusterCR(clusterName, clusterNs, shootName, "kubeconfig-"+clusterName)).
+			WithRotationPeriod(rotationPeriod).
+			WithClock(fakeClock).
+			Build()
+	})
+
+	It("Should stamp the secret with the time provided by the clock", func() {
+		_, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(lastSyncAnnotation()).To(Equal(startTime.Format(time.RFC3339)))
+	})
+
+	It("Should not rotate the secret before the rotation threshold is reached", func() {
+		_, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeClock.Step(rotationThreshold - time.Second)
+		result, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(lastSyncAnnotation()).To(Equal(startTime.Format(time.RFC3339)))
+		Expect(result.RequeueAfter).To(BeNumerically("~", time.Second, time.Millisecond))
+	})
+
+	It("Should rotate the secret once the clock passes the rotation threshold", func() {
+		_, err := controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeClock.Step(rotationThreshold - time.Second)
+		_, err = controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(lastSyncAnnotation()).To(Equal(startTime.Format(time.RFC3339)))
+
+		fakeClock.Step(time.Second)
+		_, err = controller.Reconcile(ctx, requestForCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(lastSyncAnnotation()).To(Equal(startTime.Add(rotationThreshold).Format(time.RFC3339)))
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Status.Conditions).To(HaveLen(1))
+		Expect(cluster.Status.Conditions[0].Reason).To(Equal(string(imv1.ConditionReasonKubeconfigSecretRotated)))
+	})
+
+	DescribeTable("secretRotationTimePassed", func(elapsed time.Duration, expected bool) {
+		clock := clocktesting.NewFakeClock(startTime)
+		secret := fixNewSecret("secret", clusterNs, "kymaname", shootName, "kubeconfig", startTime.Format(time.RFC3339))
+
+		clock.Step(elapsed)
+
+		Expect(secretRotationTimePassed(&secret, rotationPeriod, clock.Now())).To(Equal(expected))
+	},
+		Entry("should not rotate right after the sync", time.Duration(0), false),
+		Entry("should not rotate a second before the threshold", rotationThreshold-time.Second, false),
+		Entry("should rotate exactly at the threshold", rotationThreshold, true),
+		Entry("should rotate after the threshold", rotationPeriod, true),
+	)
+})
@@ -0,0 +1,23 @@
+package kubeconfig
+
+import (
+	"strings"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// validateSecretKeys checks that the kubeconfig keys of the cluster secret and the additional secrets are valid secret data keys,
+// the CRD validation does not cover the GardenerCluster CRs created before it was introduced
+func validateSecretKeys(cluster imv1.GardenerCluster) error {
+	secrets := append([]imv1.Secret{cluster.Spec.Kubeconfig.Secret}, cluster.Spec.Kubeconfig.AdditionalSecrets...)
+
+	for _, secret := range secrets {
+		if validationErrors := validation.IsConfigMapKey(secret.Key); len(validationErrors) > 0 {
+			return errors.Errorf("key `%s` of kubeconfig secret `%s` is invalid: %s", secret.Key, secret.Name, strings.Join(validationErrors, ", "))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package kubeconfig
+
+import (
+	"context"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Kubeconfig secret key", func() {
+	const (
+		clusterName = "key-cluster"
+		clusterNs   = "kcp-system"
+		shootName   = "key-shoot"
+	)
+
+	var (
+		ctx               = context.Background()
+		requestForCluster = ctrl.Request{NamespacedName: types.NamespacedName{Name: clusterName, Namespace: clusterNs}}
+
+		reconcileWithSecretKey = func(secretKey string) (client.Client, error) {
+			cluster := fixGardenerClusterCR(clusterName, clusterNs, shootName, "kubeconfig-"+clusterName)
+			cluster.Spec.Kubeconfig.Secret.Key = secretKey
+			controller, kcpClient := newTestGardenerClusterController(cluster).Build()
+
+			_, err := controller.Reconcile(ctx, requestForCluster)
+			return kcpClient, err
+		}
+	)
+
+	It("Should write the kubeconfig under the key set in the CR", func() {
+		kcpClient, err := reconcileWithSecretKey("kubeconfig.yaml")
+		Expect(err).ToNot(HaveOccurred())
+
+		var secretList corev1.SecretList
+		Expect(kcpClient.List(ctx, &secretList, client.MatchingLabels{"kyma-project.io/shoot-name": shootName})).To(Succeed())
+		Expect(secretList.Items).To(HaveLen(1))
+
+		// the secret is created with string data, which the fake client does not convert to data
+		Expect(secretList.Items[0].StringData).To(Equal(map[string]string{"kubeconfig.yaml": "kubeconfig"}))
+	})
+
+	It("Should report an error for an invalid key", func() {
+		kcpClient, err := reconcileWithSecretKey("kube/config")
+		Expect(err).ToNot(HaveOccurred())
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Status.State).To(Equal(imv1.ErrorState))
+		Expect(cluster.Status.Conditions).To(HaveLen(1))
+		Expect(cluster.Status.Conditions[0].Reason).To(Equal(string(imv1.ConditionReasonSecretKeyInvalid)))
+
+		var secretList corev1.SecretList
+		Expect(kcpClient.List(ctx, &secretList)).To(Succeed())
+		Expect(secretList.Items).To(BeEmpty())
+	})
+})
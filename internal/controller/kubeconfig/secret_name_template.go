@@ -0,0 +1,44 @@
+package kubeconfig
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type secretNameTemplateData struct {
+	ShootName string
+}
+
+// ParseSecretNameTemplate parses the template used to derive the kubeconfig secret name from the shoot name, for example `kubeconfig-{{.ShootName}}`.
+// Nil is returned for an empty text, which disables the templating.
+func ParseSecretNameTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+
+	secretNameTemplate, err := template.New("secretName").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse kubeconfig secret name template")
+	}
+
+	return secretNameTemplate, nil
+}
+
+// renderSecretName renders the secret name for the shoot and makes sure it is a valid Kubernetes object name
+func renderSecretName(secretNameTemplate *template.Template, shootName string) (string, error) {
+	var rendered bytes.Buffer
+	if err := secretNameTemplate.Execute(&rendered, secretNameTemplateData{ShootName: shootName}); err != nil {
+		return "", errors.Wrap(err, "failed to render kubeconfig secret name template")
+	}
+
+	secretName := rendered.String()
+	if validationErrors := validation.IsDNS1123Subdomain(secretName); len(validationErrors) > 0 {
+		return "", errors.Errorf("rendered kubeconfig secret name `%s` is invalid: %s", secretName, strings.Join(validationErrors, ", "))
+	}
+
+	return secretName, nil
+}
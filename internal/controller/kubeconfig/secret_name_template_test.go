@@ -0,0 +1,116 @@
+package kubeconfig
+
+import (
+	"context"
+	"text/template"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Kubeconfig secret name template", func() {
+	const (
+		clusterName = "name-template-cluster"
+		clusterNs   = "kcp-system"
+		shootName   = "name-template-shoot"
+	)
+
+	DescribeTable("renderSecretName", func(templateText string, expected string, expectError bool) {
+		secretNameTemplate, err := ParseSecretNameTemplate(templateText)
+		Expect(err).ToNot(HaveOccurred())
+
+		secretName, err := renderSecretName(secretNameTemplate, "c-1234")
+
+		if expectError {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secretName).To(Equal(expected))
+	},
+		Entry("should render the shoot name", "kubeconfig-{{.ShootName}}", "kubeconfig-c-1234", false),
+		Entry("should render a static name", "kubeconfig", "kubeconfig", false),
+		Entry("should fail for unknown fields", "kubeconfig-{{.RuntimeID}}", "", true),
+		Entry("should fail for names which are not DNS-safe", "Kubeconfig_{{.ShootName}}", "", true),
+	)
+
+	It("Should return nil template for empty text", func() {
+		secretNameTemplate, err := ParseSecretNameTemplate("")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secretNameTemplate).To(BeNil())
+	})
+
+	It("Should fail for invalid template", func() {
+		_, err := ParseSecretNameTemplate("kubeconfig-{{.ShootName")
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	var (
+		ctx               = context.Background()
+		requestForCluster = ctrl.Request{NamespacedName: types.NamespacedName{Name: clusterName, Namespace: clusterNs}}
+
+		reconcileWithSecretNameTemplate = func(secretName, templateText string) client.Client {
+			var secretNameTemplate *template.Template
+			if templateText != "" {
+				var err error
+				secretNameTemplate, err = ParseSecretNameTemplate(templateText)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			cluster := fixGardenerClusterCR(clusterName, clusterNs, shootName, secretName)
+			controller, kcpClient := newTestGardenerClusterController(cluster).
+				WithSecretNameTemplate(secretNameTemplate).
+				Build()
+
+			_, err := controller.Reconcile(ctx, requestForCluster)
+			Expect(err).ToNot(HaveOccurred())
+
+			return kcpClient
+		}
+
+		kubeconfigSecrets = func(kcpClient client.Client) []corev1.Secret {
+			var secretList corev1.SecretList
+			Expect(kcpClient.List(ctx, &secretList, client.MatchingLabels{"kyma-project.io/shoot-name": shootName})).To(Succeed())
+			return secretList.Items
+		}
+	)
+
+	It("Should create the secret with the name rendered from the template when the CR does not set it", func() {
+		kcpClient := reconcileWithSecretNameTemplate("", "kubeconfig-{{.ShootName}}")
+
+		secrets := kubeconfigSecrets(kcpClient)
+		Expect(secrets).To(HaveLen(1))
+		Expect(secrets[0].Name).To(Equal("kubeconfig-" + shootName))
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Spec.Kubeconfig.Secret.Name).To(BeEmpty())
+	})
+
+	It("Should prefer the secret name set in the CR over the template", func() {
+		kcpClient := reconcileWithSecretNameTemplate("explicit-secret", "kubeconfig-{{.ShootName}}")
+
+		secrets := kubeconfigSecrets(kcpClient)
+		Expect(secrets).To(HaveLen(1))
+		Expect(secrets[0].Name).To(Equal("explicit-secret"))
+	})
+
+	It("Should report an error when neither the CR nor the controller sets the secret name", func() {
+		kcpClient := reconcileWithSecretNameTemplate("", "")
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Status.State).To(Equal(imv1.ErrorState))
+		Expect(cluster.Status.Conditions).To(HaveLen(1))
+		Expect(cluster.Status.Conditions[0].Reason).To(Equal(string(imv1.ConditionReasonSecretNameNotSet)))
+		Expect(kubeconfigSecrets(kcpClient)).To(BeEmpty())
+	})
+})
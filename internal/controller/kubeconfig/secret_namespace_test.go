@@ -0,0 +1,77 @@
+package kubeconfig
+
+import (
+	"context"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Kubeconfig secret namespace", func() {
+	const (
+		clusterName = "namespace-cluster"
+		clusterNs   = "kcp-system"
+		shootName   = "namespace-shoot"
+	)
+
+	var (
+		ctx               = context.Background()
+		requestForCluster = ctrl.Request{NamespacedName: types.NamespacedName{Name: clusterName, Namespace: clusterNs}}
+
+		reconcileWithDefaultNamespace = func(secretNamespace, defaultNamespace string) (client.Client, error) {
+			cluster := fixGardenerClusterCR(clusterName, clusterNs, shootName, "kubeconfig-"+clusterName)
+			cluster.Spec.Kubeconfig.Secret.Namespace = secretNamespace
+			controller, kcpClient := newTestGardenerClusterController(cluster).
+				WithDefaultSecretNamespace(defaultNamespace).
+				Build()
+
+			_, err := controller.Reconcile(ctx, requestForCluster)
+			return kcpClient, err
+		}
+
+		kubeconfigSecret = func(kcpClient client.Client) corev1.Secret {
+			var secretList corev1.SecretList
+			Expect(kcpClient.List(ctx, &secretList, client.MatchingLabels{"kyma-project.io/shoot-name": shootName})).To(Succeed())
+			Expect(secretList.Items).To(HaveLen(1))
+			return secretList.Items[0]
+		}
+	)
+
+	It("Should create the secret in the namespace set in the CR", func() {
+		kcpClient, err := reconcileWithDefaultNamespace("explicit-namespace", "default-namespace")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(kubeconfigSecret(kcpClient).Namespace).To(Equal("explicit-namespace"))
+	})
+
+	It("Should create the secret in the default namespace when the CR does not set it", func() {
+		kcpClient, err := reconcileWithDefaultNamespace("", "default-namespace")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(kubeconfigSecret(kcpClient).Namespace).To(Equal("default-namespace"))
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Spec.Kubeconfig.Secret.Namespace).To(BeEmpty())
+	})
+
+	It("Should report an error when neither the CR nor the controller sets the namespace", func() {
+		kcpClient, err := reconcileWithDefaultNamespace("", "")
+		Expect(err).ToNot(HaveOccurred())
+
+		var cluster imv1.GardenerCluster
+		Expect(kcpClient.Get(ctx, requestForCluster.NamespacedName, &cluster)).To(Succeed())
+		Expect(cluster.Status.State).To(Equal(imv1.ErrorState))
+		Expect(cluster.Status.Conditions).To(HaveLen(1))
+		Expect(cluster.Status.Conditions[0].Reason).To(Equal(string(imv1.ConditionReasonSecretNamespaceNotSet)))
+
+		var secretList corev1.SecretList
+		Expect(kcpClient.List(ctx, &secretList)).To(Succeed())
+		Expect(secretList.Items).To(BeEmpty())
+	})
+})
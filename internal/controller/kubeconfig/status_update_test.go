@@ -0,0 +1,102 @@
+package kubeconfig
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	kubeconfig_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig/mocks"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("GardenerCluster status update", func() {
+	const (
+		clusterName = "status-update-cluster"
+		clusterNs   = "kcp-system"
+		shootName   = "status-update-shoot"
+	)
+
+	It("Should write the status only when it changed", func() {
+		ctx := context.Background()
+		now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		kubeconfigProvider := &kubeconfig_mocks.KubeconfigProvider{}
+		kubeconfigProvider.On("Fetch", mock.Anything, shootName).Return(string(fixKubeconfigWithCertificate(now.Add(24*time.Hour))), nil).Times(3)
+		kubeconfigProvider.On("Fetch", mock.Anything, shootName).Return("", errors.New("gardener unavailable"))
+
+		statusPatches := 0
+		fakeClock := clocktesting.NewFakeClock(now)
+		controller, kcpClient := newTestGardenerClusterController(fixGardenerClusterCR(clusterName, clusterNs, shootName, "kubeconfig-"+clusterName)).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+					statusPatches++
+					return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+				},
+			}).
+			WithKubeconfigProvider(kubeconfigProvider).
+			WithClock(fakeClock).
+			Build()
+
+		request := ctrl.Request{NamespacedName: types.NamespacedName{Name: clusterName, Namespace: clusterNs}}
+
+		By("Writing the status when the kubeconfig secret is created")
+		_, err := controller.Reconcile(ctx, request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statusPatches).To(Equal(1))
+
+		// the secret is created with string data, which the fake client does not convert to data like the API server does
+		var secretList corev1.SecretList
+		Expect(kcpClient.List(ctx, &secretList, client.MatchingLabels{"kyma-project.io/shoot-name": shootName})).To(Succeed())
+		Expect(secretList.Items).To(HaveLen(1))
+		secret := secretList.Items[0]
+		secret.Data = map[string][]byte{}
+		for key, value := range secret.StringData {
+			secret.Data[key] = []byte(value)
+		}
+		secret.StringData = nil
+		Expect(kcpClient.Update(ctx, &secret)).To(Succeed())
+
+		getCluster := func() imv1.GardenerCluster {
+			var actual imv1.GardenerCluster
+			Expect(kcpClient.Get(ctx, request.NamespacedName, &actual)).To(Succeed())
+			return actual
+		}
+		Expect(getCluster().Status.LastSuccessfulSyncTime.UTC()).To(Equal(now))
+
+		By("Skipping the status write when nothing changed")
+		fakeClock.Step(time.Minute)
+		_, err = controller.Reconcile(ctx, request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statusPatches).To(Equal(1))
+		Expect(getCluster().Status.LastSuccessfulSyncTime.UTC()).To(Equal(now))
+
+		By("Writing the sync time when the kubeconfig secret is rotated")
+		fakeClock.Step(time.Minute)
+		rotatedCluster := getCluster()
+		rotatedCluster.Annotations = map[string]string{forceKubeconfigRotationAnnotation: "true"}
+		Expect(kcpClient.Update(ctx, &rotatedCluster)).To(Succeed())
+
+		_, err = controller.Reconcile(ctx, request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statusPatches).To(Equal(2))
+		Expect(getCluster().Status.LastSuccessfulSyncTime.UTC()).To(Equal(now.Add(2 * time.Minute)))
+
+		By("Writing the status when the reconciliation fails")
+		_, err = controller.Reconcile(ctx, request)
+		Expect(err).To(HaveOccurred())
+		Expect(statusPatches).To(Equal(3))
+
+		actual := getCluster()
+		Expect(actual.Status.State).To(Equal(imv1.ErrorState))
+		Expect(actual.Status.ConsecutiveFailureCount).To(Equal(1))
+	})
+})
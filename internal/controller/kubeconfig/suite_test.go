@@ -20,19 +20,26 @@ import (
 	"context"
 	"path/filepath"
 	"testing"
+	"text/template"
 	"time"
 
+	"github.com/go-logr/logr"
 	infrastructuremanagerv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	kubeconfig_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig/mocks"
 	metrics "github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
+	metrics_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
 	. "github.com/onsi/ginkgo/v2" //nolint:revive
 	. "github.com/onsi/gomega"    //nolint:revive
 	"github.com/pkg/errors"
 	. "github.com/stretchr/testify/mock" //nolint:revive
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	clocktesting "k8s.io/utils/clock/testing"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -48,6 +55,7 @@ var (
 	suiteCtx       context.Context                                           //nolint:gochecknoglobals
 	cancelSuiteCtx context.CancelFunc                                        //nolint:gochecknoglobals
 	anyContext     = MatchedBy(func(_ context.Context) bool { return true }) //nolint:gochecknoglobals
+	suiteClock     *clocktesting.FakeClock                                   //nolint:gochecknoglobals
 )
 
 const TestMinimalRotationTimeRatio = 0.5
@@ -87,8 +95,9 @@ var _ = BeforeSuite(func() {
 	setupKubeconfigProviderMock(kubeconfigProviderMock)
 
 	metrics := metrics.NewMetrics()
+	suiteClock = clocktesting.NewFakeClock(time.Now())
 
-	gardenerClusterController := NewGardenerClusterController(mgr, kubeconfigProviderMock, logger, TestKubeconfigRotationPeriod, TestMinimalRotationTimeRatio, TestGardenerRequestTimeout, metrics)
+	gardenerClusterController := NewGardenerClusterController(mgr, kubeconfigProviderMock, logger, TestKubeconfigRotationPeriod, TestMinimalRotationTimeRatio, TestGardenerRequestTimeout, "", nil, infrastructuremanagerv1.Finalizer, metrics, suiteClock)
 
 	Expect(gardenerClusterController).NotTo(BeNil())
 
@@ -121,6 +130,115 @@ func setupKubeconfigProviderMock(kpMock *kubeconfig_mocks.KubeconfigProvider) {
 	kpMock.On("Fetch", anyContext, "shootName5").Return("kubeconfig5", nil)
 }
 
+// TestGardenerClusterController builds a controller backed by a fake client for the specs which
+// reconcile a single GardenerCluster without the envtest manager.
+type TestGardenerClusterController struct {
+	cluster                infrastructuremanagerv1.GardenerCluster
+	objects                []client.Object
+	interceptorFuncs       interceptor.Funcs
+	kubeconfigProvider     *kubeconfig_mocks.KubeconfigProvider
+	rotationPeriod         time.Duration
+	defaultSecretNamespace string
+	secretNameTemplate     *template.Template
+	finalizer              string
+	clock                  *clocktesting.FakeClock
+}
+
+func newTestGardenerClusterController(cluster infrastructuremanagerv1.GardenerCluster) *TestGardenerClusterController {
+	kubeconfigProvider := &kubeconfig_mocks.KubeconfigProvider{}
+	kubeconfigProvider.On("Fetch", Anything, cluster.Spec.Shoot.Name).Return("kubeconfig", nil)
+
+	return &TestGardenerClusterController{
+		cluster:            cluster,
+		kubeconfigProvider: kubeconfigProvider,
+		rotationPeriod:     TestKubeconfigRotationPeriod,
+		clock:              clocktesting.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+}
+
+func (tc *TestGardenerClusterController) WithObjects(objects ...client.Object) *TestGardenerClusterController {
+	tc.objects = append(tc.objects, objects...)
+
+	return tc
+}
+
+func (tc *TestGardenerClusterController) WithInterceptorFuncs(interceptorFuncs interceptor.Funcs) *TestGardenerClusterController {
+	tc.interceptorFuncs = interceptorFuncs
+
+	return tc
+}
+
+func (tc *TestGardenerClusterController) WithKubeconfigProvider(kubeconfigProvider *kubeconfig_mocks.KubeconfigProvider) *TestGardenerClusterController {
+	tc.kubeconfigProvider = kubeconfigProvider
+
+	return tc
+}
+
+func (tc *TestGardenerClusterController) WithRotationPeriod(rotationPeriod time.Duration) *TestGardenerClusterController {
+	tc.rotationPeriod = rotationPeriod
+
+	return tc
+}
+
+func (tc *TestGardenerClusterController) WithDefaultSecretNamespace(defaultSecretNamespace string) *TestGardenerClusterController {
+	tc.defaultSecretNamespace = defaultSecretNamespace
+
+	return tc
+}
+
+func (tc *TestGardenerClusterController) WithSecretNameTemplate(secretNameTemplate *template.Template) *TestGardenerClusterController {
+	tc.secretNameTemplate = secretNameTemplate
+
+	return tc
+}
+
+func (tc *TestGardenerClusterController) WithFinalizer(finalizer string) *TestGardenerClusterController {
+	tc.finalizer = finalizer
+
+	return tc
+}
+
+func (tc *TestGardenerClusterController) WithClock(clock *clocktesting.FakeClock) *TestGardenerClusterController {
+	tc.clock = clock
+
+	return tc
+}
+
+func (tc *TestGardenerClusterController) Build() (*GardenerClusterController, client.Client) {
+	testScheme := runtime.NewScheme()
+	Expect(scheme.AddToScheme(testScheme)).To(Succeed())
+	Expect(infrastructuremanagerv1.AddToScheme(testScheme)).To(Succeed())
+
+	cluster := tc.cluster
+	kcpClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(append(tc.objects, &cluster)...).
+		WithStatusSubresource(&cluster).
+		WithInterceptorFuncs(tc.interceptorFuncs).
+		Build()
+
+	metricsMock := &metrics_mocks.Metrics{}
+	metricsMock.On("SetGardenerClusterStates", Anything).Return()
+	metricsMock.On("SetKubeconfigExpiration", Anything, Anything, Anything).Return()
+	metricsMock.On("CleanUpGardenerClusterGauge", Anything).Return()
+	metricsMock.On("CleanUpKubeconfigExpiration", Anything).Return()
+
+	return &GardenerClusterController{
+		Client:                   kcpClient,
+		Scheme:                   testScheme,
+		KubeconfigProvider:       tc.kubeconfigProvider,
+		log:                      logr.Discard(),
+		rotationPeriod:           tc.rotationPeriod,
+		minimalRotationTimeRatio: TestMinimalRotationTimeRatio,
+		gardenerRequestTimeout:   TestGardenerRequestTimeout,
+		defaultSecretNamespace:   tc.defaultSecretNamespace,
+		secretNameTemplate:       tc.secretNameTemplate,
+		finalizer:                tc.finalizer,
+		metrics:                  metricsMock,
+		clock:                    tc.clock,
+	}, kcpClient
+}
+
 var _ = AfterSuite(func() {
 	By("tearing down the test environment")
 	cancelSuiteCtx()
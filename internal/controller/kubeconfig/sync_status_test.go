@@ -0,0 +1,64 @@
+package kubeconfig
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	kubeconfig_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig/mocks"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("GardenerCluster sync status", func() {
+	const (
+		clusterName = "sync-status-cluster"
+		clusterNs   = "kcp-system"
+		shootName   = "sync-status-shoot"
+	)
+
+	It("Should count consecutive failures and reset the counter on success", func() {
+		ctx := context.Background()
+		now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		kubeconfigProvider := &kubeconfig_mocks.KubeconfigProvider{}
+		kubeconfigProvider.On("Fetch", mock.Anything, shootName).Return("", errors.New("gardener unavailable")).Times(2)
+		kubeconfigProvider.On("Fetch", mock.Anything, shootName).Return(string(fixKubeconfigWithCertificate(now.Add(24*time.Hour))), nil)
+
+		controller, kcpClient := newTestGardenerClusterController(fixGardenerClusterCR(clusterName, clusterNs, shootName, "kubeconfig-"+clusterName)).
+			WithKubeconfigProvider(kubeconfigProvider).
+			WithClock(clocktesting.NewFakeClock(now)).
+			Build()
+
+		key := types.NamespacedName{Name: clusterName, Namespace: clusterNs}
+		getStatus := func() imv1.GardenerClusterStatus {
+			var actual imv1.GardenerCluster
+			Expect(kcpClient.Get(ctx, key, &actual)).To(Succeed())
+			return actual.Status
+		}
+
+		By("Incrementing the failure count on each failed reconciliation")
+		for expectedFailures := 1; expectedFailures <= 2; expectedFailures++ {
+			_, err := controller.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+			Expect(err).To(HaveOccurred())
+
+			status := getStatus()
+			Expect(status.ConsecutiveFailureCount).To(Equal(expectedFailures))
+			Expect(status.LastSuccessfulSyncTime).To(BeNil())
+		}
+
+		By("Resetting the failure count and setting the sync time on success")
+		_, err := controller.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).ToNot(HaveOccurred())
+
+		status := getStatus()
+		Expect(status.ConsecutiveFailureCount).To(BeZero())
+		Expect(status.LastSuccessfulSyncTime).ToNot(BeNil())
+		Expect(status.LastSuccessfulSyncTime.UTC()).To(Equal(now))
+	})
+})
@@ -22,6 +22,7 @@ const (
 	GardenerClusterStateMetricName = "im_gardener_clusters_state"
 	RuntimeStateMetricName         = "im_runtime_state"
 	RuntimeFSMStopMetricName       = "unexpected_stops_total"
+	RuntimeProvisioningMetricName  = "im_runtime_provisioning_duration_seconds"
 	provider                       = "provider"
 	state                          = "state"
 	reason                         = "reason"
@@ -41,6 +42,7 @@ type Metrics interface {
 	CleanUpGardenerClusterGauge(runtimeID string)
 	CleanUpKubeconfigExpiration(runtimeID string)
 	SetKubeconfigExpiration(secret corev1.Secret, rotationPeriod time.Duration, minimalRotationTimeRatio float64)
+	ObserveRuntimeProvisioningDuration(runtime v1.Runtime, duration time.Duration)
 }
 
 type metricsImpl struct {
@@ -48,6 +50,7 @@ type metricsImpl struct {
 	kubeconfigExpirationGauge     *prometheus.GaugeVec
 	runtimeStateGauge             *prometheus.GaugeVec
 	runtimeFSMUnexpectedStopsCnt  prometheus.Counter
+	runtimeProvisioningHistogram  *prometheus.HistogramVec
 }
 
 func NewMetrics() Metrics {
@@ -75,8 +78,15 @@ func NewMetrics() Metrics {
 				Name: RuntimeFSMStopMetricName,
 				Help: "Exposes the number of unexpected state machine stop events",
 			}),
+		runtimeProvisioningHistogram: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Subsystem: componentName,
+				Name:      RuntimeProvisioningMetricName,
+				Help:      "Exposes the time from Runtime CR creation until the Runtime is provisioned for the first time",
+				Buckets:   prometheus.ExponentialBuckets(60, 1.5, 12),
+			}, []string{provider}),
 	}
-	ctrlMetrics.Registry.MustRegister(m.gardenerClustersStateGaugeVec, m.kubeconfigExpirationGauge, m.runtimeStateGauge, m.runtimeFSMUnexpectedStopsCnt)
+	ctrlMetrics.Registry.MustRegister(m.gardenerClustersStateGaugeVec, m.kubeconfigExpirationGauge, m.runtimeStateGauge, m.runtimeFSMUnexpectedStopsCnt, m.runtimeProvisioningHistogram)
 	return m
 }
 
@@ -111,6 +121,10 @@ func (m metricsImpl) IncRuntimeFSMStopCounter() {
 	m.runtimeFSMUnexpectedStopsCnt.Inc()
 }
 
+func (m metricsImpl) ObserveRuntimeProvisioningDuration(runtime v1.Runtime, duration time.Duration) {
+	m.runtimeProvisioningHistogram.WithLabelValues(runtime.Spec.Shoot.Provider.Type).Observe(duration.Seconds())
+}
+
 func (m metricsImpl) SetGardenerClusterStates(cluster v1.GardenerCluster) {
 	var runtimeID = cluster.GetLabels()[RuntimeIDLabel]
 	var shootName = cluster.GetLabels()[ShootNameLabel]
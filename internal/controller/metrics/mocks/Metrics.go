@@ -35,6 +35,11 @@ func (_m *Metrics) IncRuntimeFSMStopCounter() {
 	_m.Called()
 }
 
+// ObserveRuntimeProvisioningDuration provides a mock function with given fields: runtime, duration
+func (_m *Metrics) ObserveRuntimeProvisioningDuration(runtime v1.Runtime, duration time.Duration) {
+	_m.Called(runtime, duration)
+}
+
 // ResetRuntimeMetrics provides a mock function with given fields:
 func (_m *Metrics) ResetRuntimeMetrics() {
 	_m.Called()
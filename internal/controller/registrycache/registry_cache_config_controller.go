@@ -68,7 +68,7 @@ func (r *RegistryCacheConfigReconciler) Reconcile(ctx context.Context, request c
 
 func (r *RegistryCacheConfigReconciler) reconcileRegistryCacheConfig(ctx context.Context, secret corev1.Secret, runtime imv1.Runtime) (ctrl.Result, error) {
 
-	registryCache, err := r.RegistryCacheCreator(secret)
+	registryCache, err := r.RegistryCacheCreator(secret, runtime.GetKubeconfigSecretKey())
 	if err != nil {
 		r.Log.V(log_level.TRACE).Error(err, "Failed to get runtime client for runtime", "RuntimeID", runtime.Name, "Namespace", runtime.Namespace)
 
@@ -138,7 +138,8 @@ type RegistryCache interface {
 	GetRegistryCacheConfig() ([]registrycache.RegistryCacheConfig, error)
 }
 
-type RegistryCacheCreator func(secret corev1.Secret) (RegistryCache, error)
+// RegistryCacheCreator returns the registry cache of the runtime cluster, the kubeconfig is read from the given key of the secret data
+type RegistryCacheCreator func(secret corev1.Secret, kubeconfigSecretKey string) (RegistryCache, error)
 
 func NewRegistryCacheConfigReconciler(mgr ctrl.Manager, logger logr.Logger, registryCacheCreator RegistryCacheCreator) *RegistryCacheConfigReconciler {
 	return &RegistryCacheConfigReconciler{
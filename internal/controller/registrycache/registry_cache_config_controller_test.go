@@ -129,7 +129,7 @@ var _ = Describe("Registry Cache Config Controller", func() {
 	})
 })
 
-func fixMockedRegistryCache() func(secret v1.Secret) (RegistryCache, error) {
+func fixMockedRegistryCache() func(secret v1.Secret, kubeconfigSecretKey string) (RegistryCache, error) {
 	callsMap := map[string]int{
 		secretForClusterWithRegistryCacheConfig1: 0,
 		secretForClusterWithRegistryCacheConfig2: 0,
@@ -163,7 +163,7 @@ func fixMockedRegistryCache() func(secret v1.Secret) (RegistryCache, error) {
 		secretNotManagedByKIM:                    testConfig,
 	}
 
-	return func(secret v1.Secret) (RegistryCache, error) {
+	return func(secret v1.Secret, _ string) (RegistryCache, error) {
 
 		if _, found := callsMap[secret.Name]; !found {
 			return nil, errors.Errorf("unexpected secret name %s", secret.Name)
@@ -0,0 +1,37 @@
+package fsm
+
+import (
+	"errors"
+	"fmt"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// updateAuditLogCondition reports the outcome of reading the audit log configuration of the Runtime.
+// A missing configuration is reported as skipped unless the audit logging is mandatory, any other error as a failure.
+func updateAuditLogCondition(m *fsm, s *systemState, err error) {
+	condition := metav1.Condition{
+		Type:               string(imv1.ConditionTypeAuditLog),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(imv1.ConditionReasonAuditLogConfigured),
+		Message:            "Audit logging is configured",
+		ObservedGeneration: s.instance.Generation,
+	}
+
+	switch {
+	case err == nil:
+	case errors.Is(err, auditlogs.ErrConfigurationNotFound) && !m.AuditLogMandatory:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(imv1.ConditionReasonAuditLogSkipped)
+		condition.Message = fmt.Sprintf("Audit logging is not configured: %s", err)
+	default:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(imv1.ConditionReasonAuditLogError)
+		condition.Message = fmt.Sprintf("%s: %s", msgFailedToConfigureAuditlogs, err)
+	}
+
+	meta.SetStatusCondition(&s.instance.Status.Conditions, condition)
+}
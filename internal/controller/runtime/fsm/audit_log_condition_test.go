@@ -0,0 +1,86 @@
+package fsm
+
+import (
+	"errors"
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateAuditLogCondition(t *testing.T) {
+	RegisterTestingT(t)
+
+	auditLogData := auditlogs.AuditLogData{
+		TenantID:   "tenant-id",
+		ServiceURL: "https://auditlog.example.com",
+		SecretName: "auditlog-secret",
+	}
+
+	for tname, tc := range map[string]struct {
+		region          string
+		mandatory       bool
+		err             error
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  imv1.RuntimeConditionReason
+		expectedMessage string
+	}{
+		"Should report configured audit logging": {
+			region:          "region",
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  imv1.ConditionReasonAuditLogConfigured,
+			expectedMessage: "Audit logging is configured",
+		},
+		"Should report skipped audit logging when there is no data for the region": {
+			region:          "other-region",
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  imv1.ConditionReasonAuditLogSkipped,
+			expectedMessage: "Audit logging is not configured: audit logs configuration not found",
+		},
+		"Should report failed audit logging when there is no data for the region and audit logging is mandatory": {
+			region:          "other-region",
+			mandatory:       true,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  imv1.ConditionReasonAuditLogError,
+			expectedMessage: "Failed to configure audit logs: audit logs configuration not found",
+		},
+		"Should report failed audit logging": {
+			region:          "region",
+			err:             errors.New("failed to get seed"),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  imv1.ConditionReasonAuditLogError,
+			expectedMessage: "Failed to configure audit logs: failed to get seed",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			testFsm := must(newFakeFSM,
+				withAuditLogConfig("gcp", "region", auditLogData),
+				withAuditLogMandatory(tc.mandatory),
+			)
+			runtime := makeInputRuntimeWithAnnotation(nil)
+			runtime.Spec.Shoot.Region = tc.region
+			systemState := &systemState{instance: *runtime}
+
+			_, err := testFsm.AuditLogging.GetAuditLogData(runtime.Spec.Shoot.Provider.Type, runtime.Spec.Shoot.Region)
+			if tc.err != nil {
+				err = tc.err
+			}
+
+			// when
+			updateAuditLogCondition(testFsm, systemState, err)
+
+			// then
+			condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeAuditLog))
+			require.NotNil(t, condition)
+			assert.Equal(t, tc.expectedStatus, condition.Status)
+			assert.Equal(t, string(tc.expectedReason), condition.Reason)
+			assert.Contains(t, condition.Message, tc.expectedMessage)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	core_v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const msgFailedToVerifyAuditPolicyConfigMap = "Failed to verify whether the audit policy config map exists"
+
+// verifyAuditPolicyConfigMap checks that the audit policy config map referenced by the shoot exists in the Gardener project namespace,
+// otherwise Gardener fails the shoot reconciliation only after the shoot is created or patched
+func verifyAuditPolicyConfigMap(ctx context.Context, m *fsm, s *systemState) error {
+	name := auditlogs.PolicyConfigMapName(s.instance, m.ConverterConfig.AuditLog.PolicyConfigMapName)
+
+	var configMap core_v1.ConfigMap
+	err := m.GardenClient.Get(ctx, types.NamespacedName{Name: name, Namespace: m.ShootNamesapace}, &configMap)
+	if k8serrors.IsNotFound(err) {
+		return fmt.Errorf("%w: %s/%s", auditlogs.ErrPolicyConfigMapNotFound, m.ShootNamesapace, name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get audit policy config map %s/%s: %w", m.ShootNamesapace, name, err)
+	}
+
+	return nil
+}
+
+// isAuditPolicyConfigMapReadError reports the errors of verifyAuditPolicyConfigMap other than the missing config map,
+// the shoot must not lose audit logging because the config map could not be read
+func isAuditPolicyConfigMapReadError(err error) bool {
+	return err != nil && !errors.Is(err, auditlogs.ErrPolicyConfigMapNotFound)
+}
+
+func updateStatusAndRequeueOnAuditPolicyConfigMapReadError(m *fsm, s *systemState, err error) (stateFn, *ctrl.Result, error) {
+	m.log.Error(err, msgFailedToVerifyAuditPolicyConfigMap)
+	s.instance.UpdateStatePending(
+		imv1.ConditionTypeRuntimeProvisioned,
+		imv1.ConditionReasonGardenerError,
+		"False",
+		fmt.Sprintf("%s: %v", msgFailedToVerifyAuditPolicyConfigMap, err),
+	)
+	return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
+}
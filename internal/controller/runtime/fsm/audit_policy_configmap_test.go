@@ -0,0 +1,220 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestVerifyAuditPolicyConfigMap(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	for tname, tc := range map[string]struct {
+		annotations   map[string]string
+		configMapName string
+		expectedErr   error
+	}{
+		"Should succeed when the audit policy config map exists": {
+			configMapName: "audit-policy",
+		},
+		"Should succeed when the experimental audit policy config map exists": {
+			annotations:   map[string]string{"operator.kyma-project.io/experimental-audit-policy": "true"},
+			configMapName: "experimental-audit-policy",
+		},
+		"Should fail when the audit policy config map is missing": {
+			configMapName: "other-policy",
+			expectedErr:   auditlogs.ErrPolicyConfigMapNotFound,
+		},
+		"Should fail when the experimental audit policy config map is missing": {
+			annotations:   map[string]string{"operator.kyma-project.io/experimental-audit-policy": "true"},
+			configMapName: "audit-policy",
+			expectedErr:   auditlogs.ErrPolicyConfigMapNotFound,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			configMap := &core_v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: tc.configMapName, Namespace: "garden-test"}}
+			testFsm := must(newFakeFSM,
+				withShootNamespace("garden-test"),
+				withFakedK8sClient(testScheme, configMap),
+				func(fsm *fsm) error {
+					fsm.ConverterConfig.AuditLog.PolicyConfigMapName = "audit-policy"
+					return nil
+				},
+			)
+			systemState := &systemState{instance: *makeInputRuntimeWithAnnotation(tc.annotations)}
+
+			// when
+			err := verifyAuditPolicyConfigMap(context.Background(), testFsm, systemState)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFSMPatchShootWithMissingAuditPolicyConfigMap(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	for tname, tc := range map[string]struct {
+		mandatory            bool
+		expectedNextStep     string
+		expectedRuntimeState imv1.State
+	}{
+		"Should stop when audit logging is mandatory": {
+			mandatory:            true,
+			expectedNextStep:     "sFnUpdateStatus",
+			expectedRuntimeState: imv1.RuntimeStateFailed,
+		},
+		"Should patch the shoot without audit logging when audit logging is not mandatory": {
+			expectedNextStep:     "sFnUpdateStatus",
+			expectedRuntimeState: imv1.RuntimeStatePending,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := makeInputRuntimeWithAnnotation(nil)
+			testFsm := must(newFakeFSM,
+				withMockedMetrics(),
+				withShootNamespace("garden-"),
+				withTestFinalizer,
+				withFakedK8sClient(testScheme, runtime),
+				withFakeEventRecorder(1),
+				withDefaultReconcileDuration(),
+				withAuditLogMandatory(tc.mandatory),
+				withAuditLogConfig("gcp", "region", auditlogs.AuditLogData{
+					TenantID:   "test-tenant",
+					ServiceURL: "http://test-auditlog-service",
+					SecretName: "test-secret",
+				}),
+				func(fsm *fsm) error {
+					fsm.ConverterConfig.AuditLog.PolicyConfigMapName = "missing-policy"
+					return nil
+				},
+			)
+			systemState := &systemState{instance: *runtime, shoot: fsm_testing.TestShootForPatch()}
+			require.NoError(t, testFsm.GardenClient.Create(context.Background(), systemState.shoot))
+
+			// when
+			nextFn, _, err := sFnPatchExistingShoot(context.Background(), testFsm, systemState)
+
+			// then
+			require.NoError(t, err)
+			Expect(nextFn).To(haveName(tc.expectedNextStep))
+			assert.Equal(t, tc.expectedRuntimeState, systemState.instance.Status.State)
+
+			condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeAuditLog))
+			require.NotNil(t, condition)
+			assert.Equal(t, metav1.ConditionFalse, condition.Status)
+			assert.Equal(t, string(imv1.ConditionReasonAuditLogError), condition.Reason)
+			assert.Contains(t, condition.Message, "audit policy config map not found: garden-/missing-policy")
+
+			if !tc.mandatory {
+				var shoot gardener.Shoot
+				require.NoError(t, testFsm.GardenClient.Get(context.Background(), client.ObjectKeyFromObject(systemState.shoot), &shoot))
+				if shoot.Spec.Kubernetes.KubeAPIServer != nil {
+					assert.Nil(t, shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig)
+				}
+			}
+		})
+	}
+}
+
+func TestFSMRequeuesWhenAuditPolicyConfigMapCannotBeRead(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	failingPolicyGet := interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if key.Name == "audit-policy" {
+				return k8serrors.NewInternalError(errors.New("etcd timeout"))
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+	}
+
+	for tname, stateFn := range map[string]stateFn{
+		"Should requeue the shoot creation": sFnCreateShoot,
+		"Should requeue the shoot patch":    sFnPatchExistingShoot,
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := makeInputRuntimeWithAnnotation(nil)
+			gardenClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(&core_v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden-test"}}).
+				WithInterceptorFuncs(failingPolicyGet).
+				Build()
+
+			testFsm := must(newFakeFSM,
+				withMockedMetrics(),
+				withShootNamespace("garden-test"),
+				withDefaultReconcileDuration(),
+				withAuditLogConfig("gcp", "region", auditlogs.AuditLogData{
+					TenantID:   "test-tenant",
+					ServiceURL: "http://test-auditlog-service",
+					SecretName: "test-secret",
+				}),
+				func(fsm *fsm) error {
+					fsm.GardenClient = gardenClient
+					fsm.KcpClient = gardenClient
+					fsm.ConverterConfig.AuditLog.PolicyConfigMapName = "audit-policy"
+					return nil
+				},
+			)
+			systemState := &systemState{instance: *runtime, shoot: fsm_testing.TestShootForPatch()}
+
+			// when
+			nextFn, _, err := stateFn(context.Background(), testFsm, systemState)
+
+			// then
+			require.NoError(t, err)
+			Expect(nextFn).To(haveName("sFnUpdateStatus"))
+			assert.Equal(t, imv1.State(imv1.RuntimeStateFailed), systemState.instance.Status.State)
+
+			condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+			require.NotNil(t, condition)
+			assert.Equal(t, string(imv1.ConditionReasonGardenerError), condition.Reason)
+			assert.Contains(t, condition.Message, "etcd timeout")
+
+			assert.Nil(t, meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeAuditLog)))
+
+			var shootList gardener.ShootList
+			require.NoError(t, gardenClient.List(context.Background(), &shootList))
+			assert.Empty(t, shootList.Items)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	msgHAChangeBlocked = "Runtime changes the high availability of the shoot control plane which cannot be applied: %s"
+
+	// minimalSeedZonesForZoneFailureTolerance is the number of zones the seed must provide to host a control plane tolerating a zone failure
+	minimalSeedZonesForZoneFailureTolerance = 3
+)
+
+// controlPlaneHAChangeBlockedReason returns why the failure tolerance of the existing shoot control plane cannot be changed to the desired one,
+// the returned reason is empty when the failure tolerance does not change or the change can be applied.
+// Gardener allows to enable the high availability of an existing control plane, but not to change or disable it afterwards.
+// Zone failure tolerance requires the seed hosting the control plane to provide enough zones.
+func controlPlaneHAChangeBlockedReason(ctx context.Context, gardenClient client.Client, existing, desired *gardener.Shoot) (string, error) {
+	existingType := failureToleranceType(existing)
+	desiredType := failureToleranceType(desired)
+
+	if existingType == desiredType {
+		return "", nil
+	}
+
+	if existingType != "" {
+		return fmt.Sprintf("failure tolerance type %s cannot be changed to %q once set", existingType, desiredType), nil
+	}
+
+	if desiredType != gardener.FailureToleranceTypeZone {
+		return "", nil
+	}
+
+	if existing.Spec.SeedName == nil {
+		return "the shoot is not scheduled on a seed yet", nil
+	}
+
+	var seed gardener.Seed
+	if err := gardenClient.Get(ctx, client.ObjectKey{Name: *existing.Spec.SeedName}, &seed); err != nil {
+		return "", err
+	}
+
+	if len(seed.Spec.Provider.Zones) < minimalSeedZonesForZoneFailureTolerance {
+		return fmt.Sprintf("seed %s provides %d zones, at least %d are required for zone failure tolerance", seed.Name, len(seed.Spec.Provider.Zones), minimalSeedZonesForZoneFailureTolerance), nil
+	}
+
+	return "", nil
+}
+
+func failureToleranceType(shoot *gardener.Shoot) gardener.FailureToleranceType {
+	if shoot.Spec.ControlPlane == nil || shoot.Spec.ControlPlane.HighAvailability == nil {
+		return ""
+	}
+
+	return shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type
+}
@@ -0,0 +1,166 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestControlPlaneHAChangeBlockedReason(t *testing.T) {
+	testScheme := api.NewScheme()
+	util.Must(gardener.AddToScheme(testScheme))
+
+	gardenClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(
+			fixSeedWithZones("seed-multi-zone", "a", "b", "c"),
+			fixSeedWithZones("seed-single-zone", "a"),
+		).
+		Build()
+
+	for tname, tc := range map[string]struct {
+		existingType   gardener.FailureToleranceType
+		desiredType    gardener.FailureToleranceType
+		seedName       *string
+		expectedReason string
+	}{
+		"Should allow the unchanged failure tolerance": {
+			existingType: gardener.FailureToleranceTypeZone,
+			desiredType:  gardener.FailureToleranceTypeZone,
+			seedName:     ptr.To("seed-single-zone"),
+		},
+		"Should allow the upgrade to zone failure tolerance on a seed with enough zones": {
+			desiredType: gardener.FailureToleranceTypeZone,
+			seedName:    ptr.To("seed-multi-zone"),
+		},
+		"Should allow the upgrade to node failure tolerance on a seed with a single zone": {
+			desiredType: gardener.FailureToleranceTypeNode,
+			seedName:    ptr.To("seed-single-zone"),
+		},
+		"Should block the upgrade to zone failure tolerance on a seed with insufficient zones": {
+			desiredType:    gardener.FailureToleranceTypeZone,
+			seedName:       ptr.To("seed-single-zone"),
+			expectedReason: "seed seed-single-zone provides 1 zones, at least 3 are required for zone failure tolerance",
+		},
+		"Should block the upgrade to zone failure tolerance when the shoot is not scheduled": {
+			desiredType:    gardener.FailureToleranceTypeZone,
+			expectedReason: "the shoot is not scheduled on a seed yet",
+		},
+		"Should block changing the failure tolerance type": {
+			existingType:   gardener.FailureToleranceTypeNode,
+			desiredType:    gardener.FailureToleranceTypeZone,
+			seedName:       ptr.To("seed-multi-zone"),
+			expectedReason: `failure tolerance type node cannot be changed to "zone" once set`,
+		},
+		"Should block disabling the failure tolerance": {
+			existingType:   gardener.FailureToleranceTypeZone,
+			seedName:       ptr.To("seed-multi-zone"),
+			expectedReason: `failure tolerance type zone cannot be changed to "" once set`,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			existing := fixShootWithFailureTolerance(tc.existingType)
+			existing.Spec.SeedName = tc.seedName
+			desired := fixShootWithFailureTolerance(tc.desiredType)
+
+			// when
+			reason, err := controlPlaneHAChangeBlockedReason(context.Background(), gardenClient, existing, desired)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReason, reason)
+		})
+	}
+}
+
+func TestFSMPatchShootWithControlPlaneHAUpgrade(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	for tname, tc := range map[string]struct {
+		seed           *gardener.Seed
+		expectedReason imv1.RuntimeConditionReason
+	}{
+		"Should apply the upgrade to zone failure tolerance": {
+			seed:           fixSeedWithZones("test-seed", "a", "b", "c"),
+			expectedReason: imv1.ConditionReasonProcessing,
+		},
+		"Should stop when the seed provides insufficient zones": {
+			seed:           fixSeedWithZones("test-seed", "a", "b"),
+			expectedReason: imv1.ConditionReasonHAChangeBlocked,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := makeInputRuntimeWithAnnotation(nil)
+			runtime.Spec.Shoot.ControlPlane = &gardener.ControlPlane{
+				HighAvailability: &gardener.HighAvailability{
+					FailureTolerance: gardener.FailureTolerance{Type: gardener.FailureToleranceTypeZone},
+				},
+			}
+			testFsm := must(newFakeFSM,
+				withMockedMetrics(),
+				withShootNamespace("garden-"),
+				withTestFinalizer,
+				withFakedK8sClient(testScheme, runtime, tc.seed),
+				withFakeEventRecorder(1),
+				withDefaultReconcileDuration(),
+			)
+			shoot := fsm_testing.TestShootForPatch()
+			shoot.Spec.SeedName = ptr.To("test-seed")
+			systemState := &systemState{instance: *runtime, shoot: shoot}
+			require.NoError(t, testFsm.GardenClient.Create(context.Background(), shoot))
+
+			// when
+			nextFn, _, err := sFnPatchExistingShoot(context.Background(), testFsm, systemState)
+
+			// then
+			require.NoError(t, err)
+			Expect(nextFn).To(haveName("sFnUpdateStatus"))
+
+			condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+			require.NotNil(t, condition)
+			assert.Equal(t, string(tc.expectedReason), condition.Reason)
+		})
+	}
+}
+
+func fixSeedWithZones(name string, zones ...string) *gardener.Seed {
+	return &gardener.Seed{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: gardener.SeedSpec{
+			Provider: gardener.SeedProvider{Zones: zones},
+		},
+	}
+}
+
+func fixShootWithFailureTolerance(failureToleranceType gardener.FailureToleranceType) *gardener.Shoot {
+	shoot := &gardener.Shoot{}
+	if failureToleranceType != "" {
+		shoot.Spec.ControlPlane = &gardener.ControlPlane{
+			HighAvailability: &gardener.HighAvailability{
+				FailureTolerance: gardener.FailureTolerance{Type: failureToleranceType},
+			},
+		}
+	}
+
+	return shoot
+}
@@ -0,0 +1,25 @@
+package fsm
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// gardenerNamespaceExists checks whether the namespace of the Gardener project where the shoots are created exists.
+// Clients which are not allowed to read the namespace cannot verify it, the namespace is assumed to exist for them.
+func gardenerNamespaceExists(ctx context.Context, gardenClient client.Client, namespace string) (bool, error) {
+	var gardenerNamespace v1.Namespace
+
+	err := gardenClient.Get(ctx, client.ObjectKey{Name: namespace}, &gardenerNamespace)
+	switch {
+	case err == nil, k8serrors.IsForbidden(err):
+		return true, nil
+	case k8serrors.IsNotFound(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,106 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestGardenerNamespaceExists(t *testing.T) {
+	testScheme := api.NewScheme()
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	gardenerNamespace := &core_v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden-test"}}
+	forbiddenGet := interceptor.Funcs{
+		Get: func(_ context.Context, _ client.WithWatch, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+			return k8serrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, key.Name, nil)
+		},
+	}
+
+	for tname, tc := range map[string]struct {
+		objects        []client.Object
+		interceptors   interceptor.Funcs
+		expectedExists bool
+	}{
+		"Should find the existing namespace": {
+			objects:        []client.Object{gardenerNamespace},
+			expectedExists: true,
+		},
+		"Should report the missing namespace": {
+			expectedExists: false,
+		},
+		"Should assume the namespace exists when it cannot be read": {
+			interceptors:   forbiddenGet,
+			expectedExists: true,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			gardenClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(tc.objects...).WithInterceptorFuncs(tc.interceptors).Build()
+
+			// when
+			exists, err := gardenerNamespaceExists(context.Background(), gardenClient, "garden-test")
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedExists, exists)
+		})
+	}
+}
+
+func TestFSMCreateShootWithMissingGardenerNamespace(t *testing.T) {
+	// given
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	runtime := makeInputRuntimeWithAnnotation(nil)
+	gardenClient := fake.NewClientBuilder().WithScheme(testScheme).Build()
+
+	testFsm := must(newFakeFSM,
+		withMockedMetrics(),
+		withShootNamespace("garden-test"),
+		withDefaultReconcileDuration(),
+		func(fsm *fsm) error {
+			fsm.GardenClient = gardenClient
+			fsm.KcpClient = gardenClient
+			return nil
+		},
+	)
+	systemState := &systemState{instance: *runtime}
+
+	// when
+	nextFn, _, err := sFnCreateShoot(context.Background(), testFsm, systemState)
+
+	// then
+	require.NoError(t, err)
+	Expect(nextFn).To(haveName("sFnUpdateStatus"))
+
+	condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+	require.NotNil(t, condition)
+	assert.Equal(t, string(imv1.ConditionReasonGardenerNamespaceNotFound), condition.Reason)
+	assert.Contains(t, condition.Message, "garden-test")
+	assert.Equal(t, imv1.State(imv1.RuntimeStateFailed), systemState.instance.Status.State)
+
+	var shootList gardener.ShootList
+	require.NoError(t, gardenClient.List(context.Background(), &shootList))
+	assert.Empty(t, shootList.Items)
+}
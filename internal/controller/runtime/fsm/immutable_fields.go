@@ -0,0 +1,29 @@
+package fsm
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/utils/ptr"
+)
+
+// immutableFieldChanges returns the paths of the shoot fields which Gardener does not allow to change and which differ between the existing and the converted shoot.
+// Fields not set on either of the shoots are skipped, so setting a value for the first time is not reported.
+func immutableFieldChanges(existing, desired *gardener.Shoot) []string {
+	var changes []string
+
+	appendIfChanged := func(path, existingValue, desiredValue string) {
+		if existingValue != "" && desiredValue != "" && existingValue != desiredValue {
+			changes = append(changes, path)
+		}
+	}
+
+	appendIfChanged("spec.region", existing.Spec.Region, desired.Spec.Region)
+
+	if existing.Spec.Networking != nil && desired.Spec.Networking != nil {
+		appendIfChanged("spec.networking.type", ptr.Deref(existing.Spec.Networking.Type, ""), ptr.Deref(desired.Spec.Networking.Type, ""))
+		appendIfChanged("spec.networking.pods", ptr.Deref(existing.Spec.Networking.Pods, ""), ptr.Deref(desired.Spec.Networking.Pods, ""))
+		appendIfChanged("spec.networking.services", ptr.Deref(existing.Spec.Networking.Services, ""), ptr.Deref(desired.Spec.Networking.Services, ""))
+		appendIfChanged("spec.networking.nodes", ptr.Deref(existing.Spec.Networking.Nodes, ""), ptr.Deref(desired.Spec.Networking.Nodes, ""))
+	}
+
+	return changes
+}
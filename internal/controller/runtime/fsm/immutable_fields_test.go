@@ -0,0 +1,121 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestImmutableFieldChanges(t *testing.T) {
+	t.Run("Should report changed pods CIDR", func(t *testing.T) {
+		// given
+		existing := fixShootWithNetworking("eu-west-1", "100.64.0.0/12")
+		desired := fixShootWithNetworking("eu-west-1", "100.96.0.0/11")
+
+		// when
+		changes := immutableFieldChanges(existing, desired)
+
+		// then
+		assert.Equal(t, []string{"spec.networking.pods"}, changes)
+	})
+
+	t.Run("Should report changed region", func(t *testing.T) {
+		// given
+		existing := fixShootWithNetworking("eu-west-1", "100.64.0.0/12")
+		desired := fixShootWithNetworking("eu-central-1", "100.64.0.0/12")
+
+		// when
+		changes := immutableFieldChanges(existing, desired)
+
+		// then
+		assert.Equal(t, []string{"spec.region"}, changes)
+	})
+
+	t.Run("Should not report fields which are not set on the existing shoot", func(t *testing.T) {
+		// given
+		existing := fixShootWithNetworking("", "")
+		existing.Spec.Networking = nil
+		desired := fixShootWithNetworking("eu-west-1", "100.64.0.0/12")
+
+		// when
+		changes := immutableFieldChanges(existing, desired)
+
+		// then
+		assert.Empty(t, changes)
+	})
+
+	t.Run("Should not report unchanged fields", func(t *testing.T) {
+		// given
+		existing := fixShootWithNetworking("eu-west-1", "100.64.0.0/12")
+		desired := fixShootWithNetworking("eu-west-1", "100.64.0.0/12")
+
+		// when
+		changes := immutableFieldChanges(existing, desired)
+
+		// then
+		assert.Empty(t, changes)
+	})
+}
+
+func TestFSMPatchShootWithImmutableFieldChange(t *testing.T) {
+	// given
+	RegisterTestingT(t)
+	ctx := context.Background()
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	runtime := makeInputRuntimeWithAnnotation(nil)
+	runtime.Spec.Shoot.Networking.Pods = "100.96.0.0/11"
+
+	shoot := fsm_testing.TestShootForPatch()
+	shoot.Spec.Networking = &gardener.Networking{
+		Pods: ptr.To("100.64.0.0/12"),
+	}
+
+	testFsm := setupFakeFSMForTest(testScheme, runtime)
+	require.NoError(t, testFsm.GardenClient.Create(ctx, shoot))
+
+	systemState := &systemState{instance: *runtime, shoot: shoot.DeepCopy()}
+
+	// when
+	stateFn, _, err := sFnPatchExistingShoot(ctx, testFsm, systemState)
+
+	// then
+	require.NoError(t, err)
+	require.Contains(t, stateFn.name(), "sFnUpdateStatus")
+	assert.Equal(t, imv1.RuntimeStateFailed, string(systemState.instance.Status.State))
+	condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+	require.NotNil(t, condition)
+	assert.Equal(t, string(imv1.ConditionReasonImmutableFieldChanged), condition.Reason)
+	assert.Contains(t, condition.Message, "spec.networking.pods")
+
+	var shootAfterPatch gardener.Shoot
+	require.NoError(t, testFsm.GardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), &shootAfterPatch))
+	assert.Equal(t, ptr.To("100.64.0.0/12"), shootAfterPatch.Spec.Networking.Pods)
+}
+
+func fixShootWithNetworking(region, pods string) *gardener.Shoot {
+	return &gardener.Shoot{
+		Spec: gardener.ShootSpec{
+			Region: region,
+			Networking: &gardener.Networking{
+				Pods: ptr.To(pods),
+			},
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// updateKubernetesVersionExpiryCondition reports whether the Kubernetes version of the shoot expires within KubernetesVersionExpiryWarningPeriod.
+// The expiration date is taken from the cloud profile of the shoot, the condition is left unchanged when the cloud profile cannot be read.
+func updateKubernetesVersionExpiryCondition(ctx context.Context, m *fsm, s *systemState) {
+	if m.KubernetesVersionExpiryWarningPeriod <= 0 || s.shoot == nil {
+		return
+	}
+
+	cloudProfileName := shootCloudProfileName(s.shoot)
+	if cloudProfileName == "" {
+		return
+	}
+
+	var cloudProfile gardener.CloudProfile
+	if err := m.GardenClient.Get(ctx, client.ObjectKey{Name: cloudProfileName}, &cloudProfile); err != nil {
+		m.log.Error(err, "Failed to get cloud profile to check the Kubernetes version expiration", "CloudProfile", cloudProfileName)
+		return
+	}
+
+	version := s.shoot.Spec.Kubernetes.Version
+	expirationDate := kubernetesVersionExpirationDate(cloudProfile, version)
+
+	condition := metav1.Condition{
+		Type:               string(imv1.ConditionTypeKubernetesVersionExpiring),
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.NewTime(m.Clock.Now()),
+		Reason:             string(imv1.ConditionReasonKubernetesVersionSupported),
+		Message:            fmt.Sprintf("Kubernetes version %s has no expiration date.", version),
+		ObservedGeneration: s.instance.Generation,
+	}
+
+	if expirationDate != nil {
+		condition.Message = fmt.Sprintf("Kubernetes version %s expires on %s.", version, expirationDate.UTC().Format(time.RFC3339))
+
+		if expirationDate.Sub(m.Clock.Now()) <= m.KubernetesVersionExpiryWarningPeriod {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = string(imv1.ConditionReasonKubernetesVersionExpiring)
+			condition.Message = fmt.Sprintf("Kubernetes version %s expires on %s, %s", version, expirationDate.UTC().Format(time.RFC3339), forcedUpdateMessage(s.instance.Spec.Shoot.Kubernetes.ForcedUpdate))
+		}
+	}
+
+	meta.SetStatusCondition(&s.instance.Status.Conditions, condition)
+}
+
+func shootCloudProfileName(shoot *gardener.Shoot) string {
+	if shoot.Spec.CloudProfile != nil && shoot.Spec.CloudProfile.Kind == "CloudProfile" {
+		return shoot.Spec.CloudProfile.Name
+	}
+
+	if shoot.Spec.CloudProfileName != nil { //nolint:staticcheck
+		return *shoot.Spec.CloudProfileName //nolint:staticcheck
+	}
+
+	return ""
+}
+
+func kubernetesVersionExpirationDate(cloudProfile gardener.CloudProfile, version string) *time.Time {
+	for _, expirableVersion := range cloudProfile.Spec.Kubernetes.Versions {
+		if expirableVersion.Version == version && expirableVersion.ExpirationDate != nil {
+			return &expirableVersion.ExpirationDate.Time
+		}
+	}
+
+	return nil
+}
+
+func forcedUpdateMessage(forcedUpdate *imv1.ForcedVersionUpdate) string {
+	if forcedUpdate == nil {
+		return "Gardener will force the update to a supported version."
+	}
+
+	if *forcedUpdate == imv1.ForcedVersionUpdateBlocked {
+		return "automatic updates are blocked for the Runtime, update the Kubernetes version before Gardener forces it."
+	}
+
+	return "the forced update to a supported version is approved for the Runtime."
+}
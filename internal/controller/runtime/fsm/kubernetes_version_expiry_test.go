@@ -0,0 +1,149 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestUpdateKubernetesVersionExpiryCondition(t *testing.T) {
+	RegisterTestingT(t)
+
+	const warningPeriod = 30 * 24 * time.Hour
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testScheme := api.NewScheme()
+	util.Must(gardener.AddToScheme(testScheme))
+
+	for tname, tc := range map[string]struct {
+		expirationDate  *time.Time
+		forcedUpdate    *imv1.ForcedVersionUpdate
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  imv1.RuntimeConditionReason
+		expectedMessage string
+	}{
+		"Should report the version nearing expiry": {
+			expirationDate:  ptr.To(now.Add(10 * 24 * time.Hour)),
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  imv1.ConditionReasonKubernetesVersionExpiring,
+			expectedMessage: "Kubernetes version 1.29.4 expires on 2024-01-11T00:00:00Z, Gardener will force the update to a supported version.",
+		},
+		"Should report the version nearing expiry with blocked forced update": {
+			expirationDate:  ptr.To(now.Add(10 * 24 * time.Hour)),
+			forcedUpdate:    ptr.To(imv1.ForcedVersionUpdateBlocked),
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  imv1.ConditionReasonKubernetesVersionExpiring,
+			expectedMessage: "Kubernetes version 1.29.4 expires on 2024-01-11T00:00:00Z, automatic updates are blocked for the Runtime, update the Kubernetes version before Gardener forces it.",
+		},
+		"Should report the version expiring after the warning period as supported": {
+			expirationDate:  ptr.To(now.Add(60 * 24 * time.Hour)),
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  imv1.ConditionReasonKubernetesVersionSupported,
+			expectedMessage: "Kubernetes version 1.29.4 expires on 2024-03-01T00:00:00Z.",
+		},
+		"Should report the version without expiration date as supported": {
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  imv1.ConditionReasonKubernetesVersionSupported,
+			expectedMessage: "Kubernetes version 1.29.4 has no expiration date.",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			testFsm := must(newFakeFSM,
+				withFakedK8sClient(testScheme, fixCloudProfileWithKubernetesVersion("gcp", "1.29.4", tc.expirationDate)),
+				withClock(clocktesting.NewFakeClock(now)),
+				withKubernetesVersionExpiryWarningPeriod(warningPeriod),
+			)
+
+			systemState := &systemState{
+				instance: imv1.Runtime{},
+				shoot:    fixShootWithKubernetesVersion("gcp", "1.29.4"),
+			}
+			systemState.instance.Spec.Shoot.Kubernetes.ForcedUpdate = tc.forcedUpdate
+
+			// when
+			updateKubernetesVersionExpiryCondition(context.Background(), testFsm, systemState)
+
+			// then
+			condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeKubernetesVersionExpiring))
+			require.NotNil(t, condition)
+			assert.Equal(t, tc.expectedStatus, condition.Status)
+			assert.Equal(t, string(tc.expectedReason), condition.Reason)
+			assert.Equal(t, tc.expectedMessage, condition.Message)
+		})
+	}
+
+	t.Run("Should not set the condition when the check is disabled", func(t *testing.T) {
+		// given
+		testFsm := must(newFakeFSM,
+			withFakedK8sClient(testScheme, fixCloudProfileWithKubernetesVersion("gcp", "1.29.4", ptr.To(now))),
+			withClock(clocktesting.NewFakeClock(now)),
+		)
+		systemState := &systemState{shoot: fixShootWithKubernetesVersion("gcp", "1.29.4")}
+
+		// when
+		updateKubernetesVersionExpiryCondition(context.Background(), testFsm, systemState)
+
+		// then
+		assert.Empty(t, systemState.instance.Status.Conditions)
+	})
+
+	t.Run("Should not set the condition when the cloud profile is not found", func(t *testing.T) {
+		// given
+		testFsm := must(newFakeFSM,
+			withFakedK8sClient(testScheme),
+			withClock(clocktesting.NewFakeClock(now)),
+			withKubernetesVersionExpiryWarningPeriod(warningPeriod),
+		)
+		systemState := &systemState{shoot: fixShootWithKubernetesVersion("gcp", "1.29.4")}
+
+		// when
+		updateKubernetesVersionExpiryCondition(context.Background(), testFsm, systemState)
+
+		// then
+		assert.Empty(t, systemState.instance.Status.Conditions)
+	})
+}
+
+func fixCloudProfileWithKubernetesVersion(name, version string, expirationDate *time.Time) client.Object {
+	expirableVersion := gardener.ExpirableVersion{Version: version}
+	if expirationDate != nil {
+		expirableVersion.ExpirationDate = ptr.To(metav1.NewTime(*expirationDate))
+	}
+
+	return &gardener.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: gardener.CloudProfileSpec{
+			Kubernetes: gardener.KubernetesSettings{
+				Versions: []gardener.ExpirableVersion{expirableVersion},
+			},
+		},
+	}
+}
+
+func fixShootWithKubernetesVersion(cloudProfileName, version string) *gardener.Shoot {
+	return &gardener.Shoot{
+		Spec: gardener.ShootSpec{
+			CloudProfile: &gardener.CloudProfileReference{
+				Kind: "CloudProfile",
+				Name: cloudProfileName,
+			},
+			Kubernetes: gardener.Kubernetes{
+				Version: version,
+			},
+		},
+	}
+}
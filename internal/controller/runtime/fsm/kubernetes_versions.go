@@ -0,0 +1,46 @@
+package fsm
+
+import (
+	"context"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// availableKubernetesVersions returns the Kubernetes versions of the cloud profile a Kubernetes minor version of the Runtime is resolved to.
+// Preview and expired versions are skipped. Nil is returned when the Runtime requests a patch version or the cloud profile cannot be read.
+func availableKubernetesVersions(ctx context.Context, m *fsm, runtime imv1.Runtime, cloudProfileName string) []string {
+	requestedVersion := ptr.Deref(runtime.Spec.Shoot.Kubernetes.Version, "")
+	if requestedVersion == "" {
+		requestedVersion = m.ConverterConfig.Kubernetes.DefaultVersion
+	}
+
+	if cloudProfileName == "" || !config.IsMinorVersion(requestedVersion) {
+		return nil
+	}
+
+	var cloudProfile gardener.CloudProfile
+	if err := m.GardenClient.Get(ctx, client.ObjectKey{Name: cloudProfileName}, &cloudProfile); err != nil {
+		m.log.Info("Failed to get cloud profile to resolve the Kubernetes patch version", "CloudProfile", cloudProfileName, "error", err.Error())
+		return nil
+	}
+
+	var versions []string
+	for _, expirableVersion := range cloudProfile.Spec.Kubernetes.Versions {
+		classification := ptr.Deref(expirableVersion.Classification, gardener.ClassificationSupported)
+		if classification == gardener.ClassificationPreview || classification == gardener.ClassificationExpired {
+			continue
+		}
+
+		if expirableVersion.ExpirationDate != nil && !m.Clock.Now().Before(expirableVersion.ExpirationDate.Time) {
+			continue
+		}
+
+		versions = append(versions, expirableVersion.Version)
+	}
+
+	return versions
+}
@@ -0,0 +1,80 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+)
+
+func TestAvailableKubernetesVersions(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testScheme := api.NewScheme()
+	util.Must(gardener.AddToScheme(testScheme))
+
+	cloudProfile := &gardener.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gcp"},
+		Spec: gardener.CloudProfileSpec{
+			Kubernetes: gardener.KubernetesSettings{
+				Versions: []gardener.ExpirableVersion{
+					{Version: "1.30.1", Classification: ptr.To(gardener.ClassificationPreview)},
+					{Version: "1.29.4", Classification: ptr.To(gardener.ClassificationSupported)},
+					{Version: "1.29.3", Classification: ptr.To(gardener.ClassificationDeprecated), ExpirationDate: ptr.To(metav1.NewTime(now.Add(time.Hour)))},
+					{Version: "1.29.2", Classification: ptr.To(gardener.ClassificationDeprecated), ExpirationDate: ptr.To(metav1.NewTime(now.Add(-time.Hour)))},
+					{Version: "1.28.9", Classification: ptr.To(gardener.ClassificationExpired)},
+				},
+			},
+		},
+	}
+
+	for tname, tc := range map[string]struct {
+		requestedVersion *string
+		cloudProfileName string
+		expectedVersions []string
+	}{
+		"Should return the versions without preview and expired ones for minor version": {
+			requestedVersion: ptr.To("1.29"),
+			cloudProfileName: "gcp",
+			expectedVersions: []string{"1.29.4", "1.29.3"},
+		},
+		"Should return the versions for default minor version": {
+			cloudProfileName: "gcp",
+			expectedVersions: []string{"1.29.4", "1.29.3"},
+		},
+		"Should return no versions for patch version": {
+			requestedVersion: ptr.To("1.29.4"),
+			cloudProfileName: "gcp",
+		},
+		"Should return no versions for missing cloud profile": {
+			requestedVersion: ptr.To("1.29"),
+			cloudProfileName: "aws",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			testFsm := must(newFakeFSM,
+				withFakedK8sClient(testScheme, cloudProfile),
+				withClock(clocktesting.NewFakeClock(now)),
+			)
+			testFsm.ConverterConfig.Kubernetes.DefaultVersion = "1.29"
+
+			runtime := imv1.Runtime{}
+			runtime.Spec.Shoot.Kubernetes.Version = tc.requestedVersion
+
+			// when
+			versions := availableKubernetesVersions(context.Background(), testFsm, runtime, tc.cloudProfileName)
+
+			// then
+			assert.Equal(t, tc.expectedVersions, versions)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	k8s_client "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const networkPoliciesErrorMessage = "Failed to apply default network policies. Scheduling for retry"
+
+// applyDefaultNetworkPolicies applies the configured network policies to the workload namespaces of the runtime cluster.
+// Policies previously applied by infrastructure-manager are removed when the Runtime opts out with the disable-default-network-policies annotation,
+// or when they are no longer part of the configured policy set.
+func applyDefaultNetworkPolicies(ctx context.Context, m *fsm, s *systemState) error {
+	policiesConfig := m.ClusterConfig.DefaultNetworkPolicies
+	if !policiesConfig.Enabled {
+		return nil
+	}
+
+	runtimeClient, err := m.RuntimeClientGetter.Get(ctx, s.instance)
+	if err != nil {
+		return err
+	}
+
+	if reconciler.ShouldDisableDefaultNetworkPolicies(s.instance.Annotations) {
+		return deleteDefaultNetworkPolicies(ctx, runtimeClient, policiesConfig.Namespaces)
+	}
+
+	policies := policiesConfig.Policies
+	if len(policies) == 0 {
+		policies = defaultNetworkPolicies()
+	}
+
+	for _, namespace := range policiesConfig.Namespaces {
+		exists, err := namespaceExists(ctx, runtimeClient, namespace)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+
+		for _, policy := range policies {
+			if err := createOrUpdateNetworkPolicy(ctx, runtimeClient, namespace, policy); err != nil {
+				return fmt.Errorf("failed to apply network policy %s/%s: %w", namespace, policy.Name, err)
+			}
+		}
+
+		if err := deleteStaleNetworkPolicies(ctx, runtimeClient, namespace, policies); err != nil {
+			return fmt.Errorf("failed to remove stale network policies from namespace %s: %w", namespace, err)
+		}
+	}
+
+	return nil
+}
+
+func createOrUpdateNetworkPolicy(ctx context.Context, runtimeClient k8s_client.Client, namespace string, policy config.NetworkPolicyConfig) error {
+	networkPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      policy.Name,
+			Namespace: namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, runtimeClient, networkPolicy, func() error {
+		if networkPolicy.Labels == nil {
+			networkPolicy.Labels = map[string]string{}
+		}
+		networkPolicy.Labels[imv1.LabelKymaManagedBy] = "infrastructure-manager"
+		networkPolicy.Spec = *policy.Spec.DeepCopy()
+		return nil
+	})
+
+	return err
+}
+
+func deleteDefaultNetworkPolicies(ctx context.Context, runtimeClient k8s_client.Client, namespaces []string) error {
+	for _, namespace := range namespaces {
+		err := runtimeClient.DeleteAllOf(ctx, &networkingv1.NetworkPolicy{},
+			k8s_client.InNamespace(namespace),
+			k8s_client.MatchingLabels{imv1.LabelKymaManagedBy: "infrastructure-manager"})
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteStaleNetworkPolicies removes the policies applied by infrastructure-manager that are no longer configured
+func deleteStaleNetworkPolicies(ctx context.Context, runtimeClient k8s_client.Client, namespace string, policies []config.NetworkPolicyConfig) error {
+	var networkPolicies networkingv1.NetworkPolicyList
+	err := runtimeClient.List(ctx, &networkPolicies,
+		k8s_client.InNamespace(namespace),
+		k8s_client.MatchingLabels{imv1.LabelKymaManagedBy: "infrastructure-manager"})
+	if err != nil {
+		return err
+	}
+
+	configured := make(map[string]struct{}, len(policies))
+	for _, policy := range policies {
+		configured[policy.Name] = struct{}{}
+	}
+
+	for i := range networkPolicies.Items {
+		if _, ok := configured[networkPolicies.Items[i].Name]; ok {
+			continue
+		}
+		if err := runtimeClient.Delete(ctx, &networkPolicies.Items[i]); err != nil && !k8s_errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func namespaceExists(ctx context.Context, runtimeClient k8s_client.Client, name string) (bool, error) {
+	var namespace corev1.Namespace
+	err := runtimeClient.Get(ctx, k8s_client.ObjectKey{Name: name}, &namespace)
+	if k8s_errors.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// defaultNetworkPolicies denies all traffic except DNS lookups
+func defaultNetworkPolicies() []config.NetworkPolicyConfig {
+	dnsPort := intstr.FromInt32(53)
+
+	return []config.NetworkPolicyConfig{
+		{
+			Name: "kyma-default-deny",
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			},
+		},
+		{
+			Name: "kyma-allow-dns",
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				Egress: []networkingv1.NetworkPolicyEgressRule{
+					{
+						To: []networkingv1.NetworkPolicyPeer{
+							{
+								NamespaceSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{corev1.LabelMetadataName: "kube-system"},
+								},
+								PodSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"k8s-app": "kube-dns"},
+								},
+							},
+						},
+						Ports: []networkingv1.NetworkPolicyPort{
+							{Protocol: ptr.To(corev1.ProtocolUDP), Port: &dnsPort},
+							{Protocol: ptr.To(corev1.ProtocolTCP), Port: &dnsPort},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestConditionsObservedGeneration(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+
+	// given
+	shoot := &gardener.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-shoot", Namespace: "garden-test"},
+		Status: gardener.ShootStatus{
+			LastOperation: &gardener.LastOperation{
+				Type:           gardener.LastOperationTypeReconcile,
+				State:          gardener.LastOperationStateProcessing,
+				LastUpdateTime: metav1.Now(),
+			},
+		},
+	}
+
+	testFsm := must(newFakeFSM,
+		withMockedMetrics(),
+		withClock(clocktesting.NewFakeClock(time.Now())),
+		withFakedK8sClient(testScheme),
+	)
+	runtime := imv1.Runtime{ObjectMeta: metav1.ObjectMeta{Name: "test-runtime", Namespace: "kcp-system", Generation: 3}}
+	systemState := &systemState{instance: runtime, shoot: shoot}
+
+	// when
+	nextFn, _, err := sFnWaitForShootReconcile(context.Background(), testFsm, systemState)
+
+	// then
+	require.NoError(t, err)
+	Expect(nextFn).To(haveName("sFnUpdateStatus"))
+
+	require.NotEmpty(t, systemState.instance.Status.Conditions)
+	for _, condition := range systemState.instance.Status.Conditions {
+		assert.Equal(t, int64(3), condition.ObservedGeneration, "condition %s", condition.Type)
+	}
+	assert.True(t, systemState.instance.IsConditionObservedForCurrentGeneration(imv1.ConditionTypeRuntimeProvisioned))
+
+	// when the spec changes before the next reconciliation
+	systemState.instance.Generation = 4
+
+	// then
+	assert.False(t, systemState.instance.IsConditionObservedForCurrentGeneration(imv1.ConditionTypeRuntimeProvisioned))
+	assert.False(t, systemState.instance.IsConditionObservedForCurrentGeneration(imv1.ConditionTypeRuntimeDeprovisioned))
+}
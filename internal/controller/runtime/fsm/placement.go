@@ -0,0 +1,58 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// updatePlacementCondition reports the seed the shoot is scheduled on, the region of the seed and the zones of the shoot worker pools.
+// The condition is not set before the shoot is scheduled and is left unchanged when the seed cannot be read.
+func updatePlacementCondition(ctx context.Context, m *fsm, s *systemState) {
+	if s.shoot == nil {
+		return
+	}
+
+	seedName := ptr.Deref(s.shoot.Status.SeedName, ptr.Deref(s.shoot.Spec.SeedName, ""))
+	if seedName == "" {
+		return
+	}
+
+	seedProvider, err := getSeedProvider(ctx, m.GardenClient, seedName)
+	if err != nil {
+		m.log.Error(err, "Failed to get seed to report the placement of the shoot", "Seed", seedName)
+		return
+	}
+
+	meta.SetStatusCondition(&s.instance.Status.Conditions, metav1.Condition{
+		Type:               string(imv1.ConditionTypePlacement),
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(m.Clock.Now()),
+		Reason:             string(imv1.ConditionReasonPlacementReported),
+		Message:            fmt.Sprintf("Seed %s in region %s, worker zones: %s.", seedName, seedProvider.Region, strings.Join(shootWorkerZones(s.shoot), ", ")),
+		ObservedGeneration: s.instance.Generation,
+	})
+}
+
+// shootWorkerZones returns the sorted zones of all worker pools of the shoot
+func shootWorkerZones(shoot *gardener.Shoot) []string {
+	var zones []string
+	for _, worker := range shoot.Spec.Provider.Workers {
+		for _, zone := range worker.Zones {
+			if !slices.Contains(zones, zone) {
+				zones = append(zones, zone)
+			}
+		}
+	}
+
+	slices.Sort(zones)
+
+	return zones
+}
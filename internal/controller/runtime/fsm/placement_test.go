@@ -0,0 +1,100 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+)
+
+func TestPlacementCondition(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+
+	seed := &gardener.Seed{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-eu1"},
+		Spec: gardener.SeedSpec{
+			Provider: gardener.SeedProvider{Type: "aws", Region: "eu-central-1"},
+		},
+	}
+
+	for tname, tc := range map[string]struct {
+		seedName          *string
+		expectedCondition *metav1.Condition
+	}{
+		"Should report the seed region and the worker zones of a scheduled shoot": {
+			seedName: ptr.To("aws-eu1"),
+			expectedCondition: &metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  string(imv1.ConditionReasonPlacementReported),
+				Message: "Seed aws-eu1 in region eu-central-1, worker zones: eu-central-1a, eu-central-1b, eu-central-1c.",
+			},
+		},
+		"Should not report the placement of a shoot which is not scheduled": {},
+		"Should not report the placement when the seed cannot be read": {
+			seedName: ptr.To("missing-seed"),
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			shoot := &gardener.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-shoot", Namespace: "garden-test"},
+				Spec: gardener.ShootSpec{
+					Provider: gardener.Provider{
+						Workers: []gardener.Worker{
+							{Name: "cpu-worker-0", Zones: []string{"eu-central-1b", "eu-central-1a"}},
+							{Name: "cpu-worker-1", Zones: []string{"eu-central-1c", "eu-central-1a"}},
+						},
+					},
+				},
+				Status: gardener.ShootStatus{
+					SeedName: tc.seedName,
+					LastOperation: &gardener.LastOperation{
+						Type:           gardener.LastOperationTypeReconcile,
+						State:          gardener.LastOperationStateProcessing,
+						LastUpdateTime: metav1.Now(),
+					},
+				},
+			}
+
+			testFsm := must(newFakeFSM,
+				withMockedMetrics(),
+				withClock(clocktesting.NewFakeClock(time.Now())),
+				withFakedK8sClient(testScheme, seed),
+			)
+			systemState := &systemState{instance: imv1.Runtime{}, shoot: shoot}
+
+			// when
+			nextFn, _, err := sFnWaitForShootReconcile(context.Background(), testFsm, systemState)
+
+			// then
+			require.NoError(t, err)
+			Expect(nextFn).To(haveName("sFnUpdateStatus"))
+
+			condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypePlacement))
+			if tc.expectedCondition == nil {
+				assert.Nil(t, condition)
+				return
+			}
+
+			require.NotNil(t, condition)
+			assert.Equal(t, tc.expectedCondition.Status, condition.Status)
+			assert.Equal(t, tc.expectedCondition.Reason, condition.Reason)
+			assert.Equal(t, tc.expectedCondition.Message, condition.Message)
+		})
+	}
+}
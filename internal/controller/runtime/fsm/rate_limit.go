@@ -0,0 +1,48 @@
+package fsm
+
+import (
+	"fmt"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// rateLimitBackoffFactor multiplies the Gardener requeue duration for requests rejected by the Gardener rate limiting
+	rateLimitBackoffFactor = 2
+	// rateLimitJitterFactor spreads the retries of the Runtimes rate limited at the same time
+	rateLimitJitterFactor = 0.5
+)
+
+// rateLimitRequeueDuration returns the jittered requeue duration used after Gardener rejected a request with Too Many Requests.
+// It is never shorter than the Retry-After of the response.
+func (m *fsm) rateLimitRequeueDuration(runtime imv1.Runtime, err error) time.Duration {
+	requeueDuration := rateLimitBackoffFactor * m.gardenerRequeueDuration(runtime)
+
+	if retryAfterSeconds, found := k8serrors.SuggestsClientDelay(err); found {
+		retryAfter := time.Duration(retryAfterSeconds) * time.Second
+		if retryAfter > requeueDuration {
+			requeueDuration = retryAfter
+		}
+	}
+
+	return wait.Jitter(requeueDuration, rateLimitJitterFactor)
+}
+
+// updateStatusAndRequeueAfterRateLimit marks the provisioning as pending until Gardener accepts the requests again
+func updateStatusAndRequeueAfterRateLimit(m *fsm, s *systemState, err error) (stateFn, *ctrl.Result, error) {
+	requeueDuration := m.rateLimitRequeueDuration(s.instance, err)
+	m.log.Info("Gardener API is rate limiting requests, retrying", "RequeueAfter", requeueDuration, "error", err.Error())
+
+	s.instance.UpdateStatePending(
+		imv1.ConditionTypeRuntimeProvisioned,
+		imv1.ConditionReasonGardenerRateLimited,
+		"Unknown",
+		fmt.Sprintf("Gardener API is rate limiting requests, retrying in %s", requeueDuration.Round(time.Second)),
+	)
+
+	return updateStatusAndRequeueAfter(requeueDuration)
+}
@@ -0,0 +1,144 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestRateLimitRequeueDuration(t *testing.T) {
+	RegisterTestingT(t)
+
+	for tname, tc := range map[string]struct {
+		err                    error
+		expectedMinimalRequeue time.Duration
+	}{
+		"Should back off longer than the Gardener requeue duration": {
+			err:                    k8serrors.NewTooManyRequests("rate limited", 0),
+			expectedMinimalRequeue: 2 * defaultGardenerRequeueDuration,
+		},
+		"Should respect Retry-After longer than the back off": {
+			err:                    k8serrors.NewTooManyRequests("rate limited", 120),
+			expectedMinimalRequeue: 120 * time.Second,
+		},
+		"Should keep the back off when Retry-After is shorter": {
+			err:                    k8serrors.NewTooManyRequests("rate limited", 1),
+			expectedMinimalRequeue: 2 * defaultGardenerRequeueDuration,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			testFsm := must(newFakeFSM, withDefaultReconcileDuration())
+
+			// when
+			requeueDuration := testFsm.rateLimitRequeueDuration(imv1.Runtime{}, tc.err)
+
+			// then
+			assert.GreaterOrEqual(t, requeueDuration, tc.expectedMinimalRequeue)
+			assert.LessOrEqual(t, requeueDuration, time.Duration(float64(tc.expectedMinimalRequeue)*(1+rateLimitJitterFactor)))
+		})
+	}
+}
+
+func TestFSMShootRequestsRateLimited(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	rateLimitErr := k8serrors.NewTooManyRequests("the server has received too many requests", 120)
+	failShootRequests := func(_ context.Context, _ client.WithWatch, obj client.Object) error {
+		if _, isShoot := obj.(*gardener.Shoot); isShoot {
+			return rateLimitErr
+		}
+		return nil
+	}
+
+	for tname, tc := range map[string]struct {
+		interceptors interceptor.Funcs
+		stateFn      stateFn
+	}{
+		"Should back off when creating the shoot is rate limited": {
+			interceptors: interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if err := failShootRequests(ctx, c, obj); err != nil {
+						return err
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			},
+			stateFn: sFnCreateShoot,
+		},
+		"Should back off when patching the shoot is rate limited": {
+			interceptors: interceptor.Funcs{
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					return failShootRequests(ctx, c, obj)
+				},
+			},
+			stateFn: sFnPatchExistingShoot,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := makeInputRuntimeWithAnnotation(nil)
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(runtime, &core_v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden-"}}).
+				WithStatusSubresource(runtime).
+				WithInterceptorFuncs(tc.interceptors).
+				Build()
+
+			testFsm := must(newFakeFSM,
+				withMockedMetrics(),
+				withShootNamespace("garden-"),
+				withTestFinalizer,
+				withFakeEventRecorder(1),
+				withDefaultReconcileDuration(),
+				func(fsm *fsm) error {
+					fsm.KcpClient = k8sClient
+					fsm.GardenClient = k8sClient
+					return nil
+				},
+			)
+			systemState := &systemState{instance: *runtime, shoot: fsm_testing.TestShootForPatch()}
+
+			// when
+			nextFn, _, err := tc.stateFn(context.Background(), testFsm, systemState)
+
+			// then
+			require.NoError(t, err)
+			Expect(nextFn).To(haveName("sFnUpdateStatus"))
+
+			condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+			require.NotNil(t, condition)
+			assert.Equal(t, string(imv1.ConditionReasonGardenerRateLimited), condition.Reason)
+			assert.Equal(t, imv1.State(imv1.RuntimeStatePending), systemState.instance.Status.State)
+
+			// the status is already up to date, so the update state returns the requeue result
+			systemState.saveRuntimeStatus()
+			_, result, err := nextFn(context.Background(), testFsm, systemState)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.GreaterOrEqual(t, result.RequeueAfter, 120*time.Second)
+			assert.LessOrEqual(t, result.RequeueAfter, 180*time.Second)
+		})
+	}
+}
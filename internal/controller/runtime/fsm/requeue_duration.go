@@ -0,0 +1,24 @@
+package fsm
+
+import (
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+)
+
+// gardenerRequeueDuration returns the requeue duration used while waiting for Gardener.
+// It can be overridden per Runtime with the requeue-seconds annotation, invalid values are logged and ignored.
+func (m *fsm) gardenerRequeueDuration(runtime imv1.Runtime) time.Duration {
+	requeueDuration, found, err := reconciler.RequeueDurationOverride(runtime.Annotations)
+	if err != nil {
+		m.log.Info("Ignoring requeue duration override", "RuntimeCR", runtime.Name, "error", err.Error())
+		return m.GardenerRequeueDuration
+	}
+
+	if !found {
+		return m.GardenerRequeueDuration
+	}
+
+	return requeueDuration
+}
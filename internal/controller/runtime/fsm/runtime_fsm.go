@@ -11,9 +11,13 @@ import (
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/kyma-project/infrastructure-manager/internal/tracing"
 	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -36,6 +40,16 @@ type RCCfg struct {
 	Metrics                              metrics.Metrics
 	AuditLogging                         auditlogs.Configuration
 	RegistryCacheConfigControllerEnabled bool
+	// AuditLogUseSeedProvider selects the audit log data by the provider of the seed the shoot is scheduled on instead of the Runtime provider
+	AuditLogUseSeedProvider bool
+	// LogShootDiff enables logging of the shoot fields changed by the patch at debug level
+	LogShootDiff bool
+	// ShootOperationTimeout is the maximum time a shoot operation may stay without progress, zero disables the check
+	ShootOperationTimeout time.Duration
+	// KubernetesVersionExpiryWarningPeriod is the time before the expiration of the shoot's Kubernetes version from which the Runtime reports it as expiring, zero disables the check
+	KubernetesVersionExpiryWarningPeriod time.Duration
+	// Clock is used for all time comparisons done by the state machine, the real clock is used when not set
+	Clock clock.PassiveClock
 	config.Config
 }
 
@@ -80,7 +94,16 @@ loop:
 			break loop
 		default:
 			stateFnName := m.fn.name()
-			m.fn, result, err = m.fn(ctx, m, &state)
+			stateCtx, span := tracing.Tracer().Start(ctx, stateFnName, trace.WithAttributes(
+				tracing.AttributeRuntimeName.String(v.Name),
+				tracing.AttributeStateName.String(stateFnName),
+			))
+			m.fn, result, err = m.fn(stateCtx, m, &state)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
 			newStateFnName := m.fn.name()
 			m.log.V(log_level.TRACE).WithValues("result", result, "err", err, "mFnIsNill", m.fn == nil).Info(fmt.Sprintf("switching state from %s to %s", stateFnName, newStateFnName))
 			if m.fn == nil || err != nil {
@@ -103,6 +126,10 @@ loop:
 }
 
 func NewFsm(log logr.Logger, cfg RCCfg, k8s K8s) Fsm {
+	if cfg.Clock == nil {
+		cfg.Clock = clock.RealClock{}
+	}
+
 	return &fsm{
 		fn:    sFnTakeSnapshot,
 		RCCfg: cfg,
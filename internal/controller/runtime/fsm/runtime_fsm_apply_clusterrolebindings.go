@@ -34,8 +34,9 @@ func sFnApplyClusterRoleBindings(ctx context.Context, m *fsm, s *systemState) (s
 		return requeue()
 	}
 
-	removed := getRemoved(crbList.Items, s.instance.Spec.Security.Administrators)
-	missing := getMissing(crbList.Items, s.instance.Spec.Security.Administrators)
+	admins := administratorSubjects(s.instance.Spec.Security.Administrators, s.instance.Spec.Security.AdministratorGroups)
+	removed := getRemoved(crbList.Items, admins)
+	missing := getMissing(crbList.Items, admins)
 
 	for _, fn := range []func() error{
 		newDelCRBs(ctx, runtimeClient, removed),
@@ -74,19 +75,41 @@ func logDeletedClusterRoleBindings(removed []rbacv1.ClusterRoleBinding, m *fsm,
 	}
 }
 
-func isRBACUserKind() func(rbacv1.Subject) bool {
+// administratorSubjects returns the RBAC subjects granted cluster-admin access, the kind of every subject is kept so that users and groups with the same name are distinguished
+func administratorSubjects(users, groups []string) []rbacv1.Subject {
+	var subjects []rbacv1.Subject
+	for _, user := range users {
+		subjects = append(subjects, toAdminSubject(rbacv1.UserKind, user))
+	}
+	for _, group := range groups {
+		subjects = append(subjects, toAdminSubject(rbacv1.GroupKind, group))
+	}
+	return subjects
+}
+
+func toAdminSubject(kind, name string) rbacv1.Subject {
+	return rbacv1.Subject{
+		Kind:     kind,
+		Name:     name,
+		APIGroup: rbacv1.GroupName,
+	}
+}
+
+func isRBACUserOrGroupKind() func(rbacv1.Subject) bool {
 	return func(s rbacv1.Subject) bool {
-		return s.Kind == rbacv1.UserKind
+		return s.Kind == rbacv1.UserKind || s.Kind == rbacv1.GroupKind
 	}
 }
 
-func isRBACUserKindOneOf(names []string) func(rbacv1.Subject) bool {
+func isRBACSubjectOneOf(subjects []rbacv1.Subject) func(rbacv1.Subject) bool {
 	return func(s rbacv1.Subject) bool {
-		return slices.Contains(names, s.Name)
+		return slices.ContainsFunc(subjects, func(subject rbacv1.Subject) bool {
+			return subject.Kind == s.Kind && subject.Name == s.Name
+		})
 	}
 }
 
-func getRemoved(crbs []rbacv1.ClusterRoleBinding, admins []string) (removed []rbacv1.ClusterRoleBinding) {
+func getRemoved(crbs []rbacv1.ClusterRoleBinding, admins []rbacv1.Subject) (removed []rbacv1.ClusterRoleBinding) {
 	// iterate over cluster role bindings to find out removed administrators
 	for _, crb := range crbs {
 		if !managedByKIM(crb) {
@@ -99,12 +122,12 @@ func getRemoved(crbs []rbacv1.ClusterRoleBinding, admins []string) (removed []rb
 			continue
 		}
 
-		if !slices.ContainsFunc(crb.Subjects, isRBACUserKind()) {
-			// cluster role binding is not user kind
+		if !slices.ContainsFunc(crb.Subjects, isRBACUserOrGroupKind()) {
+			// cluster role binding is not user or group kind
 			continue
 		}
 
-		if slices.ContainsFunc(crb.Subjects, isRBACUserKindOneOf(admins)) {
+		if slices.ContainsFunc(crb.Subjects, isRBACSubjectOneOf(admins)) {
 			// the administrator was not removed
 			continue
 		}
@@ -123,23 +146,23 @@ func managedByKIM(crb rbacv1.ClusterRoleBinding) bool {
 }
 
 //nolint:gochecknoglobals
-var newContainsAdmin = func(admin string) func(rbacv1.ClusterRoleBinding) bool {
+var newContainsAdmin = func(admin rbacv1.Subject) func(rbacv1.ClusterRoleBinding) bool {
 	return func(crb rbacv1.ClusterRoleBinding) bool {
 		if !managedByKIM(crb) {
 			return false
 		}
-		isAdmin := isRBACUserKindOneOf([]string{admin})
+		isAdmin := isRBACSubjectOneOf([]rbacv1.Subject{admin})
 		return slices.ContainsFunc(crb.Subjects, isAdmin)
 	}
 }
 
-func getMissing(crbs []rbacv1.ClusterRoleBinding, admins []string) (missing []rbacv1.ClusterRoleBinding) {
+func getMissing(crbs []rbacv1.ClusterRoleBinding, admins []rbacv1.Subject) (missing []rbacv1.ClusterRoleBinding) {
 	for _, admin := range admins {
 		containsAdmin := newContainsAdmin(admin)
 		if slices.ContainsFunc(crbs, containsAdmin) {
 			continue
 		}
-		crb := toAdminClusterRoleBinding(admin)
+		crb := toSubjectClusterRoleBindingWithLabel(admin, "reconciler.kyma-project.io/managed-by", "infrastructure-manager")
 		missing = append(missing, crb)
 	}
 
@@ -147,6 +170,10 @@ func getMissing(crbs []rbacv1.ClusterRoleBinding, admins []string) (missing []rb
 }
 
 func toAdminClusterRoleBindingWithLabel(name string, key, value string) rbacv1.ClusterRoleBinding {
+	return toSubjectClusterRoleBindingWithLabel(toAdminSubject(rbacv1.UserKind, name), key, value)
+}
+
+func toSubjectClusterRoleBindingWithLabel(subject rbacv1.Subject, key, value string) rbacv1.ClusterRoleBinding {
 	// initialize labels
 	labels := map[string]string{}
 	if key != "" {
@@ -158,11 +185,7 @@ func toAdminClusterRoleBindingWithLabel(name string, key, value string) rbacv1.C
 			GenerateName: "admin-",
 			Labels:       labels,
 		},
-		Subjects: []rbacv1.Subject{{
-			Kind:     rbacv1.UserKind,
-			Name:     name,
-			APIGroup: rbacv1.GroupName,
-		}},
+		Subjects: []rbacv1.Subject{subject},
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: rbacv1.GroupName,
 			Kind:     "ClusterRole",
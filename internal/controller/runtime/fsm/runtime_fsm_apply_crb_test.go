@@ -25,12 +25,13 @@ var _ = Describe(`runtime_fsm_apply_crb`, Label("applyCRB"), func() {
 		m.On("SetRuntimeStates", mock.Anything).Return()
 		m.On("CleanUpRuntimeGauge", mock.Anything, mock.Anything).Return()
 		m.On("IncRuntimeFSMStopCounter").Return()
+		m.On("ObserveRuntimeProvisioningDuration", mock.Anything, mock.Anything).Return()
 		return withMetrics(m)
 	}
 
 	DescribeTable("getMissing",
 		func(tc tcCRBData) {
-			actual := getMissing(tc.crbs, tc.admins)
+			actual := getMissing(tc.crbs, administratorSubjects(tc.admins, tc.groups))
 			Expect(actual).To(BeComparableTo(tc.expected))
 		},
 		Entry("should return a list with CRBs to be created", tcCRBData{
@@ -57,13 +58,46 @@ var _ = Describe(`runtime_fsm_apply_crb`, Label("applyCRB"), func() {
 			},
 			expected: nil,
 		}),
+		Entry("should return a list with CRBs to be created for users and groups", tcCRBData{
+			admins: []string{"test1"},
+			groups: []string{"test1", "admins"},
+			crbs: []rbacv1.ClusterRoleBinding{
+				toAdminClusterRoleBinding("test1"),
+			},
+			expected: []rbacv1.ClusterRoleBinding{
+				toAdminGroupClusterRoleBinding("test1"),
+				toAdminGroupClusterRoleBinding("admins"),
+			},
+		}),
 	)
 
 	DescribeTable("getRemoved",
 		func(tc tcCRBData) {
-			actual := getRemoved(tc.crbs, tc.admins)
+			actual := getRemoved(tc.crbs, administratorSubjects(tc.admins, tc.groups))
 			Expect(actual).To(BeComparableTo(tc.expected))
 		},
+		Entry("should remove group CRB when only the user with the same name is an admin", tcCRBData{
+			admins: []string{"test1"},
+			crbs: []rbacv1.ClusterRoleBinding{
+				toAdminClusterRoleBinding("test1"),
+				toAdminGroupClusterRoleBinding("test1"),
+			},
+			expected: []rbacv1.ClusterRoleBinding{
+				toAdminGroupClusterRoleBinding("test1"),
+			},
+		}),
+		Entry("should keep CRBs of admin users and groups", tcCRBData{
+			admins: []string{"test1"},
+			groups: []string{"admins"},
+			crbs: []rbacv1.ClusterRoleBinding{
+				toAdminClusterRoleBinding("test1"),
+				toAdminGroupClusterRoleBinding("admins"),
+				toAdminGroupClusterRoleBinding("removed"),
+			},
+			expected: []rbacv1.ClusterRoleBinding{
+				toAdminGroupClusterRoleBinding("removed"),
+			},
+		}),
 		Entry("should return nil list if CRB list is nil", tcCRBData{
 			admins:   []string{"test1"},
 			crbs:     nil,
@@ -244,9 +278,28 @@ var _ = Describe(`runtime_fsm_apply_crb`, Label("applyCRB"), func() {
 	)
 })
 
+var _ = Describe("runtime_fsm_apply_crb users and groups", Label("applyCRB"), func() {
+	It("should keep the subject kinds of administrators when CRBs are reconciled again", func() {
+		admins := administratorSubjects([]string{"admin@example.com", "ops"}, []string{"ops", "admins"})
+
+		created := getMissing(nil, admins)
+		Expect(created).To(HaveLen(4))
+
+		var subjects []rbacv1.Subject
+		for _, crb := range created {
+			subjects = append(subjects, crb.Subjects...)
+		}
+		Expect(subjects).To(ConsistOf(admins))
+
+		Expect(getMissing(created, admins)).To(BeEmpty())
+		Expect(getRemoved(created, admins)).To(BeEmpty())
+	})
+})
+
 type tcCRBData struct {
 	crbs     []rbacv1.ClusterRoleBinding
 	admins   []string
+	groups   []string
 	expected []rbacv1.ClusterRoleBinding
 }
 
@@ -288,6 +341,11 @@ func toManagedClusterRoleBinding(name, managedBy string) rbacv1.ClusterRoleBindi
 		"reconciler.kyma-project.io/managed-by", managedBy)
 }
 
+func toAdminGroupClusterRoleBinding(name string) rbacv1.ClusterRoleBinding {
+	return toSubjectClusterRoleBindingWithLabel(toAdminSubject(rbacv1.GroupKind, name),
+		"reconciler.kyma-project.io/managed-by", "infrastructure-manager")
+}
+
 func toServiceAccountClusterRoleBinding(name string) rbacv1.ClusterRoleBinding {
 	return rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
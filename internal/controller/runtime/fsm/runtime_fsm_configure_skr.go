@@ -41,6 +41,12 @@ func sFnConfigureSKR(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctr
 	}
 	m.log.V(log_level.DEBUG).Info("kyma-provisioning-info config map is created/updated")
 
+	if err := applyDefaultNetworkPolicies(ctx, m, s); err != nil {
+		m.log.Error(err, networkPoliciesErrorMessage)
+		updateConditionFailed(&s.instance, imv1.ConditionReasonNetworkPoliciesError, networkPoliciesErrorMessage)
+		return updateStatusAndRequeueAfter(m.ControlPlaneRequeueDuration)
+	}
+
 	if !isOidcExtensionEnabled(*s.shoot) {
 		m.log.V(log_level.DEBUG).Info("OIDC extension is disabled")
 		s.instance.UpdateStatePending(
@@ -156,7 +162,7 @@ func recreateOpenIDConnectResources(ctx context.Context, m *fsm, s *systemState,
 	additionalOidcConfigs := *s.instance.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig
 	var errResourceCreation error
 	for id, additionalOidcConfig := range additionalOidcConfigs {
-		openIDConnectResource := createOpenIDConnectResource(additionalOidcConfig, id)
+		openIDConnectResource := createOpenIDConnectResource(additionalOidcConfig, id, m.ConverterConfig.Kubernetes.DefaultOidcSigningAlgs)
 		errResourceCreation = runtimeClient.Create(ctx, openIDConnectResource)
 	}
 	return errResourceCreation
@@ -182,8 +188,14 @@ func isOidcExtensionEnabled(shoot gardener.Shoot) bool {
 	return false
 }
 
-func createOpenIDConnectResource(additionalOidcConfig imv1.OIDCConfig, oidcID int) *authenticationv1alpha1.OpenIDConnect {
+// createOpenIDConnectResource creates the OpenIDConnect resource for the additional OIDC config,
+// the default signing algorithms are used when the config doesn't specify any
+func createOpenIDConnectResource(additionalOidcConfig imv1.OIDCConfig, oidcID int, defaultSigningAlgs []string) *authenticationv1alpha1.OpenIDConnect {
 	toSupportedSigningAlgs := func(signingAlgs []string) []authenticationv1alpha1.SigningAlgorithm {
+		if len(signingAlgs) == 0 {
+			signingAlgs = defaultSigningAlgs
+		}
+
 		var supportedSigningAlgs []authenticationv1alpha1.SigningAlgorithm
 		for _, alg := range signingAlgs {
 			supportedSigningAlgs = append(supportedSigningAlgs, authenticationv1alpha1.SigningAlgorithm(alg))
@@ -5,6 +5,7 @@ import (
 	fsm_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/mocks"
 	"github.com/stretchr/testify/mock"
 	core_v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	util "k8s.io/apimachinery/pkg/util/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
@@ -515,6 +516,158 @@ func assertSuccesfullStatusConditions(t *testing.T, systemState *systemState) {
 	assertEqualConditions(t, expectedRuntimeConditions, systemState.instance.Status.Conditions)
 }
 
+func TestSkrConfigStateDefaultNetworkPolicies(t *testing.T) {
+	fixSystemState := func(annotations map[string]string) *systemState {
+		runtimeStub := runtimeForTest()
+		runtimeStub.Annotations = annotations
+		shootStub := fsm_testing.TestShootForPatch()
+		shootStub.Spec.Extensions = append(shootStub.Spec.Extensions, gardener.Extension{
+			Type:     "shoot-oidc-service",
+			Disabled: ptr.To(true),
+		})
+
+		return &systemState{
+			instance: runtimeStub,
+			shoot:    shootStub,
+		}
+	}
+
+	fixNetworkPoliciesConfig := func() config.DefaultNetworkPoliciesConfig {
+		return config.DefaultNetworkPoliciesConfig{
+			Enabled:    true,
+			Namespaces: []string{"default", "missing"},
+		}
+	}
+
+	t.Run("Should apply default network policies to existing workload namespaces", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fakeClient, testFsm := setupFakeClient()
+		testFsm.ClusterConfig.DefaultNetworkPolicies = fixNetworkPoliciesConfig()
+		require.NoError(t, fakeClient.Create(ctx, &core_v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}))
+
+		// when
+		stateFn, _, _ := sFnConfigureSKR(ctx, testFsm, fixSystemState(nil))
+
+		// then
+		require.Contains(t, stateFn.name(), "sFnApplyClusterRoleBindings")
+
+		var networkPolicies networkingv1.NetworkPolicyList
+		require.NoError(t, fakeClient.List(ctx, &networkPolicies))
+		require.Len(t, networkPolicies.Items, 2)
+		for _, networkPolicy := range networkPolicies.Items {
+			assert.Equal(t, "default", networkPolicy.Namespace)
+			assert.Equal(t, "infrastructure-manager", networkPolicy.Labels[imv1.LabelKymaManagedBy])
+		}
+		assert.ElementsMatch(t, []string{"kyma-default-deny", "kyma-allow-dns"}, []string{networkPolicies.Items[0].Name, networkPolicies.Items[1].Name})
+	})
+
+	t.Run("Should apply configured network policies instead of the defaults", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fakeClient, testFsm := setupFakeClient()
+		testFsm.ClusterConfig.DefaultNetworkPolicies = fixNetworkPoliciesConfig()
+		testFsm.ClusterConfig.DefaultNetworkPolicies.Policies = []config.NetworkPolicyConfig{
+			{
+				Name: "deny-ingress",
+				Spec: networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+			},
+		}
+		require.NoError(t, fakeClient.Create(ctx, &core_v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}))
+
+		// when
+		stateFn, _, _ := sFnConfigureSKR(ctx, testFsm, fixSystemState(nil))
+
+		// then
+		require.Contains(t, stateFn.name(), "sFnApplyClusterRoleBindings")
+
+		var networkPolicies networkingv1.NetworkPolicyList
+		require.NoError(t, fakeClient.List(ctx, &networkPolicies))
+		require.Len(t, networkPolicies.Items, 1)
+		assert.Equal(t, "deny-ingress", networkPolicies.Items[0].Name)
+		assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, networkPolicies.Items[0].Spec.PolicyTypes)
+	})
+
+	t.Run("Should remove default network policies when Runtime opts out", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fakeClient, testFsm := setupFakeClient()
+		testFsm.ClusterConfig.DefaultNetworkPolicies = fixNetworkPoliciesConfig()
+		require.NoError(t, fakeClient.Create(ctx, &core_v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}))
+		require.NoError(t, fakeClient.Create(ctx, &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{
+			Name:      "kyma-default-deny",
+			Namespace: "default",
+			Labels:    map[string]string{imv1.LabelKymaManagedBy: "infrastructure-manager"},
+		}}))
+		require.NoError(t, fakeClient.Create(ctx, &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{
+			Name:      "user-policy",
+			Namespace: "default",
+		}}))
+
+		// when
+		stateFn, _, _ := sFnConfigureSKR(ctx, testFsm, fixSystemState(map[string]string{"operator.kyma-project.io/disable-default-network-policies": "true"}))
+
+		// then
+		require.Contains(t, stateFn.name(), "sFnApplyClusterRoleBindings")
+
+		var networkPolicies networkingv1.NetworkPolicyList
+		require.NoError(t, fakeClient.List(ctx, &networkPolicies))
+		require.Len(t, networkPolicies.Items, 1)
+		assert.Equal(t, "user-policy", networkPolicies.Items[0].Name)
+	})
+
+	t.Run("Should remove previously applied network policies no longer in the configured policy set", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fakeClient, testFsm := setupFakeClient()
+		testFsm.ClusterConfig.DefaultNetworkPolicies = fixNetworkPoliciesConfig()
+		testFsm.ClusterConfig.DefaultNetworkPolicies.Policies = []config.NetworkPolicyConfig{
+			{
+				Name: "deny-ingress",
+				Spec: networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+			},
+		}
+		require.NoError(t, fakeClient.Create(ctx, &core_v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}))
+		require.NoError(t, fakeClient.Create(ctx, &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{
+			Name:      "kyma-default-deny",
+			Namespace: "default",
+			Labels:    map[string]string{imv1.LabelKymaManagedBy: "infrastructure-manager"},
+		}}))
+		require.NoError(t, fakeClient.Create(ctx, &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{
+			Name:      "user-policy",
+			Namespace: "default",
+		}}))
+
+		// when
+		stateFn, _, _ := sFnConfigureSKR(ctx, testFsm, fixSystemState(nil))
+
+		// then
+		require.Contains(t, stateFn.name(), "sFnApplyClusterRoleBindings")
+
+		var networkPolicies networkingv1.NetworkPolicyList
+		require.NoError(t, fakeClient.List(ctx, &networkPolicies))
+		require.Len(t, networkPolicies.Items, 2)
+		assert.ElementsMatch(t, []string{"deny-ingress", "user-policy"}, []string{networkPolicies.Items[0].Name, networkPolicies.Items[1].Name})
+	})
+
+	t.Run("Should not apply network policies when disabled in configuration", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fakeClient, testFsm := setupFakeClient()
+		require.NoError(t, fakeClient.Create(ctx, &core_v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}))
+
+		// when
+		stateFn, _, _ := sFnConfigureSKR(ctx, testFsm, fixSystemState(nil))
+
+		// then
+		require.Contains(t, stateFn.name(), "sFnApplyClusterRoleBindings")
+
+		var networkPolicies networkingv1.NetworkPolicyList
+		require.NoError(t, fakeClient.List(ctx, &networkPolicies))
+		assert.Empty(t, networkPolicies.Items)
+	})
+}
+
 func setupFakeClient() (client.WithWatch, *fsm) {
 	// start of fake client setup
 	scheme := createConfigureSKRScheme()
@@ -552,11 +705,43 @@ func createConfigureSKRScheme() *api.Scheme {
 	util.Must(imv1.AddToScheme(testScheme))
 	util.Must(gardener.AddToScheme(testScheme))
 	util.Must(core_v1.AddToScheme(testScheme))
+	util.Must(networkingv1.AddToScheme(testScheme))
 	util.Must(authenticationv1alpha1.AddToScheme(testScheme))
 	return testScheme
 }
 
 // sets the time to its zero value for comparison purposes
+func TestCreateOpenIDConnectResourceSigningAlgs(t *testing.T) {
+	for tname, tc := range map[string]struct {
+		signingAlgs         []string
+		defaultSigningAlgs  []string
+		expectedSigningAlgs []authenticationv1alpha1.SigningAlgorithm
+	}{
+		"Should use the default signing algorithms when the config doesn't specify any": {
+			defaultSigningAlgs:  []string{"RS256", "ES256"},
+			expectedSigningAlgs: []authenticationv1alpha1.SigningAlgorithm{"RS256", "ES256"},
+		},
+		"Should keep the signing algorithms of the config": {
+			signingAlgs:         []string{"PS256"},
+			defaultSigningAlgs:  []string{"RS256"},
+			expectedSigningAlgs: []authenticationv1alpha1.SigningAlgorithm{"PS256"},
+		},
+		"Should leave the signing algorithms unset without defaults": {},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			oidcConfig := createGardenerOidcConfig("client-id")
+			oidcConfig.SigningAlgs = tc.signingAlgs
+
+			// when
+			openIDConnect := createOpenIDConnectResource(oidcConfig, 0, tc.defaultSigningAlgs)
+
+			// then
+			assert.Equal(t, tc.expectedSigningAlgs, openIDConnect.Spec.SupportedSigningAlgs)
+		})
+	}
+}
+
 func assertEqualConditions(t *testing.T, expectedConditions []metav1.Condition, actualConditions []metav1.Condition) bool {
 	for i := range actualConditions {
 		actualConditions[i].LastTransitionTime = metav1.Time{}
@@ -13,10 +13,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-const (
-	kubeconfigSecretKey = "config"
-)
-
 func sFnHandleKubeconfig(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
 	runtimeID := s.instance.Labels[imv1.LabelKymaRuntimeID]
 
@@ -111,7 +107,7 @@ func makeGardenerClusterForRuntime(runtime imv1.Runtime, shoot *gardener.Shoot)
 				Secret: imv1.Secret{
 					Name:      fmt.Sprintf("kubeconfig-%s", runtime.Labels[imv1.LabelKymaRuntimeID]),
 					Namespace: runtime.Namespace,
-					Key:       kubeconfigSecretKey,
+					Key:       runtime.GetKubeconfigSecretKey(),
 				},
 			},
 		},
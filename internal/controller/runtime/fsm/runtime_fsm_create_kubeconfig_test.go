@@ -41,6 +41,7 @@ var _ = Describe("KIM sFnCreateKubeconfig", func() {
 		m.On("SetRuntimeStates", mock.Anything).Return()
 		m.On("CleanUpRuntimeGauge", mock.Anything, mock.Anything).Return()
 		m.On("IncRuntimeFSMStopCounter").Return()
+		m.On("ObserveRuntimeProvisioningDuration", mock.Anything, mock.Anything).Return()
 		return withMetrics(m)
 	}
 
@@ -210,3 +211,29 @@ func makeInputRuntimeWithLabels() *imv1.Runtime {
 		},
 	}
 }
+
+var _ = Describe("KIM makeGardenerClusterForRuntime", func() {
+	testShoot := &gardener.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-shoot"},
+		Spec: gardener.ShootSpec{
+			DNS: &gardener.DNS{
+				Domain: ptr.To("test-domain"),
+			},
+		},
+	}
+
+	It("should use the default kubeconfig secret key when the Runtime does not specify it", func() {
+		cluster := makeGardenerClusterForRuntime(*makeInputRuntimeWithLabels(), testShoot)
+
+		Expect(cluster.Spec.Kubeconfig.Secret.Key).To(Equal(imv1.DefaultKubeconfigSecretKey))
+	})
+
+	It("should use the kubeconfig secret key of the Runtime", func() {
+		runtime := makeInputRuntimeWithLabels()
+		runtime.Spec.Shoot.KubeconfigSecretKey = "kubeconfig"
+
+		cluster := makeGardenerClusterForRuntime(*runtime, testShoot)
+
+		Expect(cluster.Spec.Kubeconfig.Secret.Key).To(Equal("kubeconfig"))
+	})
+})
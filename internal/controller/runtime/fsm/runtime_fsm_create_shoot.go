@@ -2,13 +2,16 @@ package fsm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
 	gardener_shoot "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/structuredauth"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -16,10 +19,59 @@ import (
 const (
 	msgFailedToConfigureAuditlogs     = "Failed to configure audit logs"
 	msgFailedStructuredConfigMap      = "Failed to create structured authentication config map"
+	msgFailedOIDCConfigReferences     = "Failed to resolve the values of the OIDC config referenced in the Runtime"
 	msgFailedToConfigureRegistryCache = "Failed to configure registry cache"
 )
 
+// handleOIDCConfigReferencesError stops the reconciliation when the OIDC config references of the Runtime are invalid,
+// the Runtime is requeued on other errors as the referenced ConfigMaps are not watched
+func handleOIDCConfigReferencesError(m *fsm, s *systemState, err error) (stateFn, *ctrl.Result, error) {
+	m.log.Error(err, msgFailedOIDCConfigReferences)
+
+	if !errors.Is(err, structuredauth.ErrInvalidOIDCConfigReference) {
+		s.instance.UpdateStatePending(
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonOidcError,
+			"False",
+			fmt.Sprintf("%s: %s", msgFailedOIDCConfigReferences, err),
+		)
+		return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
+	}
+
+	m.Metrics.IncRuntimeFSMStopCounter()
+	return updateStatePendingWithErrorAndStop(
+		&s.instance,
+		imv1.ConditionTypeRuntimeProvisioned,
+		imv1.ConditionReasonOidcError,
+		fmt.Sprintf("%s: %s", msgFailedOIDCConfigReferences, err))
+}
+
 func sFnCreateShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	namespaceExists, err := gardenerNamespaceExists(ctx, m.GardenClient, m.ShootNamesapace)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to verify whether the Gardener namespace %s exists.", m.ShootNamesapace)
+		m.log.Error(err, msg)
+		s.instance.UpdateStatePending(
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonGardenerError,
+			"False",
+			msg,
+		)
+		return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
+	}
+
+	if !namespaceExists {
+		msg := fmt.Sprintf("Gardener namespace %s does not exist, check the Gardener project configured for the infrastructure manager.", m.ShootNamesapace)
+		m.log.Error(nil, msg)
+		s.instance.UpdateStatePending(
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonGardenerNamespaceNotFound,
+			"False",
+			msg,
+		)
+		return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
+	}
+
 	if s.instance.Spec.Shoot.EnforceSeedLocation != nil && *s.instance.Spec.Shoot.EnforceSeedLocation {
 		seedAvailable, regionsWithSeeds, err := seedForRegionAvailable(ctx, m.GardenClient, s.instance.Spec.Shoot.Provider.Type, s.instance.Spec.Shoot.Region)
 		if err != nil {
@@ -31,7 +83,7 @@ func sFnCreateShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl
 				"False",
 				msg,
 			)
-			return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+			return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
 		}
 
 		if !seedAvailable {
@@ -48,8 +100,12 @@ func sFnCreateShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl
 
 	cmName := fmt.Sprintf(extender.StructuredAuthConfigFmt, s.instance.Spec.Shoot.Name)
 	oidcConfig := structuredauth.GetOIDCConfigOrDefault(s.instance, m.ConverterConfig.Kubernetes.DefaultOperatorOidc.ToOIDCConfig())
+	oidcConfig, err = structuredauth.ResolveOIDCConfigReferences(ctx, m.KcpClient, s.instance.Namespace, s.instance.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfigFrom, oidcConfig)
+	if err != nil {
+		return handleOIDCConfigReferencesError(m, s, err)
+	}
 
-	err := structuredauth.CreateOrUpdateStructuredAuthConfigMap(ctx, m.GardenClient, types.NamespacedName{Name: cmName, Namespace: m.ShootNamesapace}, oidcConfig)
+	err = structuredauth.CreateOrUpdateStructuredAuthConfigMap(ctx, m.GardenClient, types.NamespacedName{Name: cmName, Namespace: m.ShootNamesapace}, oidcConfig, structuredauth.GetAdditionalOIDCConfigs(s.instance)...)
 	if err != nil {
 		m.log.Error(err, "Failed to create structured authentication config map")
 
@@ -58,17 +114,27 @@ func sFnCreateShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl
 			&s.instance,
 			imv1.ConditionTypeRuntimeProvisioned,
 			imv1.ConditionReasonOidcError,
-			msgFailedStructuredConfigMap)
+			fmt.Sprintf("%s: %s", msgFailedStructuredConfigMap, err))
 	}
 
 	data, err := m.AuditLogging.GetAuditLogData(
 		s.instance.Spec.Shoot.Provider.Type,
 		s.instance.Spec.Shoot.Region)
 
+	if err == nil {
+		err = verifyAuditPolicyConfigMap(ctx, m, s)
+		if isAuditPolicyConfigMapReadError(err) {
+			return updateStatusAndRequeueOnAuditPolicyConfigMapReadError(m, s, err)
+		}
+	}
+
 	if err != nil {
 		m.log.Error(err, msgFailedToConfigureAuditlogs)
+		data = auditlogs.AuditLogData{}
 	}
 
+	updateAuditLogCondition(m, s, err)
+
 	if err != nil && m.AuditLogMandatory {
 		m.Metrics.IncRuntimeFSMStopCounter()
 		return updateStatePendingWithErrorAndStop(
@@ -78,10 +144,13 @@ func sFnCreateShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl
 			msgFailedToConfigureAuditlogs)
 	}
 
+	cloudProfileName, _ := extender.RuntimeCloudProfileName(s.instance)
+
 	shoot, err := convertCreate(&s.instance, gardener_shoot.CreateOpts{
-		ConverterConfig:       m.ConverterConfig,
-		AuditLogData:          data,
-		MaintenanceTimeWindow: getMaintenanceTimeWindow(s, m),
+		ConverterConfig:             m.ConverterConfig,
+		AuditLogData:                data,
+		MaintenanceTimeWindow:       getMaintenanceTimeWindow(s, m),
+		AvailableKubernetesVersions: availableKubernetesVersions(ctx, m, s.instance, cloudProfileName),
 	})
 	if err != nil {
 		m.log.Error(err, "Failed to convert Runtime instance to shoot object")
@@ -95,6 +164,10 @@ func sFnCreateShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl
 
 	err = m.GardenClient.Create(ctx, &shoot)
 	if err != nil {
+		if k8serrors.IsTooManyRequests(err) {
+			return updateStatusAndRequeueAfterRateLimit(m, s, err)
+		}
+
 		m.log.Error(err, "Failed to create new gardener Shoot")
 		s.instance.UpdateStatePending(
 			imv1.ConditionTypeRuntimeProvisioned,
@@ -102,7 +175,7 @@ func sFnCreateShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl
 			"False",
 			fmt.Sprintf("Gardener API create error: %v", err),
 		)
-		return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+		return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
 	}
 
 	m.log.V(log_level.DEBUG).Info(
@@ -118,7 +191,7 @@ func sFnCreateShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl
 		"Shoot is pending",
 	)
 
-	return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+	return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
 }
 
 func convertCreate(instance *imv1.Runtime, opts gardener_shoot.CreateOpts) (gardener.Shoot, error) {
@@ -8,6 +8,7 @@ import (
 
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
 	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -30,6 +31,17 @@ func sFnInitialize(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.
 	// instance is being deleted
 	if instanceIsBeingDeleted {
 		if s.shoot != nil {
+			if reconciler.IsDeletionProtected(s.instance.Annotations) && s.shoot.GetDeletionTimestamp().IsZero() {
+				m.log.Info("Runtime is protected against deletion, shoot will not be deleted", "instance", s.instance.Name)
+				s.instance.UpdateStateDeletion(
+					imv1.ConditionTypeRuntimeDeprovisioned,
+					imv1.ConditionReasonDeletionProtected,
+					"False",
+					fmt.Sprintf("Runtime is protected against deletion, remove the %s annotation to proceed", reconciler.DeletionProtectionAnnotation),
+				)
+				return updateStatusAndStop()
+			}
+
 			return switchState(sFnDeleteKubeconfig)
 		}
 
@@ -80,6 +80,49 @@ var _ = Describe("KIM sFnInitialise", func() {
 		},
 	}
 
+	testRtWithFinalizerOfOtherInstance := imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-instance",
+			Namespace:  "default",
+			Finalizers: []string{imv1.Finalizer},
+		},
+	}
+
+	testRtWithDeletionTimestampAndFinalizers := imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-instance",
+			Namespace:         "default",
+			DeletionTimestamp: &now,
+			Finalizers:        []string{imv1.Finalizer, "test-me-plz"},
+		},
+	}
+
+	testRtWithDeletionProtection := imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"test-me-plz"},
+			Annotations:       map[string]string{"operator.kyma-project.io/deletion-protection": "true"},
+		},
+	}
+
+	testRtWithDeletionProtectionRemoved := imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			DeletionTimestamp: &now,
+			Finalizers:        []string{"test-me-plz"},
+			Annotations:       map[string]string{"operator.kyma-project.io/deletion-protection": "false"},
+		},
+	}
+
+	haveDeprovisionedReason := func(reason imv1.RuntimeConditionReason) types.GomegaMatcher {
+		return WithTransform(func(rt *imv1.Runtime) string {
+			condition := meta.FindStatusCondition(rt.Status.Conditions, string(imv1.ConditionTypeRuntimeDeprovisioned))
+			if condition == nil {
+				return ""
+			}
+			return condition.Reason
+		}, Equal(string(reason)))
+	}
+
 	testShoot := gardener.Shoot{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-instance",
@@ -124,6 +167,30 @@ var _ = Describe("KIM sFnInitialise", func() {
 				MatchNextFnState: haveName("sFnDeleteKubeconfig"),
 			},
 		),
+		Entry(
+			"should return sFnUpdateStatus and keep the shoot when CR is being deleted with deletion protection",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withMockedMetrics(), withDefaultReconcileDuration()),
+			&systemState{instance: testRtWithDeletionProtection, shoot: &testShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnUpdateStatus"),
+				StateMatch: []types.GomegaMatcher{
+					haveFinalizer("test-me-plz"),
+					haveDeprovisionedReason(imv1.ConditionReasonDeletionProtected),
+				},
+			},
+		),
+		Entry(
+			"should return sFnDeleteKubeconfig when CR is being deleted and deletion protection has been removed",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withMockedMetrics(), withDefaultReconcileDuration()),
+			&systemState{instance: testRtWithDeletionProtectionRemoved, shoot: &testShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnDeleteKubeconfig"),
+			},
+		),
 		Entry(
 			"should return sFnUpdateStatus and no error when CR has been created without finalizer - Add finalizer",
 			testCtx,
@@ -135,6 +202,28 @@ var _ = Describe("KIM sFnInitialise", func() {
 				StateMatch:       []types.GomegaMatcher{haveFinalizer("test-me-plz")},
 			},
 		),
+		Entry(
+			"should add the configured finalizer and keep the finalizer of other instance",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withTestSchemeAndObjects(&testRtWithFinalizerOfOtherInstance), withMockedMetrics(), withDefaultReconcileDuration()),
+			&systemState{instance: testRtWithFinalizerOfOtherInstance},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: BeNil(),
+				StateMatch:       []types.GomegaMatcher{haveFinalizer("test-me-plz"), haveFinalizer(imv1.Finalizer)},
+			},
+		),
+		Entry(
+			"should remove only the configured finalizer when CR is being deleted and shoot is missing",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withTestSchemeAndObjects(&testRtWithFinalizerOfOtherInstance), withMockedMetrics(), withDefaultReconcileDuration()),
+			&systemState{instance: testRtWithDeletionTimestampAndFinalizers},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnUpdateStatus"),
+				StateMatch:       []types.GomegaMatcher{Not(haveFinalizer("test-me-plz")), haveFinalizer(imv1.Finalizer)},
+			},
+		),
 		Entry(
 			"should return sFnUpdateStatus and no error when there is no Provisioning Condition - Add condition",
 			testCtx,
@@ -0,0 +1,37 @@
+package fsm
+
+import (
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// shootOperationTimedOut returns true when Gardener did not report any progress of the shoot's last operation for longer than ShootOperationTimeout
+func shootOperationTimedOut(m *fsm, shoot *gardener.Shoot) bool {
+	if m.ShootOperationTimeout <= 0 || shoot == nil || shoot.Status.LastOperation == nil {
+		return false
+	}
+
+	lastUpdateTime := shoot.Status.LastOperation.LastUpdateTime
+	if lastUpdateTime.IsZero() {
+		return false
+	}
+
+	return m.Clock.Since(lastUpdateTime.Time) >= m.ShootOperationTimeout
+}
+
+func updateStatusAndStopOnOperationTimeout(m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	msg := fmt.Sprintf("Shoot %s operation %s did not progress for %s", s.shoot.Name, s.shoot.Status.LastOperation.Type, m.ShootOperationTimeout)
+	m.log.Info(msg)
+
+	s.instance.UpdateStatePending(
+		imv1.ConditionTypeRuntimeProvisioned,
+		imv1.ConditionReasonOperationTimeout,
+		"False",
+		msg)
+
+	m.Metrics.IncRuntimeFSMStopCounter()
+	return updateStatusAndStop()
+}
@@ -0,0 +1,95 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("KIM sFnWaitForShoot* operation timeout", func() {
+	const operationTimeout = 30 * time.Minute
+
+	var (
+		startTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		shootWithOperation = func(operationType gardener.LastOperationType, lastUpdateTime time.Time) *gardener.Shoot {
+			return &gardener.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-shoot",
+					Namespace: "garden-test",
+				},
+				Status: gardener.ShootStatus{
+					LastOperation: &gardener.LastOperation{
+						Type:           operationType,
+						State:          gardener.LastOperationStateProcessing,
+						LastUpdateTime: metav1.NewTime(lastUpdateTime),
+					},
+				},
+			}
+		}
+	)
+
+	DescribeTable("should time out a stuck operation only after the clock passes the threshold",
+		func(fn stateFn, operationType gardener.LastOperationType, elapsed time.Duration, expectedReason imv1.RuntimeConditionReason, expectedState string) {
+			fakeClock := clocktesting.NewFakeClock(startTime)
+			fakeClock.Step(elapsed)
+
+			testFsm := must(newFakeFSM,
+				withMockedMetrics(),
+				withClock(fakeClock),
+				withShootOperationTimeout(operationTimeout),
+			)
+
+			systemState := &systemState{
+				instance: imv1.Runtime{},
+				shoot:    shootWithOperation(operationType, startTime),
+			}
+
+			nextFn, _, err := fn(context.Background(), testFsm, systemState)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextFn).To(haveName("sFnUpdateStatus"))
+			Expect(string(systemState.instance.Status.State)).To(Equal(expectedState))
+			Expect(systemState.instance.Status.Conditions).To(HaveLen(1))
+			Expect(systemState.instance.Status.Conditions[0].Reason).To(Equal(string(expectedReason)))
+		},
+		Entry("creation keeps waiting before the threshold",
+			sFnWaitForShootCreation, gardener.LastOperationTypeCreate, operationTimeout-time.Second,
+			imv1.ConditionReasonShootCreationPending, imv1.RuntimeStatePending),
+		Entry("creation times out exactly at the threshold",
+			sFnWaitForShootCreation, gardener.LastOperationTypeCreate, operationTimeout,
+			imv1.ConditionReasonOperationTimeout, imv1.RuntimeStateFailed),
+		Entry("reconcile keeps waiting before the threshold",
+			sFnWaitForShootReconcile, gardener.LastOperationTypeReconcile, operationTimeout-time.Second,
+			imv1.ConditionReasonProcessing, imv1.RuntimeStatePending),
+		Entry("reconcile times out after the threshold",
+			sFnWaitForShootReconcile, gardener.LastOperationTypeReconcile, operationTimeout+time.Second,
+			imv1.ConditionReasonOperationTimeout, imv1.RuntimeStateFailed),
+	)
+
+	It("should never time out when the operation timeout is disabled", func() {
+		fakeClock := clocktesting.NewFakeClock(startTime)
+		fakeClock.Step(24 * time.Hour)
+
+		testFsm := must(newFakeFSM,
+			withMockedMetrics(),
+			withClock(fakeClock),
+		)
+
+		systemState := &systemState{
+			instance: imv1.Runtime{},
+			shoot:    shootWithOperation(gardener.LastOperationTypeCreate, startTime),
+		}
+
+		_, _, err := sFnWaitForShootCreation(context.Background(), testFsm, systemState)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(systemState.instance.Status.State)).To(Equal(imv1.RuntimeStatePending))
+	})
+})
@@ -8,11 +8,13 @@ import (
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/structuredauth"
 	registrycacheapi "github.com/kyma-project/kim-snatch/api/v1beta1"
 	"reflect"
+	"strings"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
 	gardener_shoot "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
 	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -21,17 +23,42 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const fieldManagerName = "kim"
+const (
+	fieldManagerName         = "kim"
+	msgImmutableFieldChanged = "Runtime changes fields which cannot be updated on an existing shoot, the shoot must be recreated to apply them: %s"
+)
 
 func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
-	data, err := m.AuditLogging.GetAuditLogData(
-		s.instance.Spec.Shoot.Provider.Type,
-		s.instance.Spec.Shoot.Region)
+	nextState, res, err := patchExistingShoot(ctx, m, s)
+
+	// the reconcile-now annotation requests a single attempt to patch the shoot, it is removed whatever the outcome of the attempt
+	if reconciler.ShouldReconcileNow(s.instance.Annotations) {
+		if removeErr := removeRuntimeAnnotations(&s.instance, m, ctx, reconciler.ReconcileNowAnnotation); removeErr != nil {
+			m.log.Error(removeErr, "could not remove reconcile now annotation. Scheduling for retry.")
+			return requeue()
+		}
+	}
+
+	return nextState, res, err
+}
+
+func patchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	data, err := getAuditLogData(ctx, m, s)
+
+	if err == nil {
+		err = verifyAuditPolicyConfigMap(ctx, m, s)
+		if isAuditPolicyConfigMapReadError(err) {
+			return updateStatusAndRequeueOnAuditPolicyConfigMapReadError(m, s, err)
+		}
+	}
 
 	if err != nil {
 		m.log.Error(err, msgFailedToConfigureAuditlogs)
+		data = auditlogs.AuditLogData{}
 	}
 
+	updateAuditLogCondition(m, s, err)
+
 	if err != nil && m.AuditLogMandatory {
 		m.Metrics.IncRuntimeFSMStopCounter()
 		return updateStatePendingWithErrorAndStop(
@@ -42,6 +69,10 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 	}
 
 	oidcConfig := structuredauth.GetOIDCConfigOrDefault(s.instance, m.ConverterConfig.Kubernetes.DefaultOperatorOidc.ToOIDCConfig())
+	oidcConfig, err = structuredauth.ResolveOIDCConfigReferences(ctx, m.KcpClient, s.instance.Namespace, s.instance.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfigFrom, oidcConfig)
+	if err != nil {
+		return handleOIDCConfigReferencesError(m, s, err)
+	}
 
 	cmName := fmt.Sprintf(extender.StructuredAuthConfigFmt, s.instance.Spec.Shoot.Name)
 	err = structuredauth.CreateOrUpdateStructuredAuthConfigMap(
@@ -49,6 +80,7 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 		m.GardenClient,
 		types.NamespacedName{Name: cmName, Namespace: m.ShootNamesapace},
 		oidcConfig,
+		structuredauth.GetAdditionalOIDCConfigs(s.instance)...,
 	)
 
 	if err != nil {
@@ -57,21 +89,26 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 			&s.instance,
 			imv1.ConditionTypeRuntimeProvisioned,
 			imv1.ConditionReasonOidcError,
-			msgFailedStructuredConfigMap)
+			fmt.Sprintf("%s: %s", msgFailedStructuredConfigMap, err))
 	}
 
 	// NOTE: In the future we want to pass the whole shoot object here
 	updatedShoot, err := convertPatch(&s.instance, gardener_shoot.PatchOpts{
-		ConverterConfig:       m.ConverterConfig,
-		AuditLogData:          data,
-		MaintenanceTimeWindow: getMaintenanceTimeWindow(s, m),
-		Workers:               s.shoot.Spec.Provider.Workers,
-		ShootK8SVersion:       s.shoot.Spec.Kubernetes.Version,
-		Extensions:            s.shoot.Spec.Extensions,
-		Resources:             s.shoot.Spec.Resources,
-		InfrastructureConfig:  s.shoot.Spec.Provider.InfrastructureConfig,
-		ControlPlaneConfig:    s.shoot.Spec.Provider.ControlPlaneConfig,
-		Log:                   ptr.To(m.log),
+		ConverterConfig:             m.ConverterConfig,
+		AuditLogData:                data,
+		MaintenanceTimeWindow:       getMaintenanceTimeWindow(s, m),
+		ShootName:                   s.shoot.Name,
+		SecretBindingName:           ptr.Deref(s.shoot.Spec.SecretBindingName, ""),
+		Workers:                     s.shoot.Spec.Provider.Workers,
+		NodeCIDRMaskSize:            gardener_shoot.NodeCIDRMaskSize(*s.shoot),
+		ShootK8SVersion:             s.shoot.Spec.Kubernetes.Version,
+		Extensions:                  s.shoot.Spec.Extensions,
+		Resources:                   s.shoot.Spec.Resources,
+		InfrastructureConfig:        s.shoot.Spec.Provider.InfrastructureConfig,
+		ControlPlaneConfig:          s.shoot.Spec.Provider.ControlPlaneConfig,
+		Addons:                      s.shoot.Spec.Addons,
+		Log:                         ptr.To(m.log),
+		AvailableKubernetesVersions: availableKubernetesVersions(ctx, m, s.instance, shootCloudProfileName(s.shoot)),
 	})
 
 	if err != nil {
@@ -94,6 +131,42 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 
 	m.log.V(log_level.DEBUG).Info("Shoot converted successfully", "Name", updatedShoot.Name, "Namespace", updatedShoot.Namespace)
 
+	if changedFields := immutableFieldChanges(s.shoot, &updatedShoot); len(changedFields) > 0 {
+		m.log.Info("Runtime changes immutable shoot fields, the shoot must be recreated", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace, "fields", changedFields)
+		m.Metrics.IncRuntimeFSMStopCounter()
+
+		return updateStatePendingWithErrorAndStop(
+			&s.instance,
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonImmutableFieldChanged,
+			fmt.Sprintf(msgImmutableFieldChanged, strings.Join(changedFields, ", ")))
+	}
+
+	blockedReason, err := controlPlaneHAChangeBlockedReason(ctx, m.GardenClient, s.shoot, &updatedShoot)
+	if err != nil {
+		m.log.Error(err, "Failed to verify the high availability change of the shoot control plane")
+		s.instance.UpdateStatePending(
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonGardenerError,
+			"False",
+			fmt.Sprintf("Failed to verify the high availability change of the shoot control plane: %v", err),
+		)
+		return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
+	}
+
+	if blockedReason != "" {
+		m.log.Info("Runtime changes the high availability of the shoot control plane which cannot be applied", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace, "reason", blockedReason)
+		m.Metrics.IncRuntimeFSMStopCounter()
+
+		return updateStatePendingWithErrorAndStop(
+			&s.instance,
+			imv1.ConditionTypeRuntimeProvisioned,
+			imv1.ConditionReasonHAChangeBlocked,
+			fmt.Sprintf(msgHAChangeBlocked, blockedReason))
+	}
+
+	updateKubernetesVersionExpiryCondition(ctx, m, s)
+
 	registryCacheSecretShouldBeRemoved, err := registrycache.GardenSecretNeedToBeRemoved(s.shoot.Spec.Extensions, s.instance.Spec.Caching)
 	if err != nil {
 		m.log.Error(err, "Failed to check if registry cache secret should be removed")
@@ -102,8 +175,14 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 		return updateStatusAndRequeue()
 	}
 
+	// worker pools without changes are kept as they are on the shoot to avoid unnecessary node rolls
+	updatedShoot.Spec.Provider.Workers = mergeChangedWorkers(s.shoot.Spec.Provider.Workers, updatedShoot.Spec.Provider.Workers)
 	workersShouldBeUpdated := !workersAreEqual(s.shoot.Spec.Provider.Workers, updatedShoot.Spec.Provider.Workers)
 
+	if m.LogShootDiff {
+		logShootDiff(m, s.shoot, &updatedShoot)
+	}
+
 	// The additional Update function is required to fully replace collections with the ones defined in updated runtime object.
 	// This is a workaround for the sigs.k8s.io/controller-runtime/pkg/client, which does not support replacing collections with client.Patch.
 	// The client is able to add an item to the collection, but not to remove it.
@@ -172,7 +251,7 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 		"Shoot is pending for update after patch",
 	)
 
-	return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+	return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
 }
 
 func registryCacheExists(runtime imv1.Runtime) bool {
@@ -197,7 +276,11 @@ func handleUpdateError(err error, m *fsm, s *systemState, errMsg, statusMsg stri
 				"Shoot is pending for update after conflict error",
 			)
 
-			return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+			return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
+		}
+
+		if k8serrors.IsTooManyRequests(err) {
+			return updateStatusAndRequeueAfterRateLimit(m, s, err)
 		}
 
 		// We're retrying on Forbidden error because Gardener returns them from time too time for operations that are properly authorized.
@@ -211,7 +294,7 @@ func handleUpdateError(err error, m *fsm, s *systemState, errMsg, statusMsg stri
 				"Shoot is pending for update after forbidden error",
 			)
 
-			return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+			return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
 		}
 
 		m.log.Error(err, errMsg)
@@ -235,19 +318,99 @@ func workersAreEqual(workers []gardener.Worker, workers2 []gardener.Worker) bool
 	return true
 }
 
+// mergeChangedWorkers returns the desired worker pools, where every pool without changes is replaced with its current version from the shoot.
+// This way the fields defaulted by Gardener are preserved for untouched pools, and only the changed pools are patched.
+func mergeChangedWorkers(current []gardener.Worker, desired []gardener.Worker) []gardener.Worker {
+	currentWorkersMap := make(map[string]gardener.Worker, len(current))
+	for _, worker := range current {
+		currentWorkersMap[worker.Name] = worker
+	}
+
+	merged := make([]gardener.Worker, 0, len(desired))
+	for _, desiredWorker := range desired {
+		currentWorker, found := currentWorkersMap[desiredWorker.Name]
+		if found && !workerChanged(currentWorker, desiredWorker) {
+			merged = append(merged, currentWorker)
+			continue
+		}
+		merged = append(merged, desiredWorker)
+	}
+
+	return merged
+}
+
+// workerChanged compares worker pools ignoring fields which are not set in the desired pool, but are defaulted by Gardener
+func workerChanged(current gardener.Worker, desired gardener.Worker) bool {
+	aligned := desired.DeepCopy()
+
+	if aligned.CRI == nil {
+		aligned.CRI = current.CRI
+	}
+
+	if aligned.Machine.Architecture == nil {
+		aligned.Machine.Architecture = current.Machine.Architecture
+	}
+
+	if aligned.MaxSurge == nil {
+		aligned.MaxSurge = current.MaxSurge
+	}
+
+	if aligned.MaxUnavailable == nil {
+		aligned.MaxUnavailable = current.MaxUnavailable
+	}
+
+	if aligned.SystemComponents == nil {
+		aligned.SystemComponents = current.SystemComponents
+	}
+
+	return !reflect.DeepEqual(current, *aligned)
+}
+
+// getAuditLogData selects the audit log data by the Runtime provider and region.
+// When enabled, the provider and region of the seed the shoot is scheduled on are used instead.
+func getAuditLogData(ctx context.Context, m *fsm, s *systemState) (auditlogs.AuditLogData, error) {
+	providerType := s.instance.Spec.Shoot.Provider.Type
+	region := s.instance.Spec.Shoot.Region
+
+	if m.AuditLogUseSeedProvider && s.shoot != nil && s.shoot.Spec.SeedName != nil {
+		seedProvider, err := getSeedProvider(ctx, m.GardenClient, *s.shoot.Spec.SeedName)
+		if err != nil {
+			return auditlogs.AuditLogData{}, fmt.Errorf("failed to get seed %s: %w", *s.shoot.Spec.SeedName, err)
+		}
+
+		providerType = seedProvider.Type
+		region = seedProvider.Region
+	}
+
+	return m.AuditLogging.GetAuditLogData(providerType, region)
+}
+
 func handleForceReconciliationAnnotation(runtime *imv1.Runtime, fsm *fsm, ctx context.Context) error {
 	annotations := runtime.Annotations
-	if reconciler.ShouldForceReconciliation(annotations) {
+	forceReconciliation := reconciler.ShouldForceReconciliation(annotations)
+	reconcileNow := reconciler.ShouldReconcileNow(annotations)
+	if forceReconciliation || reconcileNow {
 		fsm.log.Info("Force reconciliation annotation found, removing the annotation and continuing the reconciliation")
-		delete(annotations, reconciler.ForceReconcileAnnotation)
-		runtime.SetAnnotations(annotations)
+		return removeRuntimeAnnotations(runtime, fsm, ctx, reconciler.ForceReconcileAnnotation, reconciler.ReconcileNowAnnotation)
+	}
+	return nil
+}
 
-		err := fsm.KcpClient.Update(ctx, runtime)
-		if err != nil {
-			return err
-		}
+func removeRuntimeAnnotations(runtime *imv1.Runtime, fsm *fsm, ctx context.Context, keys ...string) error {
+	annotations := runtime.Annotations
+	for _, key := range keys {
+		delete(annotations, key)
+	}
+	runtime.SetAnnotations(annotations)
 
+	// the update returns the stored status, keep the status updated so far in the reconciliation
+	status := runtime.Status
+	err := fsm.KcpClient.Update(ctx, runtime)
+	if err != nil {
+		return err
 	}
+	runtime.Status = status
+
 	return nil
 }
 
@@ -2,14 +2,19 @@ package fsm
 
 import (
 	"context"
+	metrics_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
 	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/structuredauth"
 	registrycachev1beta1 "github.com/kyma-project/kim-snatch/api/v1beta1"
 	"github.com/pkg/errors"
 	core_v1 "k8s.io/api/core/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
 	"testing"
 	"time"
 
@@ -17,9 +22,12 @@ import (
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	//nolint:revive
 	. "github.com/onsi/gomega" //nolint:revive
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	api "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func TestFSMPatchShoot(t *testing.T) {
@@ -40,6 +48,7 @@ func TestFSMPatchShoot(t *testing.T) {
 
 	expectedAnnotations := map[string]string{"operator.kyma-project.io/existing-annotation": "true"}
 	inputRuntimeWithForceAnnotation := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/force-patch-reconciliation": "true", "operator.kyma-project.io/existing-annotation": "true"})
+	inputRuntimeWithReconcileNowAnnotation := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/reconcile-now": "", "operator.kyma-project.io/existing-annotation": "true"})
 	inputRuntime := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/existing-annotation": "true"})
 	inputRuntimeWithRegistryCacheEnabled := inputRuntime.DeepCopy()
 	inputRuntimeWithRegistryCacheEnabled.Spec.Caching = []imv1.ImageRegistryCache{
@@ -80,6 +89,17 @@ func TestFSMPatchShoot(t *testing.T) {
 				status:      fsm_testing.PendingStatusShootPatched(),
 			},
 		},
+		{
+			"should transition to Pending Unknown state after successful patching and remove reconcile now annotation",
+			setupFakeFSMForTest(testScheme, inputRuntimeWithReconcileNowAnnotation),
+			&systemState{instance: *inputRuntimeWithReconcileNowAnnotation, shoot: fsm_testing.TestShootForPatch()},
+			outputFnState{
+				nextStep:    haveName("sFnUpdateStatus"),
+				annotations: expectedAnnotations,
+				result:      nil,
+				status:      fsm_testing.PendingStatusShootPatched(),
+			},
+		},
 		{
 			"should transition to Failed state when Audit Logs are mandatory and Audit Log Config cannot be read",
 			setupFakeFSMForTestWithAuditLogMandatory(testScheme, inputRuntime),
@@ -168,6 +188,28 @@ func TestFSMPatchShoot(t *testing.T) {
 				status:      fsm_testing.PendingStatusAfterForbiddenErr(),
 			},
 		},
+		{
+			"should remove reconcile now annotation when cannot execute Patch shoot with inConflict error",
+			setupFakeFSMForTestWithFailingPatchWithInConflictError(testScheme, inputRuntimeWithReconcileNowAnnotation.DeepCopy()),
+			&systemState{instance: *inputRuntimeWithReconcileNowAnnotation.DeepCopy(), shoot: fsm_testing.TestShootForPatch()},
+			outputFnState{
+				nextStep:    haveName("sFnUpdateStatus"),
+				annotations: expectedAnnotations,
+				result:      nil,
+				status:      fsm_testing.PendingStatusAfterConflictErr(),
+			},
+		},
+		{
+			"should remove reconcile now annotation when cannot execute Patch shoot with any other error",
+			setupFakeFSMForTestWithFailingPatchWithOtherError(testScheme, inputRuntimeWithReconcileNowAnnotation.DeepCopy()),
+			&systemState{instance: *inputRuntimeWithReconcileNowAnnotation.DeepCopy(), shoot: fsm_testing.TestShootForPatch()},
+			outputFnState{
+				nextStep:    haveName("sFnUpdateStatus"),
+				annotations: expectedAnnotations,
+				result:      nil,
+				status:      fsm_testing.FailedStatusPatchErr(),
+			},
+		},
 		{
 			"should transition to Failed state when cannot execute Update shoot with any other error",
 			setupFakeFSMForTestWithFailingUpdateWithOtherError(testScheme, inputRuntime),
@@ -188,6 +230,10 @@ func TestFSMPatchShoot(t *testing.T) {
 		Expect(err).To(BeNil())
 		Expect(res).To(Equal(entry.expected.result))
 
+		// the audit log condition is covered by TestUpdateAuditLogCondition
+		Expect(meta.FindStatusCondition(entry.systemState.instance.Status.Conditions, string(imv1.ConditionTypeAuditLog))).ToNot(BeNil(), entry.description)
+		meta.RemoveStatusCondition(&entry.systemState.instance.Status.Conditions, string(imv1.ConditionTypeAuditLog))
+
 		if entry.systemState.instance.Status.Conditions != nil {
 			Expect(len(entry.systemState.instance.Status.Conditions)).To(Equal(len(entry.expected.status.Conditions)))
 			for i := range entry.systemState.instance.Status.Conditions {
@@ -202,6 +248,165 @@ func TestFSMPatchShoot(t *testing.T) {
 	}
 }
 
+func TestFSMPatchShootWithChangedOIDCConfig(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	// given
+	inputRuntime := makeInputRuntimeWithAnnotation(nil)
+	inputRuntime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig = gardener.OIDCConfig{
+		ClientID:      ptr.To("new-client-id"),
+		IssuerURL:     ptr.To("https://new-issuer.example.com"),
+		UsernameClaim: ptr.To("sub"),
+		GroupsClaim:   ptr.To("groups"),
+	}
+
+	cmKey := types.NamespacedName{Name: "structured-auth-config-test-shoot", Namespace: "garden-"}
+	testFsm := setupFakeFSMForTest(testScheme, inputRuntime)
+
+	Expect(structuredauth.CreateOrUpdateStructuredAuthConfigMap(context.Background(), testFsm.GardenClient, cmKey, gardener.OIDCConfig{
+		ClientID:      ptr.To("old-client-id"),
+		IssuerURL:     ptr.To("https://old-issuer.example.com"),
+		UsernameClaim: ptr.To("sub"),
+		GroupsClaim:   ptr.To("groups"),
+		GroupsPrefix:  ptr.To("old-prefix-"),
+	})).To(Succeed())
+
+	shoot := fsm_testing.TestShootForPatch()
+	Expect(testFsm.GardenClient.Create(context.Background(), shoot)).To(Succeed())
+
+	// when
+	_, _, err := sFnPatchExistingShoot(context.Background(), testFsm, &systemState{instance: *inputRuntime, shoot: shoot})
+
+	// then
+	Expect(err).To(BeNil())
+
+	var cm core_v1.ConfigMap
+	Expect(testFsm.GardenClient.Get(context.Background(), cmKey, &cm)).To(Succeed())
+
+	var authenticationConfiguration structuredauth.AuthenticationConfiguration
+	Expect(yaml.Unmarshal([]byte(cm.Data["config.yaml"]), &authenticationConfiguration)).To(Succeed())
+	Expect(authenticationConfiguration.JWT).To(HaveLen(1))
+	Expect(authenticationConfiguration.JWT[0].Issuer.URL).To(Equal("https://new-issuer.example.com"))
+	Expect(authenticationConfiguration.JWT[0].Issuer.Audiences).To(Equal([]string{"new-client-id"}))
+	Expect(authenticationConfiguration.JWT[0].ClaimMappings.Groups.Prefix).To(Equal(ptr.To("")))
+}
+
+func TestFSMPatchShootWithOIDCConfigReferences(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(core_v1.AddToScheme(testScheme))
+
+	cmKey := types.NamespacedName{Name: "structured-auth-config-test-shoot", Namespace: "garden-"}
+
+	makeRuntimeWithOIDCConfigReferences := func() *imv1.Runtime {
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig = gardener.OIDCConfig{
+			ClientID:      ptr.To("client-id"),
+			IssuerURL:     ptr.To("https://issuer.example.com"),
+			UsernameClaim: ptr.To("sub"),
+			GroupsClaim:   ptr.To("groups"),
+		}
+		runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfigFrom = &imv1.OIDCConfigReferences{
+			CABundle: &core_v1.ConfigMapKeySelector{LocalObjectReference: core_v1.LocalObjectReference{Name: "oidc-ca"}, Key: "ca.crt"},
+		}
+		return runtime
+	}
+
+	t.Run("Should put the referenced CA bundle into the structured authentication config", func(t *testing.T) {
+		// given
+		inputRuntime := makeRuntimeWithOIDCConfigReferences()
+		caConfigMap := &core_v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "oidc-ca", Namespace: inputRuntime.Namespace},
+			Data:       map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----"},
+		}
+		testFsm := setupFakeFSMForTest(testScheme, inputRuntime, caConfigMap)
+
+		shoot := fsm_testing.TestShootForPatch()
+		Expect(testFsm.GardenClient.Create(context.Background(), shoot)).To(Succeed())
+
+		// when
+		_, _, err := sFnPatchExistingShoot(context.Background(), testFsm, &systemState{instance: *inputRuntime, shoot: shoot})
+
+		// then
+		Expect(err).To(BeNil())
+
+		var cm core_v1.ConfigMap
+		Expect(testFsm.GardenClient.Get(context.Background(), cmKey, &cm)).To(Succeed())
+
+		var authenticationConfiguration structuredauth.AuthenticationConfiguration
+		Expect(yaml.Unmarshal([]byte(cm.Data["config.yaml"]), &authenticationConfiguration)).To(Succeed())
+		Expect(authenticationConfiguration.JWT).To(HaveLen(1))
+		Expect(authenticationConfiguration.JWT[0].Issuer.CertificateAuthority).To(Equal("-----BEGIN CERTIFICATE-----"))
+	})
+
+	t.Run("Should stop with OIDC error when the referenced config map does not exist", func(t *testing.T) {
+		// given
+		inputRuntime := makeRuntimeWithOIDCConfigReferences()
+		testFsm := setupFakeFSMForTest(testScheme, inputRuntime)
+
+		shoot := fsm_testing.TestShootForPatch()
+		Expect(testFsm.GardenClient.Create(context.Background(), shoot)).To(Succeed())
+		systemState := &systemState{instance: *inputRuntime, shoot: shoot}
+
+		// when
+		stateFn, _, err := sFnPatchExistingShoot(context.Background(), testFsm, systemState)
+
+		// then
+		Expect(err).To(BeNil())
+		Expect(stateFn).To(haveName("sFnUpdateStatus"))
+
+		condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Reason).To(Equal(string(imv1.ConditionReasonOidcError)))
+		Expect(condition.Message).To(ContainSubstring("failed to get config map oidc-ca referenced in OIDC config"))
+
+		Expect(testFsm.Metrics.(*metrics_mocks.Metrics).AssertCalled(t, "IncRuntimeFSMStopCounter")).To(BeTrue())
+
+		var cm core_v1.ConfigMap
+		Expect(testFsm.GardenClient.Get(context.Background(), cmKey, &cm)).ToNot(Succeed())
+	})
+
+	t.Run("Should requeue without stopping when the referenced config map cannot be read", func(t *testing.T) {
+		// given
+		inputRuntime := makeRuntimeWithOIDCConfigReferences()
+		testFsm := setupFakeFSMForTest(testScheme, inputRuntime)
+		testFsm.KcpClient = interceptor.NewClient(testFsm.KcpClient.(client.WithWatch), interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := obj.(*core_v1.ConfigMap); ok {
+					return k8s_errors.NewServiceUnavailable("test unavailable")
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		})
+
+		shoot := fsm_testing.TestShootForPatch()
+		Expect(testFsm.GardenClient.Create(context.Background(), shoot)).To(Succeed())
+		systemState := &systemState{instance: *inputRuntime, shoot: shoot}
+
+		// when
+		stateFn, _, err := sFnPatchExistingShoot(context.Background(), testFsm, systemState)
+
+		// then
+		Expect(err).To(BeNil())
+		Expect(stateFn).To(haveName("sFnUpdateStatus"))
+
+		condition := meta.FindStatusCondition(systemState.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+		Expect(condition).ToNot(BeNil())
+		Expect(condition.Reason).To(Equal(string(imv1.ConditionReasonOidcError)))
+		Expect(condition.Message).To(ContainSubstring("test unavailable"))
+
+		Expect(testFsm.Metrics.(*metrics_mocks.Metrics).AssertNotCalled(t, "IncRuntimeFSMStopCounter")).To(BeTrue())
+	})
+}
+
 func setupFakeFSMForTest(scheme *api.Scheme, objs ...client.Object) *fsm {
 	return must(newFakeFSM,
 		withMockedMetrics(),
@@ -332,9 +537,84 @@ func setupFakeFSMForTestWithAuditLogMandatoryAndConfig(scheme *api.Scheme, runti
 			ServiceURL: "http://test-auditlog-service",
 			SecretName: "test-secret",
 		}),
+		withAuditPolicyConfigMap("test-policy"),
 	)
 }
 
+func TestGetAuditLogData(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(gardener.AddToScheme(testScheme))
+
+	runtime := makeInputRuntimeWithAnnotation(nil)
+	seed := &gardener.Seed{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws-seed"},
+		Spec: gardener.SeedSpec{
+			Provider: gardener.SeedProvider{Type: "aws", Region: "eu-central-1"},
+		},
+	}
+	runtimeProviderData := auditlogs.AuditLogData{TenantID: "runtime-tenant", ServiceURL: "http://runtime-auditlog-service", SecretName: "runtime-secret"}
+	seedProviderData := auditlogs.AuditLogData{TenantID: "seed-tenant", ServiceURL: "http://seed-auditlog-service", SecretName: "seed-secret"}
+	auditLogConfig := auditlogs.Configuration{
+		"gcp": {"region": runtimeProviderData},
+		"aws": {"eu-central-1": seedProviderData},
+	}
+
+	shootOnSeed := func(seedName *string) *gardener.Shoot {
+		shoot := fsm_testing.TestShootForPatch()
+		shoot.Spec.SeedName = seedName
+		return shoot
+	}
+
+	for _, entry := range []struct {
+		description     string
+		useSeedProvider bool
+		shoot           *gardener.Shoot
+		expected        auditlogs.AuditLogData
+		expectedErr     bool
+	}{
+		{
+			description: "should select audit log data by Runtime provider when seed provider is disabled",
+			shoot:       shootOnSeed(ptr.To("aws-seed")),
+			expected:    runtimeProviderData,
+		},
+		{
+			description:     "should select audit log data by seed provider",
+			useSeedProvider: true,
+			shoot:           shootOnSeed(ptr.To("aws-seed")),
+			expected:        seedProviderData,
+		},
+		{
+			description:     "should select audit log data by Runtime provider when seed is not assigned yet",
+			useSeedProvider: true,
+			shoot:           shootOnSeed(nil),
+			expected:        runtimeProviderData,
+		},
+		{
+			description:     "should fail when assigned seed does not exist",
+			useSeedProvider: true,
+			shoot:           shootOnSeed(ptr.To("missing-seed")),
+			expectedErr:     true,
+		},
+	} {
+		t.Run(entry.description, func(t *testing.T) {
+			testFsm := setupFakeFSMForTest(testScheme, seed)
+			testFsm.AuditLogging = auditLogConfig
+			Expect(withAuditLogUseSeedProvider(entry.useSeedProvider)(testFsm)).To(Succeed())
+
+			data, err := getAuditLogData(context.Background(), testFsm, &systemState{instance: *runtime, shoot: entry.shoot})
+
+			if entry.expectedErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(Equal(entry.expected))
+		})
+	}
+}
+
 func TestWorkersAreEqual(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -458,3 +738,78 @@ func TestWorkersAreEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeChangedWorkers(t *testing.T) {
+	defaultedCRI := &gardener.CRI{Name: gardener.CRINameContainerD}
+	defaultedMaxSurge := ptr.To(intstr.FromInt32(1))
+
+	tests := []struct {
+		name    string
+		current []gardener.Worker
+		desired []gardener.Worker
+		want    []gardener.Worker
+	}{
+		{
+			name: "changing one pool's max count does not alter the other pool",
+			current: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3, CRI: defaultedCRI, MaxSurge: defaultedMaxSurge},
+				{Name: "worker2", Minimum: 1, Maximum: 3, CRI: defaultedCRI, MaxSurge: defaultedMaxSurge},
+			},
+			desired: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 5},
+				{Name: "worker2", Minimum: 1, Maximum: 3},
+			},
+			want: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 5},
+				{Name: "worker2", Minimum: 1, Maximum: 3, CRI: defaultedCRI, MaxSurge: defaultedMaxSurge},
+			},
+		},
+		{
+			name: "unchanged pools are kept as they are",
+			current: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3, CRI: defaultedCRI},
+			},
+			desired: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3},
+			},
+			want: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3, CRI: defaultedCRI},
+			},
+		},
+		{
+			name: "removed field in the desired pool is detected as a change",
+			current: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3, Labels: map[string]string{"key": "value"}},
+			},
+			desired: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3},
+			},
+			want: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3},
+			},
+		},
+		{
+			name: "added and removed pools",
+			current: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3, CRI: defaultedCRI},
+				{Name: "worker2", Minimum: 1, Maximum: 3, CRI: defaultedCRI},
+			},
+			desired: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3},
+				{Name: "worker3", Minimum: 1, Maximum: 3},
+			},
+			want: []gardener.Worker{
+				{Name: "worker1", Minimum: 1, Maximum: 3, CRI: defaultedCRI},
+				{Name: "worker3", Minimum: 1, Maximum: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := mergeChangedWorkers(tt.current, tt.desired)
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
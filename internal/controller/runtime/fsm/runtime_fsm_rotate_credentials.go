@@ -0,0 +1,90 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// sFnTriggerCredentialsRotation requests the credentials rotation set with the rotate-credentials annotation by setting the Gardener operation on the shoot.
+// The annotation is removed from the Runtime once the operation is requested, invalid values are reported with an event and removed as well.
+func sFnTriggerCredentialsRotation(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	operation, _, err := reconciler.CredentialsRotationOperation(s.instance.Annotations)
+	if err != nil {
+		m.log.Info("Ignoring credentials rotation request", "RuntimeCR", s.instance.Name, "error", err.Error())
+		m.Event(&s.instance, "Warning", "CredentialsRotation", err.Error())
+		return removeCredentialsRotationAnnotationAndRequeue(ctx, m, s)
+	}
+
+	original := s.shoot.DeepCopy()
+	metav1.SetMetaDataAnnotation(&s.shoot.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+
+	if err := m.GardenClient.Patch(ctx, s.shoot, client.MergeFrom(original)); err != nil {
+		m.log.Error(err, "Failed to request credentials rotation for shoot", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name, "operation", operation)
+		return requeueAfter(m.gardenerRequeueDuration(s.instance))
+	}
+
+	m.log.Info("Credentials rotation requested for shoot", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name, "operation", operation)
+	m.Event(&s.instance, "Normal", "CredentialsRotation", fmt.Sprintf("Gardener operation %s requested for shoot %s", operation, s.shoot.Name))
+
+	return removeCredentialsRotationAnnotationAndRequeue(ctx, m, s)
+}
+
+func removeCredentialsRotationAnnotationAndRequeue(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	delete(s.instance.Annotations, reconciler.RotateCredentialsAnnotation)
+
+	if err := m.KcpClient.Update(ctx, &s.instance); err != nil {
+		return updateStatusAndStopWithError(err)
+	}
+
+	return requeueAfter(m.gardenerRequeueDuration(s.instance))
+}
+
+func credentialsRotationStatus(credentials *gardener.ShootCredentials) *imv1.CredentialsRotationStatus {
+	if credentials == nil || credentials.Rotation == nil {
+		return nil
+	}
+
+	rotation := credentials.Rotation
+	status := imv1.CredentialsRotationStatus{}
+
+	if rotation.CertificateAuthorities != nil {
+		status.CertificateAuthorities = rotation.CertificateAuthorities.Phase
+	}
+
+	if rotation.ServiceAccountKey != nil {
+		status.ServiceAccountKey = rotation.ServiceAccountKey.Phase
+	}
+
+	if rotation.ETCDEncryptionKey != nil {
+		status.ETCDEncryptionKey = rotation.ETCDEncryptionKey.Phase
+	}
+
+	if status == (imv1.CredentialsRotationStatus{}) {
+		return nil
+	}
+
+	return &status
+}
+
+// credentialsRotationInProgress returns true when any of the rotations is in a phase Gardener is going to leave without any user action
+func credentialsRotationInProgress(status *imv1.CredentialsRotationStatus) bool {
+	if status == nil {
+		return false
+	}
+
+	for _, phase := range []gardener.CredentialsRotationPhase{status.CertificateAuthorities, status.ServiceAccountKey, status.ETCDEncryptionKey} {
+		if phase != "" && phase != gardener.RotationPrepared && phase != gardener.RotationCompleted {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,192 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSFnTriggerCredentialsRotation(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+
+	for tname, tc := range map[string]struct {
+		annotationValue   string
+		expectedOperation string
+	}{
+		"Should set the Gardener operation on the shoot and remove the annotation": {
+			annotationValue:   "rotate-credentials-start",
+			expectedOperation: "rotate-credentials-start",
+		},
+		"Should remove the annotation with invalid value without touching the shoot": {
+			annotationValue: "delete",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/rotate-credentials": tc.annotationValue})
+			shoot := fixShootForCredentialsRotation(nil)
+			testFsm := must(newFakeFSM,
+				withFakedK8sClient(testScheme, runtime, shoot),
+				withFakeEventRecorder(1),
+				withDefaultReconcileDuration(),
+			)
+
+			// when
+			nextFn, result, err := sFnTriggerCredentialsRotation(context.Background(), testFsm, &systemState{instance: *runtime, shoot: shoot.DeepCopy()})
+
+			// then
+			require.NoError(t, err)
+			assert.Nil(t, nextFn)
+			require.NotNil(t, result)
+			assert.Equal(t, defaultGardenerRequeueDuration, result.RequeueAfter)
+
+			var actualShoot gardener.Shoot
+			require.NoError(t, testFsm.GardenClient.Get(context.Background(), client.ObjectKeyFromObject(shoot), &actualShoot))
+			assert.Equal(t, tc.expectedOperation, actualShoot.Annotations["gardener.cloud/operation"])
+
+			var actualRuntime imv1.Runtime
+			require.NoError(t, testFsm.KcpClient.Get(context.Background(), client.ObjectKeyFromObject(runtime), &actualRuntime))
+			assert.NotContains(t, actualRuntime.Annotations, "operator.kyma-project.io/rotate-credentials")
+		})
+	}
+}
+
+func TestSFnSelectShootProcessingWithCredentialsRotation(t *testing.T) {
+	RegisterTestingT(t)
+
+	t.Run("Should switch to sFnTriggerCredentialsRotation due to rotate credentials annotation", func(t *testing.T) {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/rotate-credentials": "rotate-ca-start"})
+		testFsm := must(newFakeFSM, withMockedMetrics(), withDefaultReconcileDuration())
+
+		// when
+		nextFn, _, err := sFnSelectShootProcessing(context.Background(), testFsm, &systemState{instance: *runtime, shoot: fixShootForCredentialsRotation(nil)})
+
+		// then
+		require.NoError(t, err)
+		Expect(nextFn).To(haveName("sFnTriggerCredentialsRotation"))
+	})
+
+	t.Run("Should update status and requeue Ready Runtime with credentials rotation in progress", func(t *testing.T) {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Status.State = imv1.RuntimeStateReady
+		shoot := fixShootForCredentialsRotation(&gardener.ShootCredentialsRotation{
+			CertificateAuthorities: &gardener.CARotation{Phase: gardener.RotationPreparing},
+		})
+		testFsm := must(newFakeFSM, withMockedMetrics(), withDefaultReconcileDuration())
+		systemState := &systemState{instance: *runtime, shoot: shoot}
+		exposeShootStatusInfo(systemState)
+
+		// when
+		nextFn, _, err := sFnSelectShootProcessing(context.Background(), testFsm, systemState)
+
+		// then
+		require.NoError(t, err)
+		Expect(nextFn).To(haveName("sFnUpdateStatus"))
+		assert.Equal(t, &imv1.CredentialsRotationStatus{CertificateAuthorities: gardener.RotationPreparing}, systemState.instance.Status.ShootCredentialsRotation)
+	})
+
+	t.Run("Should stop processing Ready Runtime with prepared credentials rotation already on the status", func(t *testing.T) {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Status.State = imv1.RuntimeStateReady
+		shoot := fixShootForCredentialsRotation(&gardener.ShootCredentialsRotation{
+			ServiceAccountKey: &gardener.ServiceAccountKeyRotation{Phase: gardener.RotationPrepared},
+		})
+		testFsm := must(newFakeFSM, withMockedMetrics(), withDefaultReconcileDuration())
+		systemState := &systemState{instance: *runtime, shoot: shoot}
+		exposeShootStatusInfo(systemState)
+		systemState.saveRuntimeStatus()
+
+		// when
+		nextFn, result, err := sFnSelectShootProcessing(context.Background(), testFsm, systemState)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, nextFn)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCredentialsRotationStatus(t *testing.T) {
+	for tname, tc := range map[string]struct {
+		credentials        *gardener.ShootCredentials
+		expectedStatus     *imv1.CredentialsRotationStatus
+		expectedInProgress bool
+	}{
+		"Should return nil without credentials": {},
+		"Should return nil without rotation phases": {
+			credentials: &gardener.ShootCredentials{Rotation: &gardener.ShootCredentialsRotation{
+				SSHKeypair: &gardener.ShootSSHKeypairRotation{},
+			}},
+		},
+		"Should return phases of the rotations in progress": {
+			credentials: &gardener.ShootCredentials{Rotation: &gardener.ShootCredentialsRotation{
+				CertificateAuthorities: &gardener.CARotation{Phase: gardener.RotationPrepared},
+				ServiceAccountKey:      &gardener.ServiceAccountKeyRotation{Phase: gardener.RotationCompleting},
+				ETCDEncryptionKey:      &gardener.ETCDEncryptionKeyRotation{Phase: gardener.RotationCompleted},
+			}},
+			expectedStatus: &imv1.CredentialsRotationStatus{
+				CertificateAuthorities: gardener.RotationPrepared,
+				ServiceAccountKey:      gardener.RotationCompleting,
+				ETCDEncryptionKey:      gardener.RotationCompleted,
+			},
+			expectedInProgress: true,
+		},
+		"Should return phases of the prepared rotations": {
+			credentials: &gardener.ShootCredentials{Rotation: &gardener.ShootCredentialsRotation{
+				CertificateAuthorities: &gardener.CARotation{Phase: gardener.RotationPrepared},
+			}},
+			expectedStatus: &imv1.CredentialsRotationStatus{
+				CertificateAuthorities: gardener.RotationPrepared,
+			},
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// when
+			status := credentialsRotationStatus(tc.credentials)
+
+			// then
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.Equal(t, tc.expectedInProgress, credentialsRotationInProgress(status))
+		})
+	}
+}
+
+func fixShootForCredentialsRotation(rotation *gardener.ShootCredentialsRotation) *gardener.Shoot {
+	return &gardener.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-shoot",
+			Namespace:   "garden-",
+			Annotations: map[string]string{extender.ShootRuntimeGenerationAnnotation: "0"},
+		},
+		Spec: gardener.ShootSpec{
+			DNS: &gardener.DNS{
+				Domain: ptr.To("test-domain"),
+			},
+		},
+		Status: gardener.ShootStatus{
+			LastOperation: &gardener.LastOperation{
+				Type:  gardener.LastOperationTypeReconcile,
+				State: gardener.LastOperationStateSucceeded,
+			},
+			Credentials: &gardener.ShootCredentials{Rotation: rotation},
+		},
+	}
+}
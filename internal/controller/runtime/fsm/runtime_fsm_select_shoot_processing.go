@@ -3,6 +3,7 @@ package fsm
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -18,23 +19,27 @@ func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateF
 	if s.shoot.Spec.DNS == nil || s.shoot.Spec.DNS.Domain == nil {
 		m.log.V(log_level.DEBUG).Info("DNS Domain is not set yet for shoot, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
 		m.Metrics.SetRuntimeStates(s.instance)
-		return requeueAfter(m.GardenerRequeueDuration)
+		return requeueAfter(m.gardenerRequeueDuration(s.instance))
 	}
 
 	lastOperation := s.shoot.Status.LastOperation
 	if lastOperation == nil {
 		m.log.V(log_level.DEBUG).Info("Last operation is nil for shoot, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
 		m.Metrics.SetRuntimeStates(s.instance)
-		return requeueAfter(m.GardenerRequeueDuration)
+		return requeueAfter(m.gardenerRequeueDuration(s.instance))
 	}
 
 	logLastErrors(s, m)
 
+	if _, found := s.instance.Annotations[reconciler.RotateCredentialsAnnotation]; found {
+		return switchState(sFnTriggerCredentialsRotation)
+	}
+
 	patchShoot, err := shouldPatchShoot(&s.instance, s.shoot, &m.log)
 	if err != nil {
 		m.log.Error(err, "Failed to get applied generation for shoot", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
 		m.Metrics.SetRuntimeStates(s.instance)
-		return requeueAfter(m.GardenerRequeueDuration)
+		return requeueAfter(m.gardenerRequeueDuration(s.instance))
 	}
 
 	if patchShoot {
@@ -51,6 +56,15 @@ func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateF
 		}
 	}
 
+	// Runtimes with credentials rotation in progress are requeued to keep the rotation phases on the Runtime status up to date
+	if credentialsRotationInProgress(s.instance.Status.ShootCredentialsRotation) {
+		return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
+	}
+
+	if !reflect.DeepEqual(s.instance.Status.ShootCredentialsRotation, s.snapshot.ShootCredentialsRotation) {
+		return updateStatusAndStop()
+	}
+
 	// All other runtimes in Ready and Failed state will be not processed to mitigate massive reconciliation during restart
 	m.log.Info("Stopping processing reconcile, exiting with no retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name, "function", "sFnSelectShootProcessing")
 	return stop()
@@ -81,7 +95,7 @@ func shouldPatchShoot(runtime *imv1.Runtime, shoot *gardener.Shoot, logger *logr
 		return false, nil
 	}
 
-	if reconciler.ShouldForceReconciliation(runtime.Annotations) {
+	if reconciler.ShouldForceReconciliation(runtime.Annotations) || reconciler.ShouldReconcileNow(runtime.Annotations) {
 		return true, nil
 	}
 
@@ -31,6 +31,7 @@ var _ = Describe("KIM sFnSelectShootProcessing", func() {
 	}
 
 	inputRtWithForceAnnotation := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/force-patch-reconciliation": "true"})
+	inputRtWithReconcileNowAnnotation := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/reconcile-now": "true"})
 	inputRtWithSuspendAnnotation := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/suspend-patch-reconciliation": "true"})
 
 	testShoot := gardener.Shoot{
@@ -65,6 +66,16 @@ var _ = Describe("KIM sFnSelectShootProcessing", func() {
 				MatchNextFnState: haveName("sFnPrepareRegistryCache"),
 			},
 		),
+		Entry(
+			"should switch to sFnPatchExistingShoot due to reconcile now annotation",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withTestSchemeAndObjects()),
+			&systemState{instance: *inputRtWithReconcileNowAnnotation, shoot: &testShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnPrepareRegistryCache"),
+			},
+		),
 		Entry(
 			"should stop due to suspend annotation",
 			testCtx,
@@ -78,6 +89,33 @@ var _ = Describe("KIM sFnSelectShootProcessing", func() {
 	)
 })
 
+var _ = Describe("KIM sFnSelectShootProcessing requeue duration", func() {
+	shootWithoutDNS := &gardener.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-shoot",
+			Namespace: "garden-",
+		},
+	}
+
+	DescribeTable("should requeue with the duration overridden by the requeue-seconds annotation",
+		func(annotations map[string]string, expectedRequeueAfter time.Duration) {
+			testFsm := must(newFakeFSM, withMockedMetrics(), withDefaultReconcileDuration())
+			systemState := &systemState{instance: *makeInputRuntimeWithAnnotation(annotations), shoot: shootWithoutDNS}
+
+			nextFn, result, err := sFnSelectShootProcessing(context.Background(), testFsm, systemState)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nextFn).To(BeNil())
+			Expect(result).ToNot(BeNil())
+			Expect(result.RequeueAfter).To(Equal(expectedRequeueAfter))
+		},
+		Entry("uses the global duration without annotation", nil, defaultGardenerRequeueDuration),
+		Entry("uses the annotation value", map[string]string{"operator.kyma-project.io/requeue-seconds": "2"}, 2*time.Second),
+		Entry("ignores annotation value out of bounds", map[string]string{"operator.kyma-project.io/requeue-seconds": "3600"}, defaultGardenerRequeueDuration),
+		Entry("ignores invalid annotation value", map[string]string{"operator.kyma-project.io/requeue-seconds": "fast"}, defaultGardenerRequeueDuration),
+	)
+})
+
 func makeInputRuntimeWithAnnotation(annotations map[string]string) *imv1.Runtime {
 	return &imv1.Runtime{
 		ObjectMeta: metav1.ObjectMeta{
@@ -101,14 +139,18 @@ func makeInputRuntimeWithAnnotation(annotations map[string]string) *imv1.Runtime
 		},
 		Spec: imv1.RuntimeSpec{
 			Shoot: imv1.RuntimeShoot{
-				Name:   "test-shoot",
-				Region: "region",
+				Name:              "test-shoot",
+				Region:            "region",
+				SecretBindingName: "test-secret-binding",
 				Provider: imv1.Provider{
 					Type:                 "gcp",
 					Workers:              fixWorkers("test-worker", "m5.xlarge", "garden-linux", "1.19.8", 1, 1, []string{"europe-west1-d"}),
 					InfrastructureConfig: fixGCPInfrastructureConfig(),
 					ControlPlaneConfig:   fixGCPControlPlaneConfig(),
 				},
+				Networking: imv1.Networking{
+					Nodes: "10.250.0.0/22",
+				},
 			},
 		},
 	}
@@ -14,14 +14,28 @@ func sFnTakeSnapshot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctr
 	s.saveRuntimeStatus()
 
 	var shoot gardener_api.Shoot
+	shootName := m.ConverterConfig.Gardener.ShootName(s.instance.Spec.Shoot.Name)
 	err := m.GardenClient.Get(ctx, types.NamespacedName{
-		Name:      s.instance.Spec.Shoot.Name,
+		Name:      shootName,
 		Namespace: m.ShootNamesapace,
 	}, &shoot)
 
+	// the shoots created before the shoot naming was configured are named after the Runtime shoot name
+	if apierrors.IsNotFound(err) && shootName != s.instance.Spec.Shoot.Name {
+		err = m.GardenClient.Get(ctx, types.NamespacedName{
+			Name:      s.instance.Spec.Shoot.Name,
+			Namespace: m.ShootNamesapace,
+		}, &shoot)
+	}
+
+	if apierrors.IsTooManyRequests(err) {
+		m.log.Info("Gardener API is rate limiting requests, retrying", "error", err)
+		return updateStatusAndRequeueAfter(m.rateLimitRequeueDuration(s.instance, err))
+	}
+
 	if err != nil && !apierrors.IsNotFound(err) {
 		m.log.Info("Failed to get Gardener shoot", "error", err)
-		return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+		return updateStatusAndRequeueAfter(m.gardenerRequeueDuration(s.instance))
 	}
 
 	if err == nil {
@@ -0,0 +1,84 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestFSMTakeSnapshot(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(gardener.AddToScheme(testScheme))
+
+	for tname, tc := range map[string]struct {
+		shootNamePrefix   string
+		existingShootName string
+		expectedShootName string
+	}{
+		"Should find the shoot named after the Runtime shoot name": {
+			existingShootName: "test-shoot",
+			expectedShootName: "test-shoot",
+		},
+		"Should find the shoot named with the configured prefix": {
+			shootNamePrefix:   "p-",
+			existingShootName: "p-test-shoot",
+			expectedShootName: "p-test-shoot",
+		},
+		"Should find the shoot created before the prefix was configured": {
+			shootNamePrefix:   "p-",
+			existingShootName: "test-shoot",
+			expectedShootName: "test-shoot",
+		},
+		"Should not find the shoot when it does not exist": {
+			shootNamePrefix:   "p-",
+			existingShootName: "other-shoot",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			gardenClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(&gardener.Shoot{ObjectMeta: metav1.ObjectMeta{Name: tc.existingShootName, Namespace: "garden-test"}}).
+				Build()
+
+			testFsm := must(newFakeFSM,
+				withShootNamespace("garden-test"),
+				withDefaultReconcileDuration(),
+				func(fsm *fsm) error {
+					fsm.GardenClient = gardenClient
+					fsm.ConverterConfig.Gardener.ShootNamePrefix = tc.shootNamePrefix
+					return nil
+				},
+			)
+			systemState := &systemState{instance: imv1.Runtime{
+				Spec: imv1.RuntimeSpec{Shoot: imv1.RuntimeShoot{Name: "test-shoot"}},
+			}}
+
+			// when
+			nextFn, _, err := sFnTakeSnapshot(context.Background(), testFsm, systemState)
+
+			// then
+			require.NoError(t, err)
+			Expect(nextFn).To(haveName("sFnInitialize"))
+
+			if tc.expectedShootName == "" {
+				assert.Nil(t, systemState.shoot)
+				return
+			}
+
+			require.NotNil(t, systemState.shoot)
+			assert.Equal(t, tc.expectedShootName, systemState.shoot.Name)
+		})
+	}
+}
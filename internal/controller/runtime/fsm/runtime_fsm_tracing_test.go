@@ -0,0 +1,55 @@
+package fsm
+
+import (
+	"context"
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/tracing"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestFSMTracingSpans(t *testing.T) {
+	RegisterTestingT(t)
+
+	// given
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previousTracerProvider := otel.GetTracerProvider()
+	otel.SetTracerProvider(tracerProvider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousTracerProvider)
+	})
+
+	var sFnTracingDone stateFn = func(_ context.Context, _ *fsm, _ *systemState) (stateFn, *ctrl.Result, error) {
+		return nil, nil, nil
+	}
+	var sFnTracingStart stateFn = func(_ context.Context, _ *fsm, _ *systemState) (stateFn, *ctrl.Result, error) {
+		return sFnTracingDone, nil, nil
+	}
+
+	testFsm := must(newFakeFSM, withDefaultReconcileDuration())
+	testFsm.fn = sFnTracingStart
+
+	// when
+	_, err := testFsm.Run(context.Background(), imv1.Runtime{ObjectMeta: metav1.ObjectMeta{Name: "test-runtime"}})
+
+	// then
+	require.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	for i, expectedStateName := range []string{sFnTracingStart.name(), sFnTracingDone.name()} {
+		assert.Equal(t, expectedStateName, spans[i].Name)
+		assert.Contains(t, spans[i].Attributes, tracing.AttributeRuntimeName.String("test-runtime"))
+		assert.Contains(t, spans[i].Attributes, tracing.AttributeStateName.String(expectedStateName))
+	}
+}
@@ -2,8 +2,8 @@ package fsm
 
 import (
 	"context"
-	"reflect"
 
+	"github.com/kyma-project/infrastructure-manager/internal/controller/status"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -13,22 +13,29 @@ func sFnUpdateStatus(result *ctrl.Result, err error) stateFn {
 			m.Metrics.IncRuntimeFSMStopCounter()
 		}
 
-		// make sure there is a change in status
-		if reflect.DeepEqual(s.instance.Status, s.snapshot) {
-			return nil, result, err
-		}
-
-		updateErr := m.KcpClient.Status().Update(ctx, &s.instance)
+		// make sure there is a change in status, conditions set again with the same content are not a change
+		status.KeepTransitionTimes(s.snapshot.Conditions, s.instance.Status.Conditions)
+		updated, updateErr := status.PatchIfChanged(ctx, m.KcpClient, &s.instance, s.snapshot, s.instance.Status, s.snapshot.Conditions)
 
 		if updateErr != nil {
-			m.log.Error(updateErr, "unable to update instance status!")
+			m.log.Error(updateErr, "unable to patch instance status!")
 			if err == nil {
 				err = updateErr
 			}
 			return nil, nil, err
 		}
 
+		if !updated {
+			return nil, result, err
+		}
+
 		m.Metrics.SetRuntimeStates(s.instance)
+
+		// the provisioning duration is recorded once the completed provisioning is saved, so a failed status patch does not record it twice
+		if s.instance.IsProvisioningCompletedStatusSet() && !s.snapshot.ProvisioningCompleted {
+			m.Metrics.ObserveRuntimeProvisioningDuration(s.instance, m.Clock.Since(s.instance.CreationTimestamp.Time))
+		}
+
 		next := sFnEmmitEventfunc(nil, result, err)
 		return next, nil, nil
 	}
@@ -0,0 +1,237 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	metrics_mocks "github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	. "github.com/onsi/gomega" //nolint:revive
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	api "k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestFSMUpdateStatus(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+
+	for tname, tc := range map[string]struct {
+		updateStatus          func(runtime *imv1.Runtime)
+		expectedStatusPatches int
+	}{
+		"Should not patch the status when the condition is set again with the same content": {
+			updateStatus: func(runtime *imv1.Runtime) {
+				runtime.UpdateStatePending(imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonShootCreationPending, "Unknown", "Shoot is pending")
+			},
+			expectedStatusPatches: 0,
+		},
+		"Should patch the status when the condition changed": {
+			updateStatus: func(runtime *imv1.Runtime) {
+				runtime.UpdateStatePending(imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonGardenerError, "False", "Gardener API create error")
+			},
+			expectedStatusPatches: 1,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := makeInputRuntimeWithAnnotation(nil)
+			runtime.Status.State = imv1.RuntimeStatePending
+			runtime.Status.Conditions = []metav1.Condition{{
+				Type:               string(imv1.ConditionTypeRuntimeProvisioned),
+				Status:             "Unknown",
+				Reason:             string(imv1.ConditionReasonShootCreationPending),
+				Message:            "Shoot is pending",
+				LastTransitionTime: metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+			}}
+
+			statusPatches := 0
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(runtime).
+				WithStatusSubresource(runtime).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						statusPatches++
+						return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+					},
+				}).
+				Build()
+
+			testFsm := must(newFakeFSM,
+				withMockedMetrics(),
+				withFakeEventRecorder(1),
+				func(fsm *fsm) error {
+					fsm.KcpClient = k8sClient
+					return nil
+				},
+			)
+
+			systemState := &systemState{instance: *runtime}
+			systemState.saveRuntimeStatus()
+			tc.updateStatus(&systemState.instance)
+
+			// when
+			nextFn, result, err := sFnUpdateStatus(&ctrl.Result{RequeueAfter: time.Minute}, nil)(context.Background(), testFsm, systemState)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatusPatches, statusPatches)
+
+			if tc.expectedStatusPatches == 0 {
+				assert.Nil(t, nextFn)
+				require.NotNil(t, result)
+				assert.Equal(t, time.Minute, result.RequeueAfter)
+				return
+			}
+
+			assert.NotNil(t, nextFn)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestFSMUpdateStatusPreservesForeignConditions(t *testing.T) {
+	RegisterTestingT(t)
+
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+
+	// given
+	runtime := makeInputRuntimeWithAnnotation(nil)
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(runtime).
+		WithStatusSubresource(runtime).
+		Build()
+
+	testFsm := must(newFakeFSM,
+		withMockedMetrics(),
+		withFakeEventRecorder(1),
+		func(fsm *fsm) error {
+			fsm.KcpClient = k8sClient
+			return nil
+		},
+	)
+
+	var instance imv1.Runtime
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(runtime), &instance))
+	systemState := &systemState{instance: instance}
+	systemState.saveRuntimeStatus()
+
+	// another controller sets its condition after the status was observed
+	foreignCondition := metav1.Condition{
+		Type:               "ForeignCondition",
+		Status:             metav1.ConditionTrue,
+		Reason:             "SetByAnotherController",
+		LastTransitionTime: metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	concurrent := instance.DeepCopy()
+	concurrent.Status.Conditions = append(concurrent.Status.Conditions, foreignCondition)
+	require.NoError(t, k8sClient.Status().Update(context.Background(), concurrent))
+
+	systemState.instance.UpdateStatePending(imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonShootCreationPending, "Unknown", "Shoot is pending")
+
+	// when
+	_, _, err := sFnUpdateStatus(nil, nil)(context.Background(), testFsm, systemState)
+
+	// then
+	require.NoError(t, err)
+
+	var actual imv1.Runtime
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(runtime), &actual))
+	assert.Equal(t, imv1.State(imv1.RuntimeStatePending), actual.Status.State)
+	assert.True(t, actual.IsConditionSet(imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonShootCreationPending))
+	assert.True(t, actual.IsConditionSetWithStatus("ForeignCondition", "SetByAnotherController", metav1.ConditionTrue))
+}
+
+func TestFSMUpdateStatusProvisioningDuration(t *testing.T) {
+	testScheme := api.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+
+	creationTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for tname, tc := range map[string]struct {
+		provisioningCompletedBefore bool
+		patchErr                    error
+		expectedObservations        int
+	}{
+		"Should observe the provisioning duration once the completed provisioning is saved": {
+			expectedObservations: 1,
+		},
+		"Should not observe the provisioning duration when the status patch fails": {
+			patchErr:             errors.New("test patch error"),
+			expectedObservations: 0,
+		},
+		"Should not observe the provisioning duration when the provisioning was completed before": {
+			provisioningCompletedBefore: true,
+			expectedObservations:        0,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := makeInputRuntimeWithAnnotation(nil)
+			runtime.CreationTimestamp = metav1.NewTime(creationTime)
+			runtime.Status.ProvisioningCompleted = tc.provisioningCompletedBefore
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(testScheme).
+				WithObjects(runtime).
+				WithStatusSubresource(runtime).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						if tc.patchErr != nil {
+							return tc.patchErr
+						}
+						return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+					},
+				}).
+				Build()
+
+			metricsMock := &metrics_mocks.Metrics{}
+			metricsMock.On("SetRuntimeStates", mock.Anything).Return()
+			metricsMock.On("ObserveRuntimeProvisioningDuration", mock.Anything, 25*time.Minute).Return()
+
+			fakeClock := clocktesting.NewFakeClock(creationTime)
+			fakeClock.Step(25 * time.Minute)
+
+			testFsm := must(newFakeFSM,
+				withMetrics(metricsMock),
+				withFakeEventRecorder(1),
+				withClock(fakeClock),
+				func(fsm *fsm) error {
+					fsm.KcpClient = k8sClient
+					return nil
+				},
+			)
+
+			systemState := &systemState{instance: *runtime}
+			systemState.saveRuntimeStatus()
+			systemState.instance.UpdateStateReady(imv1.ConditionTypeRuntimeConfigured, imv1.ConditionReasonAdministratorsConfigured, "Cluster admin configuration complete")
+			systemState.instance.UpdateStateProvisioningCompleted()
+
+			// when
+			_, _, err := sFnUpdateStatus(nil, nil)(context.Background(), testFsm, systemState)
+
+			// then
+			if tc.patchErr != nil {
+				require.ErrorIs(t, err, tc.patchErr)
+			} else {
+				require.NoError(t, err)
+			}
+			metricsMock.AssertNumberOfCalls(t, "ObserveRuntimeProvisioningDuration", tc.expectedObservations)
+		})
+	}
+}
@@ -11,9 +11,15 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-func sFnWaitForShootReconcile(_ context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+func sFnWaitForShootReconcile(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	updatePlacementCondition(ctx, m, s)
+
 	switch s.shoot.Status.LastOperation.State {
 	case gardener.LastOperationStateProcessing, gardener.LastOperationStatePending, gardener.LastOperationStateAborted, gardener.LastOperationStateError:
+		if shootOperationTimedOut(m, s.shoot) {
+			return updateStatusAndStopOnOperationTimeout(m, s)
+		}
+
 		m.log.V(log_level.DEBUG).Info(fmt.Sprintf("Shoot %s is in %s state, scheduling for retry", s.shoot.Name, s.shoot.Status.LastOperation.State))
 
 		s.instance.UpdateStatePending(
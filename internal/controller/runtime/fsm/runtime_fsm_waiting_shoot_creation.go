@@ -27,8 +27,9 @@ func ensureTerminatingStatusConditionAndContinue(instance *imv1.Runtime, condTyp
 	return switchState(next)
 }
 
-func sFnWaitForShootCreation(_ context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+func sFnWaitForShootCreation(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
 	m.log.V(log_level.DEBUG).Info("Waiting for shoot creation state")
+	updatePlacementCondition(ctx, m, s)
 
 	switch s.shoot.Status.LastOperation.State {
 	case gardener.LastOperationStateProcessing, gardener.LastOperationStatePending, gardener.LastOperationStateAborted, gardener.LastOperationStateError:
@@ -42,6 +43,10 @@ func sFnWaitForShootCreation(_ context.Context, m *fsm, s *systemState) (stateFn
 			return updateStatusAndStop()
 		}
 
+		if shootOperationTimedOut(m, s.shoot) {
+			return updateStatusAndStopOnOperationTimeout(m, s)
+		}
+
 		m.log.V(log_level.DEBUG).Info(fmt.Sprintf("Shoot %s is in %s state, scheduling for retry", s.shoot.Name, s.shoot.Status.LastOperation.State))
 
 		s.instance.UpdateStatePending(
@@ -24,5 +24,6 @@ func exposeShootStatusInfo(s *systemState) {
 	if s.shoot != nil {
 		s.instance.Status.ShootLastOperation = s.shoot.Status.LastOperation
 		s.instance.Status.ShootLastErrors = s.shoot.Status.LastErrors
+		s.instance.Status.ShootCredentialsRotation = credentialsRotationStatus(s.shoot.Status.Credentials)
 	}
 }
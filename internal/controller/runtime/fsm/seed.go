@@ -29,6 +29,17 @@ func seedForRegionAvailable(context context.Context, gardenClient client.Client,
 	return slices.Contains(regionsWithSeeds, region), regionsWithSeeds, nil
 }
 
+func getSeedProvider(ctx context.Context, gardenClient client.Client, seedName string) (gardener_types.SeedProvider, error) {
+	var seed gardener_types.Seed
+
+	err := gardenClient.Get(ctx, client.ObjectKey{Name: seedName}, &seed)
+	if err != nil {
+		return gardener_types.SeedProvider{}, err
+	}
+
+	return seed.Spec.Provider, nil
+}
+
 func seedCanBeUsed(seed *gardener_types.Seed) bool {
 	return seed.DeletionTimestamp == nil && seed.Spec.Settings.Scheduling.Visible && verifySeedReadiness(seed)
 }
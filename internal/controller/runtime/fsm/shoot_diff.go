@@ -0,0 +1,115 @@
+package fsm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+)
+
+const redactedValue = "<redacted>"
+
+//nolint:gochecknoglobals
+var sensitiveFieldNames = []string{"secret", "password", "token", "credentials"}
+
+// logShootDiff logs at debug level the fields of the shoot spec which are going to be changed by the patch
+func logShootDiff(m *fsm, current, desired *gardener.Shoot) {
+	changes, err := shootSpecDiff(current, desired)
+	if err != nil {
+		m.log.Error(err, "Failed to compute shoot diff", "Name", desired.Name, "Namespace", desired.Namespace)
+		return
+	}
+
+	m.log.V(log_level.DEBUG).Info("Shoot changes to be applied", "Name", desired.Name, "Namespace", desired.Namespace, "changes", changes)
+}
+
+// shootSpecDiff returns the fields set in the desired shoot spec which differ from the current one.
+// Fields not set in the desired spec are skipped as they are not affected by the server-side apply patch.
+// Values of fields which may contain sensitive data are redacted.
+func shootSpecDiff(current, desired *gardener.Shoot) ([]string, error) {
+	currentSpec, err := toUnstructuredValue(current.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredSpec, err := toUnstructuredValue(desired.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []string{}
+	diffValues("spec", currentSpec, desiredSpec, &changes)
+
+	return changes, nil
+}
+
+func toUnstructuredValue(spec gardener.ShootSpec) (any, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal shoot spec: %w", err)
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal shoot spec: %w", err)
+	}
+
+	return value, nil
+}
+
+func diffValues(path string, current, desired any, changes *[]string) {
+	if reflect.DeepEqual(current, desired) {
+		return
+	}
+
+	if isSensitiveField(path) {
+		*changes = append(*changes, fmt.Sprintf("%s: %s", path, redactedValue))
+		return
+	}
+
+	switch desiredValue := desired.(type) {
+	case map[string]any:
+		currentValue, _ := current.(map[string]any)
+
+		keys := make([]string, 0, len(desiredValue))
+		for key := range desiredValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			diffValues(path+"."+key, currentValue[key], desiredValue[key], changes)
+		}
+	case []any:
+		currentValue, _ := current.([]any)
+
+		for i := range desiredValue {
+			var currentItem any
+			if i < len(currentValue) {
+				currentItem = currentValue[i]
+			}
+			diffValues(fmt.Sprintf("%s[%d]", path, i), currentItem, desiredValue[i], changes)
+		}
+
+		if len(currentValue) > len(desiredValue) {
+			*changes = append(*changes, fmt.Sprintf("%s: %d items -> %d items", path, len(currentValue), len(desiredValue)))
+		}
+	default:
+		*changes = append(*changes, fmt.Sprintf("%s: %v -> %v", path, current, desired))
+	}
+}
+
+func isSensitiveField(path string) bool {
+	name := strings.ToLower(path[strings.LastIndex(path, ".")+1:])
+	for _, sensitive := range sensitiveFieldNames {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
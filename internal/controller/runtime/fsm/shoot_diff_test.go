@@ -0,0 +1,101 @@
+package fsm
+
+import (
+	"strings"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/go-logr/logr/funcr"
+	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestShootSpecDiff(t *testing.T) {
+	t.Run("Should report changed worker maximum", func(t *testing.T) {
+		// given
+		current := fixShootForDiff(3)
+		desired := fixShootForDiff(5)
+
+		// when
+		changes, err := shootSpecDiff(current, desired)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"spec.provider.workers[0].maximum: 3 -> 5"}, changes)
+	})
+
+	t.Run("Should skip fields not set in the desired shoot", func(t *testing.T) {
+		// given
+		current := fixShootForDiff(3)
+		current.Spec.Purpose = ptr.To(gardener.ShootPurposeProduction)
+		desired := fixShootForDiff(3)
+
+		// when
+		changes, err := shootSpecDiff(current, desired)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("Should redact sensitive fields", func(t *testing.T) {
+		// given
+		current := fixShootForDiff(3)
+		current.Spec.SecretBindingName = ptr.To("old-secret")
+		desired := fixShootForDiff(3)
+		desired.Spec.SecretBindingName = ptr.To("new-secret")
+
+		// when
+		changes, err := shootSpecDiff(current, desired)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"spec.secretBindingName: <redacted>"}, changes)
+	})
+
+	t.Run("Should report removed list items", func(t *testing.T) {
+		// given
+		current := fixShootForDiff(3)
+		current.Spec.Provider.Workers = append(current.Spec.Provider.Workers, gardener.Worker{Name: "additional", Maximum: 1})
+		desired := fixShootForDiff(3)
+
+		// when
+		changes, err := shootSpecDiff(current, desired)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"spec.provider.workers: 2 items -> 1 items"}, changes)
+	})
+}
+
+func TestLogShootDiff(t *testing.T) {
+	// given
+	var logs strings.Builder
+	logger := funcr.New(func(prefix, args string) {
+		logs.WriteString(args)
+	}, funcr.Options{Verbosity: log_level.DEBUG})
+
+	testFsm := &fsm{log: logger}
+
+	// when
+	logShootDiff(testFsm, fixShootForDiff(3), fixShootForDiff(5))
+
+	// then
+	assert.Contains(t, logs.String(), "Shoot changes to be applied")
+	assert.Contains(t, logs.String(), "spec.provider.workers[0].maximum: 3 -> 5")
+}
+
+func fixShootForDiff(maximum int32) *gardener.Shoot {
+	return &gardener.Shoot{
+		Spec: gardener.ShootSpec{
+			Provider: gardener.Provider{
+				Type: "aws",
+				Workers: []gardener.Worker{
+					{Name: "worker", Minimum: 1, Maximum: maximum},
+				},
+			},
+		},
+	}
+}
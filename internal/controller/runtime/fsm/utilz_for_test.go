@@ -14,10 +14,12 @@ import (
 	. "github.com/onsi/gomega"    //nolint:revive
 	"github.com/onsi/gomega/types"
 	"github.com/stretchr/testify/mock"
+	core_v1 "k8s.io/api/core/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -69,6 +71,7 @@ var (
 		m.On("SetRuntimeStates", mock.Anything).Return()
 		m.On("CleanUpRuntimeGauge", mock.Anything, mock.Anything).Return()
 		m.On("IncRuntimeFSMStopCounter").Return()
+		m.On("ObserveRuntimeProvisioningDuration", mock.Anything, mock.Anything).Return()
 		return withMetrics(m)
 	}
 
@@ -90,6 +93,23 @@ var (
 		}
 	}
 
+	// withAuditPolicyConfigMap must follow the option setting the Gardener client
+	withAuditPolicyConfigMap = func(name string) fakeFSMOpt {
+		return func(fsm *fsm) error {
+			fsm.ConverterConfig.AuditLog.PolicyConfigMapName = name
+			return fsm.GardenClient.Create(context.Background(), &core_v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: fsm.ShootNamesapace},
+			})
+		}
+	}
+
+	withAuditLogUseSeedProvider = func(useSeedProvider bool) fakeFSMOpt {
+		return func(fsm *fsm) error {
+			fsm.AuditLogUseSeedProvider = useSeedProvider
+			return nil
+		}
+	}
+
 	withMetrics = func(m metrics.Metrics) fakeFSMOpt {
 		return func(fsm *fsm) error {
 			fsm.Metrics = m
@@ -221,6 +241,27 @@ var (
 		}
 	}
 
+	withClock = func(c clock.PassiveClock) fakeFSMOpt {
+		return func(fsm *fsm) error {
+			fsm.Clock = c
+			return nil
+		}
+	}
+
+	withShootOperationTimeout = func(timeout time.Duration) fakeFSMOpt {
+		return func(fsm *fsm) error {
+			fsm.ShootOperationTimeout = timeout
+			return nil
+		}
+	}
+
+	withKubernetesVersionExpiryWarningPeriod = func(period time.Duration) fakeFSMOpt {
+		return func(fsm *fsm) error {
+			fsm.KubernetesVersionExpiryWarningPeriod = period
+			return nil
+		}
+	}
+
 	withFakeEventRecorder = func(buffer int) fakeFSMOpt {
 		return func(fsm *fsm) error {
 			fsm.EventRecorder = record.NewFakeRecorder(buffer)
@@ -233,6 +274,7 @@ func newFakeFSM(opts ...fakeFSMOpt) (*fsm, error) {
 	fsm := fsm{
 		log: zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)),
 	}
+	fsm.Clock = clock.RealClock{}
 	// apply opts
 	for _, opt := range opts {
 		if err := opt(&fsm); err != nil {
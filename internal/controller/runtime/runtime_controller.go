@@ -24,6 +24,8 @@ import (
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm"
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/kyma-project/infrastructure-manager/internal/tracing"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -49,10 +51,14 @@ type RuntimeReconciler struct {
 //+kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimes,verbs=get;list;watch;create;update;patch,namespace=kcp-system
 //+kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimes/status,verbs=get;list;delete;create;update;patch,namespace=kcp-system
 //+kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimes/finalizers,verbs=get;list;delete;create;update;patch,namespace=kcp-system
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch,namespace=kcp-system
 
 func (r *RuntimeReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
 	r.Log.V(log_level.TRACE).Info(request.String())
 
+	ctx, span := tracing.Tracer().Start(ctx, "Reconcile", trace.WithAttributes(tracing.AttributeRuntimeName.String(request.Name)))
+	defer span.End()
+
 	var runtime imv1.Runtime
 	if err := r.KcpClient.Get(ctx, request.NamespacedName, &runtime); err != nil {
 		return ctrl.Result{
@@ -97,7 +103,7 @@ func NewRuntimeReconciler(mgr ctrl.Manager, gardenClient client.Client, runtimeC
 func (r *RuntimeReconciler) SetupWithManager(mgr ctrl.Manager, numberOfWorkers int) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&imv1.Runtime{}).
-		WithOptions(controller.Options{MaxConcurrentReconciles: numberOfWorkers}).
+		WithOptions(controllerOptions(numberOfWorkers)).
 		WithEventFilter(predicate.Or(
 			predicate.GenerationChangedPredicate{},
 			predicate.LabelChangedPredicate{},
@@ -105,3 +111,10 @@ func (r *RuntimeReconciler) SetupWithManager(mgr ctrl.Manager, numberOfWorkers i
 		)).
 		Complete(r)
 }
+
+// controllerOptions returns the options of the Runtime controller.
+// Distinct Runtimes are reconciled in parallel by up to numberOfWorkers workers, each reconcile builds its own state machine
+// and the configuration shared between them is read-only.
+func controllerOptions(numberOfWorkers int) controller.Options {
+	return controller.Options{MaxConcurrentReconciles: numberOfWorkers}
+}
@@ -0,0 +1,17 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControllerOptions(t *testing.T) {
+	t.Run("should apply configured number of workers as max concurrent reconciles", func(t *testing.T) {
+		// when
+		options := controllerOptions(42)
+
+		// then
+		assert.Equal(t, 42, options.MaxConcurrentReconciles)
+	})
+}
@@ -289,8 +289,9 @@ func CreateRuntimeStub(resourceName string) *imv1.Runtime {
 						},
 					},
 				},
-				Region:  "eu-central-1",
-				Purpose: "production",
+				Region:            "eu-central-1",
+				Purpose:           "production",
+				SecretBindingName: "test-secret-binding",
 				Kubernetes: imv1.Kubernetes{
 					KubeAPIServer: imv1.APIServer{
 						OidcConfig: gardener.OIDCConfig{
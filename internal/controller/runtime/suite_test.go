@@ -46,6 +46,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	clienttesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -60,6 +61,9 @@ import (
 // These tests use Ginkgo (BDD-style Go testing framework). Refer to
 // http://onsi.github.io/ginkgo/ to learn more about Ginkgo.
 
+// testGardenerNamespace is the namespace of the Gardener project configured in fixConverterConfigForTests
+const testGardenerNamespace = "garden-kyma-dev"
+
 var (
 	cfg                *rest.Config         //nolint:gochecknoglobals
 	k8sClient          client.Client        //nolint:gochecknoglobals
@@ -69,6 +73,8 @@ var (
 	cancelSuiteCtx     context.CancelFunc   //nolint:gochecknoglobals
 	runtimeReconciler  *RuntimeReconciler   //nolint:gochecknoglobals
 	customTracker      *CustomTracker       //nolint:gochecknoglobals
+	// suiteClock can be stepped by tests verifying time dependent behaviour of the state machine
+	suiteClock *clocktesting.FakeClock //nolint:gochecknoglobals
 )
 
 func TestControllers(t *testing.T) {
@@ -110,6 +116,7 @@ var _ = BeforeSuite(func() {
 
 	// tracker will be updated with different shoot sequence for each test case
 	tracker := clienttesting.NewObjectTracker(clientScheme, serializer.NewCodecFactory(clientScheme).UniversalDecoder())
+	Expect(tracker.Add(fixGardenerNamespace())).To(Succeed())
 	customTracker = NewCustomTracker(tracker, []*gardener_api.Shoot{}, []*gardener_api.SeedList{})
 	gardenerTestClient = fake.NewClientBuilder().WithScheme(clientScheme).WithObjectTracker(customTracker).Build()
 
@@ -119,6 +126,7 @@ var _ = BeforeSuite(func() {
 	mm.On("SetRuntimeStates", mock.Anything).Return()
 	mm.On("IncRuntimeFSMStopCounter").Return()
 	mm.On("CleanUpRuntimeGauge", mock.Anything, mock.Anything).Return()
+	mm.On("ObserveRuntimeProvisioningDuration", mock.Anything, mock.Anything).Return()
 
 	runtimeClientScheme := runtime.NewScheme()
 	_ = rbacv1.AddToScheme(runtimeClientScheme)
@@ -137,6 +145,8 @@ var _ = BeforeSuite(func() {
 
 	runtimeClientGetterMock.On("Get", mock.Anything, mock.Anything).Return(fakeClient, nil)
 
+	suiteClock = clocktesting.NewFakeClock(time.Now())
+
 	fsmCfg := fsm.RCCfg{
 		Finalizer:                     imv1.Finalizer,
 		Config:                        convConfig,
@@ -147,6 +157,8 @@ var _ = BeforeSuite(func() {
 		RequeueDurationShootReconcile: 3 * time.Second,
 		RequeueDurationShootCreate:    3 * time.Second,
 		RequeueDurationShootDelete:    3 * time.Second,
+		Clock:                         suiteClock,
+		ShootNamesapace:               testGardenerNamespace,
 	}
 
 	runtimeReconciler = NewRuntimeReconciler(mgr, gardenerTestClient, runtimeClientGetterMock, logger, fsmCfg)
@@ -216,6 +228,7 @@ func setupGardenerClientWithSequence(shoots []*gardener_api.Shoot, seeds []*gard
 	_ = v12.AddToScheme(clientScheme)
 
 	tracker := clienttesting.NewObjectTracker(clientScheme, serializer.NewCodecFactory(clientScheme).UniversalDecoder())
+	Expect(tracker.Add(fixGardenerNamespace())).To(Succeed())
 	customTracker = NewCustomTracker(tracker, shoots, seeds)
 	gardenerTestClient = fake.NewClientBuilder().WithScheme(clientScheme).WithObjectTracker(customTracker).
 		WithInterceptorFuncs(interceptor.Funcs{
@@ -224,6 +237,10 @@ func setupGardenerClientWithSequence(shoots []*gardener_api.Shoot, seeds []*gard
 	runtimeReconciler.GardenClient = gardenerTestClient
 }
 
+func fixGardenerNamespace() *v12.Namespace {
+	return &v12.Namespace{ObjectMeta: metav1.ObjectMeta{Name: testGardenerNamespace}}
+}
+
 func getBaseShootForTestingSequence() gardener_api.Shoot {
 	runtimeStub := CreateRuntimeStub("test-resource")
 	infrastructureManagerConfig := fixConverterConfigForTests()
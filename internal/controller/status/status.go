@@ -0,0 +1,91 @@
+package status
+
+import (
+	"context"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ObjectWithConditions is an object with conditions in its status
+type ObjectWithConditions interface {
+	client.Object
+	GetConditions() []metav1.Condition
+	SetConditions(conditions []metav1.Condition)
+}
+
+// Changed reports whether the status differs from the observed one, times are compared semantically
+func Changed(observedStatus, status any) bool {
+	return !equality.Semantic.DeepEqual(observedStatus, status)
+}
+
+// PatchIfChanged issues the status patch of the object only when its status differs from the status observed before the reconciliation.
+// Only the conditions changed during the reconciliation are applied to the latest conditions of the object, so conditions set by other
+// actors in the meantime are preserved. The patch fails with a conflict when the object changes again before it is applied.
+// It returns whether the status patch was issued.
+func PatchIfChanged(ctx context.Context, c client.Client, obj ObjectWithConditions, observedStatus, status any, observedConditions []metav1.Condition) (bool, error) {
+	if !Changed(observedStatus, status) {
+		return false, nil
+	}
+
+	latest := obj.DeepCopyObject().(ObjectWithConditions)
+	if err := c.Get(ctx, client.ObjectKeyFromObject(obj), latest); err != nil {
+		return false, err
+	}
+
+	obj.SetConditions(MergeConditions(observedConditions, obj.GetConditions(), latest.GetConditions()))
+	obj.SetResourceVersion(latest.GetResourceVersion())
+
+	return true, c.Status().Patch(ctx, obj, client.MergeFromWithOptions(latest, client.MergeFromWithOptimisticLock{}))
+}
+
+// MergeConditions applies the conditions which changed from observedConditions to conditions onto latestConditions.
+// Changed and added conditions replace the latest condition of their type, removed conditions are removed,
+// all other latest conditions are kept.
+func MergeConditions(observedConditions, conditions, latestConditions []metav1.Condition) []metav1.Condition {
+	merged := slices.Clone(latestConditions)
+
+	for _, condition := range conditions {
+		observed := meta.FindStatusCondition(observedConditions, condition.Type)
+		if observed != nil && equality.Semantic.DeepEqual(*observed, condition) {
+			continue
+		}
+
+		index := slices.IndexFunc(merged, func(latest metav1.Condition) bool {
+			return latest.Type == condition.Type
+		})
+		if index == -1 {
+			merged = append(merged, condition)
+		} else {
+			merged[index] = condition
+		}
+	}
+
+	for _, observed := range observedConditions {
+		if meta.FindStatusCondition(conditions, observed.Type) == nil {
+			meta.RemoveStatusCondition(&merged, observed.Type)
+		}
+	}
+
+	return merged
+}
+
+// KeepTransitionTimes restores the observed transition time of the conditions whose status, reason and message did not change,
+// so conditions which are set again with the current time are not reported as a status change
+func KeepTransitionTimes(observedConditions []metav1.Condition, conditions []metav1.Condition) {
+	for i := range conditions {
+		condition := &conditions[i]
+		for _, observed := range observedConditions {
+			if observed.Type == condition.Type &&
+				observed.Status == condition.Status &&
+				observed.Reason == condition.Reason &&
+				observed.Message == condition.Message &&
+				observed.ObservedGeneration == condition.ObservedGeneration {
+				condition.LastTransitionTime = observed.LastTransitionTime
+			}
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestPatchIfChanged(t *testing.T) {
+	observedTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	observedStatus := imv1.GardenerClusterStatus{
+		State:      imv1.ReadyState,
+		Conditions: []metav1.Condition{fixCondition("KubeconfigSecretCreated", observedTime)},
+	}
+
+	for tname, tc := range map[string]struct {
+		status          imv1.GardenerClusterStatus
+		expectedPatched bool
+	}{
+		"Should not patch the status when nothing changed": {
+			status:          *observedStatus.DeepCopy(),
+			expectedPatched: false,
+		},
+		"Should not patch the status when a condition is set again with the same content": {
+			status: imv1.GardenerClusterStatus{
+				State:      imv1.ReadyState,
+				Conditions: []metav1.Condition{fixCondition("KubeconfigSecretCreated", metav1.Now())},
+			},
+			expectedPatched: false,
+		},
+		"Should patch the status when a condition changed": {
+			status: imv1.GardenerClusterStatus{
+				State:      imv1.ReadyState,
+				Conditions: []metav1.Condition{fixCondition("KubeconfigSecretRotated", metav1.Now())},
+			},
+			expectedPatched: true,
+		},
+		"Should patch the status when a field changed": {
+			status: imv1.GardenerClusterStatus{
+				State:                   imv1.ErrorState,
+				Conditions:              []metav1.Condition{fixCondition("KubeconfigSecretCreated", observedTime)},
+				ConsecutiveFailureCount: 1,
+			},
+			expectedPatched: true,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			cluster := &imv1.GardenerCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "kcp-system"}}
+			k8sClient, statusPatches := fixClientCountingStatusPatches(t, cluster)
+			cluster.Status = tc.status
+
+			// when
+			KeepTransitionTimes(observedStatus.Conditions, cluster.Status.Conditions)
+			patched, err := PatchIfChanged(context.Background(), k8sClient, cluster, observedStatus, cluster.Status, observedStatus.Conditions)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPatched, patched)
+			if tc.expectedPatched {
+				assert.Equal(t, 1, *statusPatches)
+			} else {
+				assert.Zero(t, *statusPatches)
+			}
+		})
+	}
+}
+
+func TestPatchIfChangedPreservesForeignConditions(t *testing.T) {
+	// given
+	observedTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	cluster := &imv1.GardenerCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "kcp-system"}}
+	cluster.Status.Conditions = []metav1.Condition{fixCondition("KubeconfigSecretCreated", observedTime)}
+	k8sClient, _ := fixClientCountingStatusPatches(t, cluster)
+
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(cluster), cluster))
+	observedStatus := *cluster.Status.DeepCopy()
+
+	// another actor adds its condition after the status was observed
+	foreignCondition := metav1.Condition{Type: "Foreign", Status: metav1.ConditionTrue, Reason: "SetByAnotherActor", LastTransitionTime: observedTime}
+	concurrent := cluster.DeepCopy()
+	concurrent.Status.Conditions = append(concurrent.Status.Conditions, foreignCondition)
+	require.NoError(t, k8sClient.Status().Update(context.Background(), concurrent))
+
+	cluster.UpdateConditionForReadyState(imv1.ConditionTypeKubeconfigManagement, imv1.ConditionReasonKubeconfigSecretRotated, metav1.ConditionTrue)
+
+	// when
+	patched, err := PatchIfChanged(context.Background(), k8sClient, cluster, observedStatus, cluster.Status, observedStatus.Conditions)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, patched)
+
+	var actual imv1.GardenerCluster
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(cluster), &actual))
+	assert.Equal(t, imv1.ReadyState, actual.Status.State)
+	require.Len(t, actual.Status.Conditions, 2)
+	assert.Equal(t, string(imv1.ConditionReasonKubeconfigSecretRotated), actual.Status.Conditions[0].Reason)
+	assert.Equal(t, "SetByAnotherActor", actual.Status.Conditions[1].Reason)
+}
+
+func TestMergeConditions(t *testing.T) {
+	observedTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	owned := metav1.Condition{Type: "Owned", Status: metav1.ConditionTrue, Reason: "Observed", LastTransitionTime: observedTime}
+	changedOwned := metav1.Condition{Type: "Owned", Status: metav1.ConditionFalse, Reason: "Changed", LastTransitionTime: observedTime}
+	removed := metav1.Condition{Type: "Removed", Status: metav1.ConditionTrue, Reason: "Observed", LastTransitionTime: observedTime}
+	added := metav1.Condition{Type: "Added", Status: metav1.ConditionTrue, Reason: "Added", LastTransitionTime: observedTime}
+	foreign := metav1.Condition{Type: "Foreign", Status: metav1.ConditionTrue, Reason: "Observed", LastTransitionTime: observedTime}
+	changedForeign := metav1.Condition{Type: "Foreign", Status: metav1.ConditionFalse, Reason: "ChangedByAnotherActor", LastTransitionTime: observedTime}
+
+	for tname, tc := range map[string]struct {
+		conditions         []metav1.Condition
+		latestConditions   []metav1.Condition
+		expectedConditions []metav1.Condition
+	}{
+		"Should keep the latest conditions when no condition changed": {
+			conditions:         []metav1.Condition{owned, removed, foreign},
+			latestConditions:   []metav1.Condition{owned, removed, changedForeign},
+			expectedConditions: []metav1.Condition{owned, removed, changedForeign},
+		},
+		"Should apply changed, added and removed conditions and keep the latest state of the other conditions": {
+			conditions:         []metav1.Condition{changedOwned, foreign, added},
+			latestConditions:   []metav1.Condition{owned, removed, changedForeign},
+			expectedConditions: []metav1.Condition{changedOwned, changedForeign, added},
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// when
+			merged := MergeConditions([]metav1.Condition{owned, removed, foreign}, tc.conditions, tc.latestConditions)
+
+			// then
+			assert.Equal(t, tc.expectedConditions, merged)
+		})
+	}
+}
+
+func TestKeepTransitionTimes(t *testing.T) {
+	observedTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	newTime := metav1.NewTime(observedTime.Add(time.Hour))
+
+	conditions := []metav1.Condition{
+		fixCondition("KubeconfigSecretCreated", newTime),
+	}
+	changedConditions := []metav1.Condition{
+		fixCondition("KubeconfigSecretRotated", newTime),
+	}
+
+	KeepTransitionTimes([]metav1.Condition{fixCondition("KubeconfigSecretCreated", observedTime)}, conditions)
+	KeepTransitionTimes([]metav1.Condition{fixCondition("KubeconfigSecretCreated", observedTime)}, changedConditions)
+
+	assert.Equal(t, observedTime, conditions[0].LastTransitionTime)
+	assert.Equal(t, newTime, changedConditions[0].LastTransitionTime)
+}
+
+func fixCondition(reason string, transitionTime metav1.Time) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(imv1.ConditionTypeKubeconfigManagement),
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		LastTransitionTime: transitionTime,
+	}
+}
+
+func fixClientCountingStatusPatches(t *testing.T, cluster *imv1.GardenerCluster) (client.Client, *int) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, imv1.AddToScheme(scheme))
+
+	statusPatches := 0
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(cluster).
+		WithStatusSubresource(cluster).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				statusPatches++
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	return k8sClient, &statusPatches
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	serviceName = "infrastructure-manager"
+	tracerName  = "github.com/kyma-project/infrastructure-manager"
+)
+
+const (
+	AttributeRuntimeName = attribute.Key("runtime.name")
+	AttributeStateName   = attribute.Key("fsm.state")
+)
+
+// Tracer returns the tracer of the infrastructure manager, the spans are dropped until a tracer provider is set up
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// Setup registers the tracer provider exporting the spans to the OTLP gRPC endpoint.
+// Tracing stays disabled when the endpoint is empty, the returned function flushes the remaining spans on shutdown.
+func Setup(ctx context.Context, endpoint string, insecure bool) (func(context.Context) error, error) {
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	return tracerProvider.Shutdown, nil
+}
@@ -0,0 +1,23 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetupWithoutEndpoint(t *testing.T) {
+	// given
+	shutdown, err := Setup(context.Background(), "", false)
+	require.NoError(t, err)
+
+	// when
+	_, span := Tracer().Start(context.Background(), "test")
+	span.End()
+
+	// then
+	assert.False(t, span.SpanContext().IsValid())
+	assert.NoError(t, shutdown(context.Background()))
+}
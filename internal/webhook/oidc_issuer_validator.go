@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const discoveryDocumentPath = "/.well-known/openid-configuration"
+
+//+kubebuilder:webhook:path=/validate-infrastructuremanager-kyma-project-io-v1-runtime,mutating=false,failurePolicy=ignore,sideEffects=None,groups=infrastructuremanager.kyma-project.io,resources=runtimes,verbs=create;update,versions=v1,name=vruntime-oidc-issuer.kb.io,admissionReviewVersions=v1
+
+// OIDCIssuerValidator checks at admission time that the OIDC issuers of a Runtime serve a valid discovery document.
+// Unreachable issuers are reported as warnings unless failing on them is enabled.
+type OIDCIssuerValidator struct {
+	httpClient        *http.Client
+	failOnUnreachable bool
+}
+
+var _ admission.CustomValidator = &OIDCIssuerValidator{}
+
+func NewOIDCIssuerValidator(timeout time.Duration, failOnUnreachable bool) *OIDCIssuerValidator {
+	return &OIDCIssuerValidator{
+		httpClient:        &http.Client{Timeout: timeout},
+		failOnUnreachable: failOnUnreachable,
+	}
+}
+
+// SetupWithManager registers the validating webhook for Runtime in the manager
+func (v *OIDCIssuerValidator) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&imv1.Runtime{}).
+		WithValidator(v).
+		Complete()
+}
+
+func (v *OIDCIssuerValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return v.validate(ctx, obj)
+}
+
+// ValidateUpdate checks the OIDC issuers only when the OIDC config changed, so the issuers of an unchanged config
+// going down do not block the other updates of the Runtime
+func (v *OIDCIssuerValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldRuntime, oldOk := oldObj.(*imv1.Runtime)
+	newRuntime, newOk := newObj.(*imv1.Runtime)
+	if oldOk && newOk && !oidcConfigChanged(oldRuntime, newRuntime) {
+		return nil, nil
+	}
+
+	return v.validate(ctx, newObj)
+}
+
+func (v *OIDCIssuerValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *OIDCIssuerValidator) validate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	rt, ok := obj.(*imv1.Runtime)
+	if !ok {
+		return nil, fmt.Errorf("expected a Runtime object but got %T", obj)
+	}
+
+	var warnings admission.Warnings
+	for _, issuerURL := range issuersToCheck(rt) {
+		err := v.checkDiscoveryDocument(ctx, issuerURL)
+		if err == nil {
+			continue
+		}
+
+		if v.failOnUnreachable {
+			return warnings, fmt.Errorf("OIDC issuer %s does not serve a valid discovery document: %w", issuerURL, err)
+		}
+		warnings = append(warnings, fmt.Sprintf("OIDC issuer %s does not serve a valid discovery document: %s", issuerURL, err))
+	}
+
+	return warnings, nil
+}
+
+func oidcConfigChanged(oldRuntime, newRuntime *imv1.Runtime) bool {
+	oldKubeAPIServer := oldRuntime.Spec.Shoot.Kubernetes.KubeAPIServer
+	newKubeAPIServer := newRuntime.Spec.Shoot.Kubernetes.KubeAPIServer
+
+	return !reflect.DeepEqual(oldKubeAPIServer.OidcConfig, newKubeAPIServer.OidcConfig) ||
+		!reflect.DeepEqual(oldKubeAPIServer.AdditionalOidcConfig, newKubeAPIServer.AdditionalOidcConfig)
+}
+
+// issuersToCheck returns the OIDC issuers of the Runtime, issuers with inline JWKS are skipped as they don't need to be reachable
+func issuersToCheck(rt *imv1.Runtime) []string {
+	var issuers []string
+
+	kubeAPIServer := rt.Spec.Shoot.Kubernetes.KubeAPIServer
+	if kubeAPIServer.OidcConfig.IssuerURL != nil && *kubeAPIServer.OidcConfig.IssuerURL != "" {
+		issuers = append(issuers, *kubeAPIServer.OidcConfig.IssuerURL)
+	}
+
+	if kubeAPIServer.AdditionalOidcConfig != nil {
+		for _, oidcConfig := range *kubeAPIServer.AdditionalOidcConfig {
+			if oidcConfig.IssuerURL == nil || *oidcConfig.IssuerURL == "" || len(oidcConfig.JWKS) > 0 {
+				continue
+			}
+			issuers = append(issuers, *oidcConfig.IssuerURL)
+		}
+	}
+
+	return issuers
+}
+
+func (v *OIDCIssuerValidator) checkDiscoveryDocument(ctx context.Context, issuerURL string) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(issuerURL, "/")+discoveryDocumentPath, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := v.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+
+	var discoveryDocument struct {
+		Issuer string `json:"issuer"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&discoveryDocument); err != nil {
+		return fmt.Errorf("failed to decode discovery document: %w", err)
+	}
+
+	if strings.TrimSuffix(discoveryDocument.Issuer, "/") != strings.TrimSuffix(issuerURL, "/") {
+		return fmt.Errorf("discovery document issuer %q does not match", discoveryDocument.Issuer)
+	}
+
+	return nil
+}
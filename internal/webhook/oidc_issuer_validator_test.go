@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestOIDCIssuerValidator(t *testing.T) {
+	reachableIssuer := fixIssuerServer(t, func(w http.ResponseWriter, r *http.Request, issuerURL string) {
+		_, _ = fmt.Fprintf(w, `{"issuer":%q,"jwks_uri":%q}`, issuerURL, issuerURL+"/keys")
+	})
+	mismatchingIssuer := fixIssuerServer(t, func(w http.ResponseWriter, _ *http.Request, _ string) {
+		_, _ = fmt.Fprint(w, `{"issuer":"https://other.example.com"}`)
+	})
+	failingIssuer := fixIssuerServer(t, func(w http.ResponseWriter, _ *http.Request, _ string) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	unreachableIssuer := httptest.NewServer(http.NotFoundHandler())
+	unreachableIssuer.Close()
+
+	t.Run("Should accept reachable issuer without warnings", func(t *testing.T) {
+		// given
+		validator := NewOIDCIssuerValidator(time.Second, false)
+
+		// when
+		warnings, err := validator.ValidateCreate(context.Background(), fixRuntimeWithIssuers(reachableIssuer.URL))
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("Should accept reachable issuer with trailing slash", func(t *testing.T) {
+		// given
+		validator := NewOIDCIssuerValidator(time.Second, true)
+
+		// when
+		warnings, err := validator.ValidateCreate(context.Background(), fixRuntimeWithIssuers(reachableIssuer.URL+"/"))
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	for name, issuerURL := range map[string]string{
+		"unreachable issuer":        unreachableIssuer.URL,
+		"issuer returning an error": failingIssuer.URL,
+		"mismatching issuer":        mismatchingIssuer.URL,
+	} {
+		t.Run("Should warn about "+name, func(t *testing.T) {
+			// given
+			validator := NewOIDCIssuerValidator(time.Second, false)
+
+			// when
+			warnings, err := validator.ValidateCreate(context.Background(), fixRuntimeWithIssuers(reachableIssuer.URL, issuerURL))
+
+			// then
+			require.NoError(t, err)
+			require.Len(t, warnings, 1)
+			assert.Contains(t, warnings[0], issuerURL)
+		})
+
+		t.Run("Should reject "+name+" when failing is enabled", func(t *testing.T) {
+			// given
+			validator := NewOIDCIssuerValidator(time.Second, true)
+
+			// when
+			_, err := validator.ValidateUpdate(context.Background(), &imv1.Runtime{}, fixRuntimeWithIssuers(issuerURL))
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), issuerURL)
+		})
+	}
+
+	t.Run("Should skip additional issuer with inline JWKS", func(t *testing.T) {
+		// given
+		validator := NewOIDCIssuerValidator(time.Second, true)
+		runtime := fixRuntimeWithIssuers(reachableIssuer.URL)
+		runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig = &[]imv1.OIDCConfig{
+			{
+				OIDCConfig: gardener.OIDCConfig{IssuerURL: ptr.To(unreachableIssuer.URL)},
+				JWKS:       []byte("keys"),
+			},
+		}
+
+		// when
+		warnings, err := validator.ValidateCreate(context.Background(), runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("Should warn when issuer does not respond within timeout", func(t *testing.T) {
+		// given
+		slowIssuer := fixIssuerServer(t, func(w http.ResponseWriter, r *http.Request, issuerURL string) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+		})
+		validator := NewOIDCIssuerValidator(50*time.Millisecond, false)
+
+		// when
+		warnings, err := validator.ValidateCreate(context.Background(), fixRuntimeWithIssuers(slowIssuer.URL))
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, warnings, 1)
+	})
+
+	t.Run("Should not validate update without OIDC config changes", func(t *testing.T) {
+		// given
+		validator := NewOIDCIssuerValidator(time.Second, true)
+		oldRuntime := fixRuntimeWithIssuers(unreachableIssuer.URL, unreachableIssuer.URL+"/additional")
+		newRuntime := oldRuntime.DeepCopy()
+		newRuntime.Spec.Shoot.Provider.Workers = []gardener.Worker{{Name: "worker"}}
+
+		// when
+		warnings, err := validator.ValidateUpdate(context.Background(), oldRuntime, newRuntime)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("Should validate update changing the OIDC config", func(t *testing.T) {
+		// given
+		validator := NewOIDCIssuerValidator(time.Second, true)
+		oldRuntime := fixRuntimeWithIssuers(reachableIssuer.URL)
+		newRuntime := fixRuntimeWithIssuers(reachableIssuer.URL, unreachableIssuer.URL)
+
+		// when
+		_, err := validator.ValidateUpdate(context.Background(), oldRuntime, newRuntime)
+
+		// then
+		require.ErrorContains(t, err, unreachableIssuer.URL)
+	})
+
+	t.Run("Should not validate on delete", func(t *testing.T) {
+		// given
+		validator := NewOIDCIssuerValidator(time.Second, true)
+
+		// when
+		warnings, err := validator.ValidateDelete(context.Background(), fixRuntimeWithIssuers(unreachableIssuer.URL))
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, warnings)
+	})
+}
+
+func fixIssuerServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, issuerURL string)) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != discoveryDocumentPath {
+			http.NotFound(w, r)
+			return
+		}
+		handler(w, r, server.URL)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func fixRuntimeWithIssuers(issuerURL string, additionalIssuerURLs ...string) *imv1.Runtime {
+	runtime := &imv1.Runtime{}
+	runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.IssuerURL = ptr.To(issuerURL)
+
+	if len(additionalIssuerURLs) > 0 {
+		additionalOidcConfig := make([]imv1.OIDCConfig, 0, len(additionalIssuerURLs))
+		for _, additionalIssuerURL := range additionalIssuerURLs {
+			additionalOidcConfig = append(additionalOidcConfig, imv1.OIDCConfig{
+				OIDCConfig: gardener.OIDCConfig{IssuerURL: ptr.To(additionalIssuerURL)},
+			})
+		}
+		runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig = &additionalOidcConfig
+	}
+
+	return runtime
+}
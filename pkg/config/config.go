@@ -2,9 +2,14 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"slices"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	authenticationv1alpha1 "github.com/gardener/oidc-webhook-authenticator/apis/authentication/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 )
 
 type Config struct {
@@ -14,20 +19,49 @@ type Config struct {
 
 type ClusterConfig struct {
 	DefaultSharedIASTenant OidcProvider `json:"defaultSharedIASTenant" validate:"required"`
+	// DefaultNetworkPolicies contains the baseline network policies applied to the workload namespaces of every Runtime
+	DefaultNetworkPolicies DefaultNetworkPoliciesConfig `json:"defaultNetworkPolicies"`
+}
+
+// DefaultNetworkPoliciesConfig contains the network policies applied to the workload namespaces of Runtimes which don't opt out
+type DefaultNetworkPoliciesConfig struct {
+	Enabled bool `json:"enabled"`
+	// Namespaces lists the workload namespaces the policies are applied to, namespaces which don't exist are skipped
+	Namespaces []string `json:"namespaces"`
+	// Policies lists the network policies to apply, default-deny and allow-DNS policies are used when empty
+	Policies []NetworkPolicyConfig `json:"policies"`
+}
+
+// NetworkPolicyConfig describes a network policy applied to every configured namespace
+type NetworkPolicyConfig struct {
+	Name string                         `json:"name"`
+	Spec networkingv1.NetworkPolicySpec `json:"spec"`
 }
 
 type ProviderConfig struct {
 	AWS AWSConfig `json:"aws"`
+	// AllowedCloudProfiles lists the cloud profiles which can be requested in the Runtime, per provider type
+	AllowedCloudProfiles map[string][]string `json:"allowedCloudProfiles"`
 }
 
 type AWSConfig struct {
-	EnableIMDSv2 bool `json:"enableIMDSv2"`
+	EnableIMDSv2 bool                  `json:"enableIMDSv2"`
+	ControlPlane AWSControlPlaneConfig `json:"controlPlane"`
+}
+
+// AWSControlPlaneConfig contains settings rendered into the ControlPlaneConfig of AWS shoots
+type AWSControlPlaneConfig struct {
+	EnableLoadBalancerController       bool            `json:"enableLoadBalancerController"`
+	ManagedDefaultStorageClass         *bool           `json:"managedDefaultStorageClass"`
+	CloudControllerManagerFeatureGates map[string]bool `json:"cloudControllerManagerFeatureGates"`
 }
 
 type DNSConfig struct {
 	SecretName   string `json:"secretName"`
 	DomainPrefix string `json:"domainPrefix"`
 	ProviderType string `json:"providerType"`
+	// DomainPrefixByPurpose overrides DomainPrefix for runtimes with the given shoot purpose, e.g. "production"
+	DomainPrefixByPurpose map[string]string `json:"domainPrefixByPurpose,omitempty"`
 }
 
 type KubernetesConfig struct {
@@ -35,6 +69,11 @@ type KubernetesConfig struct {
 	EnableKubernetesVersionAutoUpdate   bool         `json:"enableKubernetesVersionAutoUpdate"`
 	EnableMachineImageVersionAutoUpdate bool         `json:"enableMachineImageVersionVersionAutoUpdate"`
 	DefaultOperatorOidc                 OidcProvider `json:"defaultOperatorOidc" validate:"required"`
+	SupportedVersions                   []string     `json:"supportedVersions"`
+	// DefaultOidcSigningAlgs are used for the additional OIDC configs of the Runtime which don't specify signing algorithms
+	DefaultOidcSigningAlgs []string `json:"defaultOidcSigningAlgs"`
+	// DefaultKubeletMaxPods is the maximum number of pods per node of the worker pools, unless the Runtime specifies it; 0 keeps the Gardener default
+	DefaultKubeletMaxPods int32 `json:"defaultKubeletMaxPods" validate:"gte=0"`
 }
 
 type OidcProvider struct {
@@ -46,6 +85,30 @@ type OidcProvider struct {
 	UsernamePrefix string   `json:"usernamePrefix" validate:"required"`
 }
 
+// SupportedOidcSigningAlgs lists the signing algorithms recognized by the OIDC authenticator of the shoot
+var SupportedOidcSigningAlgs = []string{
+	string(authenticationv1alpha1.RS256),
+	string(authenticationv1alpha1.RS384),
+	string(authenticationv1alpha1.RS512),
+	string(authenticationv1alpha1.ES256),
+	string(authenticationv1alpha1.ES384),
+	string(authenticationv1alpha1.ES512),
+	string(authenticationv1alpha1.PS256),
+	string(authenticationv1alpha1.PS384),
+	string(authenticationv1alpha1.PS512),
+}
+
+// ValidateOidcSigningAlgs returns an error for the first signing algorithm which is not recognized by the OIDC authenticator
+func ValidateOidcSigningAlgs(signingAlgs []string) error {
+	for _, signingAlg := range signingAlgs {
+		if !slices.Contains(SupportedOidcSigningAlgs, signingAlg) {
+			return fmt.Errorf("OIDC signing algorithm %s is not supported, supported algorithms: %v", signingAlg, SupportedOidcSigningAlgs)
+		}
+	}
+
+	return nil
+}
+
 func (p OidcProvider) ToOIDCConfig() gardener.OIDCConfig {
 	return gardener.OIDCConfig{
 		ClientID:       &p.ClientID,
@@ -57,9 +120,22 @@ func (p OidcProvider) ToOIDCConfig() gardener.OIDCConfig {
 	}
 }
 
+// DefaultAuditLogSecretReferenceName is the name of the shoot resource reference to the audit log credentials used when not configured
+const DefaultAuditLogSecretReferenceName = "auditlog-credentials"
+
 type AuditLogConfig struct {
 	PolicyConfigMapName string `json:"policyConfigMapName" validate:"required"`
 	TenantConfigPath    string `json:"tenantConfigPath" validate:"required"`
+	// SecretReferenceName is the name of the shoot resource reference to the audit log credentials secret
+	SecretReferenceName string `json:"secretReferenceName,omitempty"`
+}
+
+// GetSecretReferenceName returns the configured audit log secret reference name or the default one when not set
+func (c AuditLogConfig) GetSecretReferenceName() string {
+	if c.SecretReferenceName == "" {
+		return DefaultAuditLogSecretReferenceName
+	}
+	return c.SecretReferenceName
 }
 
 type MaintenanceWindowConfig struct {
@@ -68,6 +144,16 @@ type MaintenanceWindowConfig struct {
 
 type GardenerConfig struct {
 	ProjectName string `json:"projectName" validate:"required"`
+	// DefaultSecretBindingName is used for the Runtimes which do not specify the secret binding
+	DefaultSecretBindingName string `json:"defaultSecretBindingName"`
+	// ShootNamePrefix and ShootNameSuffix are added to the shoot name of the Runtime when a new shoot is created
+	ShootNamePrefix string `json:"shootNamePrefix"`
+	ShootNameSuffix string `json:"shootNameSuffix"`
+}
+
+// ShootName returns the name of the shoot created for the shoot name of the Runtime
+func (c GardenerConfig) ShootName(runtimeShootName string) string {
+	return c.ShootNamePrefix + runtimeShootName + c.ShootNameSuffix
 }
 
 type MachineImageConfig struct {
@@ -77,15 +163,72 @@ type MachineImageConfig struct {
 
 type TolerationsConfig map[string][]gardener.Toleration
 
+// AddonsConfig allows to disable addons managed by Gardener regardless of the Runtime settings
+type AddonsConfig struct {
+	DisableKubernetesDashboard bool `json:"disableKubernetesDashboard"`
+	DisableNginxIngress        bool `json:"disableNginxIngress"`
+}
+
+// WorkersConfig contains defaults applied to every worker pool, values set on the pool take precedence
+type WorkersConfig struct {
+	DefaultAnnotations map[string]string `json:"defaultAnnotations"`
+	DefaultTaints      []corev1.Taint    `json:"defaultTaints"`
+	// RoleTaints contains the taints added to the worker pools labeled with the role, keyed by the value of the `role` label
+	RoleTaints map[string][]corev1.Taint `json:"roleTaints"`
+	// DefaultVolumes contains the root volume set on worker pools without a volume, keyed by provider type
+	DefaultVolumes map[string]WorkerVolumeConfig `json:"defaultVolumes"`
+	// ZoneBalancing controls the zone order of worker pools, so new machines are spread evenly when pools scale out
+	ZoneBalancing WorkerZoneBalancingConfig `json:"zoneBalancing"`
+	// Architectures contains the CPU architectures which can be requested for worker pools
+	Architectures WorkerArchitecturesConfig `json:"architectures"`
+	// ZoneMachineTypes lists the machine types available in a zone, keyed by provider type, region and zone; zones which are not listed accept any machine type
+	ZoneMachineTypes map[string]map[string]map[string][]string `json:"zoneMachineTypes"`
+}
+
+// WorkerArchitecturesConfig contains the CPU architectures supported per provider and per machine type
+type WorkerArchitecturesConfig struct {
+	// SupportedByProvider lists the architectures which can be requested for worker pools, keyed by provider type.
+	// The architectures supported by Gardener are accepted for providers which are not listed
+	SupportedByProvider map[string][]string `json:"supportedByProvider"`
+	// MachineTypes contains the architecture of machine types, worker pools of a listed machine type get its architecture when none is requested
+	MachineTypes map[string]string `json:"machineTypes"`
+}
+
+// WorkerZoneBalancingConfig contains the desired zone order and the zone requirements of highly available Runtimes
+type WorkerZoneBalancingConfig struct {
+	// Enabled orders the zones of worker pools deterministically, zones already used by an existing worker pool keep their position
+	Enabled bool `json:"enabled"`
+	// DesiredZones lists the preferred zone order keyed by region, zones not listed are ordered alphabetically after the listed ones
+	DesiredZones map[string][]string `json:"desiredZones"`
+	// RequireHAZones rejects worker pools of Runtimes with zone failure tolerance which don't span a highly available number of zones
+	RequireHAZones bool `json:"requireHAZones"`
+}
+
+// WorkerVolumeConfig describes the root volume of a worker pool
+type WorkerVolumeConfig struct {
+	Type string `json:"type"`
+	Size string `json:"size"`
+}
+
+// NetworkingConfig contains settings of the shoot networking
+type NetworkingConfig struct {
+	// KubeProxyReplacementTypes lists the networking types which replace kube-proxy, only for them kube-proxy can be disabled
+	KubeProxyReplacementTypes []string `json:"kubeProxyReplacementTypes"`
+}
+
 type ConverterConfig struct {
-	Kubernetes        KubernetesConfig        `json:"kubernetes" validate:"required"`
-	DNS               DNSConfig               `json:"dns"`
-	Provider          ProviderConfig          `json:"provider"`
-	MachineImage      MachineImageConfig      `json:"machineImage" validate:"required"`
-	Gardener          GardenerConfig          `json:"gardener" validate:"required"`
-	AuditLog          AuditLogConfig          `json:"auditLogging" validate:"required"`
-	MaintenanceWindow MaintenanceWindowConfig `json:"maintenanceWindow"`
-	Tolerations       TolerationsConfig       `json:"tolerations"`
+	Kubernetes             KubernetesConfig        `json:"kubernetes" validate:"required"`
+	DNS                    DNSConfig               `json:"dns"`
+	Provider               ProviderConfig          `json:"provider"`
+	MachineImage           MachineImageConfig      `json:"machineImage" validate:"required"`
+	Gardener               GardenerConfig          `json:"gardener" validate:"required"`
+	AuditLog               AuditLogConfig          `json:"auditLogging" validate:"required"`
+	MaintenanceWindow      MaintenanceWindowConfig `json:"maintenanceWindow"`
+	Tolerations            TolerationsConfig       `json:"tolerations"`
+	LicenceTypeTolerations TolerationsConfig       `json:"licenceTypeTolerations"`
+	Workers                WorkersConfig           `json:"workers"`
+	Addons                 AddonsConfig            `json:"addons"`
+	Networking             NetworkingConfig        `json:"networking"`
 }
 
 // special case for own Gardener's DNS solution
@@ -93,6 +236,15 @@ func (c DNSConfig) IsGardenerInternal() bool {
 	return c.ProviderType == "" && c.SecretName == "" && c.DomainPrefix == ""
 }
 
+// GetDomainPrefix returns the domain prefix configured for the shoot purpose, falling back to the default DomainPrefix
+func (c DNSConfig) GetDomainPrefix(purpose string) string {
+	if domainPrefix, found := c.DomainPrefixByPurpose[purpose]; found && domainPrefix != "" {
+		return domainPrefix
+	}
+
+	return c.DomainPrefix
+}
+
 type ReaderGetter = func() (io.Reader, error)
 
 func (c *Config) Load(f ReaderGetter) error {
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// IsMinorVersion returns true for a version in the `major.minor` form
+func IsMinorVersion(version string) bool {
+	return strings.Count(version, ".") == 1
+}
+
+// ResolveSupportedVersion returns the supported version matching the version, a version without a patch number (e.g. `1.29`)
+// is resolved to the latest supported patch version of the minor version
+func ResolveSupportedVersion(version string, supportedVersions []string) (string, error) {
+	if slices.Contains(supportedVersions, version) {
+		return version, nil
+	}
+
+	unsupportedErr := fmt.Errorf("unsupported Kubernetes version: %s, supported versions: %s", version, strings.Join(supportedVersions, ", "))
+
+	// only versions in the `major.minor` form are normalized
+	if !IsMinorVersion(version) {
+		return "", unsupportedErr
+	}
+
+	requested, err := semver.NewVersion(version)
+	if err != nil {
+		return "", unsupportedErr
+	}
+
+	var latest *semver.Version
+	var latestOriginal string
+	for _, supportedVersion := range supportedVersions {
+		candidate, err := semver.NewVersion(supportedVersion)
+		if err != nil || candidate.Major() != requested.Major() || candidate.Minor() != requested.Minor() {
+			continue
+		}
+		if latest == nil || candidate.GreaterThan(latest) {
+			latest = candidate
+			latestOriginal = supportedVersion
+		}
+	}
+
+	if latest == nil {
+		return "", unsupportedErr
+	}
+
+	return latestOriginal, nil
+}
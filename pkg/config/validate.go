@@ -0,0 +1,99 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	validator "github.com/go-playground/validator/v10"
+)
+
+// Validate checks the required fields and the consistency of the configuration, all problems found are returned as one error
+func (c Config) Validate() error {
+	var errs []error
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(jsonFieldName)
+
+	if err := validate.Struct(c); err != nil {
+		var validationErrs validator.ValidationErrors
+		if !errors.As(err, &validationErrs) {
+			return err
+		}
+
+		for _, fieldErr := range validationErrs {
+			errs = append(errs, fieldError(fieldErr))
+		}
+	}
+
+	errs = append(errs, c.ConverterConfig.DNS.validate()...)
+	errs = append(errs, c.ConverterConfig.Kubernetes.validate()...)
+
+	return errors.Join(errs...)
+}
+
+func (c DNSConfig) validate() []error {
+	if c.IsGardenerInternal() {
+		return nil
+	}
+
+	var errs []error
+	for field, value := range map[string]string{
+		"converter.dns.secretName":   c.SecretName,
+		"converter.dns.domainPrefix": c.DomainPrefix,
+		"converter.dns.providerType": c.ProviderType,
+	} {
+		if value == "" {
+			errs = append(errs, fmt.Errorf("%s is required when an external DNS provider is configured", field))
+		}
+	}
+
+	// map iteration order is random, keep the error message stable
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return errs
+}
+
+func (c KubernetesConfig) validate() []error {
+	var errs []error
+
+	if len(c.SupportedVersions) > 0 && c.DefaultVersion != "" {
+		if _, err := ResolveSupportedVersion(c.DefaultVersion, c.SupportedVersions); err != nil {
+			errs = append(errs, fmt.Errorf("converter.kubernetes.defaultVersion %s is not one of the supported versions %v", c.DefaultVersion, c.SupportedVersions))
+		}
+	}
+
+	if err := ValidateOidcSigningAlgs(c.DefaultOidcSigningAlgs); err != nil {
+		errs = append(errs, fmt.Errorf("converter.kubernetes.defaultOidcSigningAlgs is invalid: %w", err))
+	}
+
+	if err := ValidateOidcSigningAlgs(c.DefaultOperatorOidc.SigningAlgs); err != nil {
+		errs = append(errs, fmt.Errorf("converter.kubernetes.defaultOperatorOidc.signingAlgs is invalid: %w", err))
+	}
+
+	return errs
+}
+
+func fieldError(fieldErr validator.FieldError) error {
+	// the namespace starts with the name of the validated struct type, which is not part of the configuration file
+	_, field, _ := strings.Cut(fieldErr.Namespace(), ".")
+
+	if fieldErr.Tag() == "required" {
+		return fmt.Errorf("%s is required", field)
+	}
+
+	return fmt.Errorf("%s is invalid: failed on the %q validation", field, fieldErr.Tag())
+}
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	for tname, tc := range map[string]struct {
+		modify         func(*Config)
+		expectedErrors []string
+	}{
+		"Should accept a valid configuration": {
+			modify: func(*Config) {},
+		},
+		"Should accept the Gardener internal DNS": {
+			modify: func(c *Config) {
+				c.ConverterConfig.DNS = DNSConfig{}
+			},
+		},
+		"Should report all missing required fields": {
+			modify: func(c *Config) {
+				c.ConverterConfig.Gardener.ProjectName = ""
+				c.ConverterConfig.MachineImage.DefaultVersion = ""
+				c.ClusterConfig.DefaultSharedIASTenant.ClientID = ""
+			},
+			expectedErrors: []string{
+				"converter.gardener.projectName is required",
+				"converter.machineImage.defaultVersion is required",
+				"cluster.defaultSharedIASTenant.clientID is required",
+			},
+		},
+		"Should require the complete external DNS configuration": {
+			modify: func(c *Config) {
+				c.ConverterConfig.DNS.SecretName = ""
+				c.ConverterConfig.DNS.DomainPrefix = ""
+			},
+			expectedErrors: []string{
+				"converter.dns.secretName is required when an external DNS provider is configured",
+				"converter.dns.domainPrefix is required when an external DNS provider is configured",
+			},
+		},
+		"Should reject a default Kubernetes version which is not supported": {
+			modify: func(c *Config) {
+				c.ConverterConfig.Kubernetes.SupportedVersions = []string{"1.31", "1.32"}
+			},
+			expectedErrors: []string{"converter.kubernetes.defaultVersion 1.30 is not one of the supported versions [1.31 1.32]"},
+		},
+		"Should accept a default Kubernetes minor version matching a supported patch version": {
+			modify: func(c *Config) {
+				c.ConverterConfig.Kubernetes.DefaultVersion = "1.29"
+				c.ConverterConfig.Kubernetes.SupportedVersions = []string{"1.29.3", "1.29.8", "1.30.1"}
+			},
+		},
+		"Should reject a default Kubernetes minor version without a supported patch version": {
+			modify: func(c *Config) {
+				c.ConverterConfig.Kubernetes.DefaultVersion = "1.28"
+				c.ConverterConfig.Kubernetes.SupportedVersions = []string{"1.29.3", "1.30.1"}
+			},
+			expectedErrors: []string{"converter.kubernetes.defaultVersion 1.28 is not one of the supported versions [1.29.3 1.30.1]"},
+		},
+		"Should reject a default OIDC signing algorithm which is not supported": {
+			modify: func(c *Config) {
+				c.ConverterConfig.Kubernetes.DefaultOidcSigningAlgs = []string{"RS256", "HS256"}
+			},
+			expectedErrors: []string{"converter.kubernetes.defaultOidcSigningAlgs is invalid: OIDC signing algorithm HS256 is not supported"},
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			cfg := validConfig()
+			tc.modify(&cfg)
+
+			// when
+			err := cfg.Validate()
+
+			// then
+			if len(tc.expectedErrors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expectedError := range tc.expectedErrors {
+				assert.Contains(t, err.Error(), expectedError)
+			}
+		})
+	}
+}
+
+func validConfig() Config {
+	oidc := OidcProvider{
+		ClientID:       "client-id",
+		GroupsClaim:    "groups",
+		IssuerURL:      "https://issuer.example.com",
+		SigningAlgs:    []string{"RS256"},
+		UsernameClaim:  "sub",
+		UsernamePrefix: "-",
+	}
+
+	return Config{
+		ConverterConfig: ConverterConfig{
+			Kubernetes: KubernetesConfig{
+				DefaultVersion:      "1.30",
+				DefaultOperatorOidc: oidc,
+			},
+			DNS: DNSConfig{
+				SecretName:   "dns-secret",
+				DomainPrefix: "dev.kyma.example.com",
+				ProviderType: "aws-route53",
+			},
+			MachineImage: MachineImageConfig{
+				DefaultName:    "gardenlinux",
+				DefaultVersion: "1592.1.0",
+			},
+			Gardener: GardenerConfig{
+				ProjectName:              "kyma-dev",
+				DefaultSecretBindingName: "secret-binding",
+			},
+			AuditLog: AuditLogConfig{
+				PolicyConfigMapName: "policy-config-map",
+				TenantConfigPath:    "/tenants.json",
+			},
+		},
+		ClusterConfig: ClusterConfig{
+			DefaultSharedIASTenant: oidc,
+		},
+	}
+}
@@ -24,11 +24,8 @@ func NewRestConfigFromFile(kubeconfigFilePath string) (*restclient.Config, error
 	return restConfig, err
 }
 
-const (
-	kubeconfigSecretKey = "config"
-)
-
-func GetRuntimeClient(secret corev1.Secret) (client.Client, error) {
+// GetRuntimeClient returns a client of the runtime cluster, the kubeconfig is read from the given key of the secret data
+func GetRuntimeClient(secret corev1.Secret, kubeconfigSecretKey string) (client.Client, error) {
 
 	restConfig, err := clientcmd.RESTConfigFromKubeConfig(secret.Data[kubeconfigSecretKey])
 	if err != nil {
@@ -0,0 +1,104 @@
+package shoot
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"k8s.io/utils/ptr"
+)
+
+// Option configures the extenders used by the converter
+type Option func(*convertOptions)
+
+type convertOptions struct {
+	PatchOpts
+	patch bool
+}
+
+// WithConverterConfig sets the converter configuration
+func WithConverterConfig(cfg config.ConverterConfig) Option {
+	return func(o *convertOptions) {
+		o.ConverterConfig = cfg
+	}
+}
+
+// WithAuditLogData enables the audit log extension and policy, audit logs are not configured without this option
+func WithAuditLogData(data auditlogs.AuditLogData) Option {
+	return func(o *convertOptions) {
+		o.AuditLogData = data
+	}
+}
+
+// WithMaintenanceTimeWindow sets the maintenance time window applied to production shoots
+func WithMaintenanceTimeWindow(window *gardener.MaintenanceTimeWindow) Option {
+	return func(o *convertOptions) {
+		o.MaintenanceTimeWindow = window
+	}
+}
+
+// WithAvailableKubernetesVersions sets the Kubernetes versions of the cloud profile, a Kubernetes minor version is resolved to the latest of them
+func WithAvailableKubernetesVersions(versions []string) Option {
+	return func(o *convertOptions) {
+		o.AvailableKubernetesVersions = versions
+	}
+}
+
+// ForPatch converts the Runtime into a patch of the given shoot instead of a new shoot.
+// The name, secret binding, Kubernetes version, workers, node CIDR mask size, extensions, resources, provider configs and addons of the shoot are taken into account.
+func ForPatch(shoot gardener.Shoot) Option {
+	return func(o *convertOptions) {
+		o.patch = true
+		o.ShootName = shoot.Name
+		o.SecretBindingName = ptr.Deref(shoot.Spec.SecretBindingName, "")
+		o.ShootK8SVersion = shoot.Spec.Kubernetes.Version
+		o.Workers = shoot.Spec.Provider.Workers
+		o.NodeCIDRMaskSize = NodeCIDRMaskSize(shoot)
+		o.Extensions = shoot.Spec.Extensions
+		o.Resources = shoot.Spec.Resources
+		o.InfrastructureConfig = shoot.Spec.Provider.InfrastructureConfig
+		o.ControlPlaneConfig = shoot.Spec.Provider.ControlPlaneConfig
+		o.Addons = shoot.Spec.Addons
+	}
+}
+
+// NodeCIDRMaskSize returns the node CIDR mask size of the shoot, nil when it is not set
+func NodeCIDRMaskSize(shoot gardener.Shoot) *int32 {
+	if shoot.Spec.Kubernetes.KubeControllerManager == nil {
+		return nil
+	}
+
+	return shoot.Spec.Kubernetes.KubeControllerManager.NodeCIDRMaskSize
+}
+
+func withPatchOpts(opts PatchOpts) Option {
+	return func(o *convertOptions) {
+		o.PatchOpts = opts
+		o.patch = true
+	}
+}
+
+// NewConverter returns a converter with extenders selected by the options.
+// A converter for creating a new shoot is returned unless ForPatch is used.
+func NewConverter(opts ...Option) Converter {
+	var options convertOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.patch {
+		return newConverterPatch(options.PatchOpts)
+	}
+
+	return newConverterCreate(CreateOpts{
+		ConverterConfig:             options.ConverterConfig,
+		AuditLogData:                options.AuditLogData,
+		MaintenanceTimeWindow:       options.MaintenanceTimeWindow,
+		AvailableKubernetesVersions: options.AvailableKubernetesVersions,
+	})
+}
+
+// Convert converts the Runtime into a shoot using a converter configured with the options
+func Convert(runtime imv1.Runtime, opts ...Option) (gardener.Shoot, error) {
+	return NewConverter(opts...).ToShoot(runtime)
+}
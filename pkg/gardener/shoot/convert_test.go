@@ -0,0 +1,117 @@
+package shoot
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/extensions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestConvert(t *testing.T) {
+	t.Run("Should convert Runtime without audit log and maintenance options", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+
+		// when
+		shoot, err := Convert(runtime, WithConverterConfig(fixConverterConfig()))
+
+		// then
+		require.NoError(t, err)
+		assertShootFields(t, runtime, shoot)
+		assert.False(t, hasExtension(shoot, extensions.AuditlogExtensionType))
+		assert.Empty(t, shoot.Spec.Resources)
+		require.NotNil(t, shoot.Spec.Maintenance)
+		assert.Nil(t, shoot.Spec.Maintenance.TimeWindow)
+	})
+
+	t.Run("Should convert Runtime with audit log and maintenance options", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		maintenanceWindow := &gardener.MaintenanceTimeWindow{
+			Begin: "200000+0000",
+			End:   "230000+0000",
+		}
+
+		// when
+		shoot, err := Convert(runtime,
+			WithConverterConfig(fixConverterConfig()),
+			WithAuditLogData(fixAuditLogData()),
+			WithMaintenanceTimeWindow(maintenanceWindow),
+		)
+
+		// then
+		require.NoError(t, err)
+		assertShootFields(t, runtime, shoot)
+		assertAuditLogSecretReference(t, shoot, "auditlog-credentials", "auditlog-secret")
+		assert.Equal(t, maintenanceWindow, shoot.Spec.Maintenance.TimeWindow)
+	})
+
+	t.Run("Should produce the same shoot as the create converter", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		expected, err := NewConverterCreate(CreateOpts{
+			ConverterConfig: fixConverterConfig(),
+			AuditLogData:    fixAuditLogData(),
+		}).ToShoot(runtime)
+		require.NoError(t, err)
+
+		// when
+		shoot, err := Convert(runtime, WithAuditLogData(fixAuditLogData()), WithConverterConfig(fixConverterConfig()))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expected, shoot)
+	})
+
+	t.Run("Should convert Runtime into a patch of the existing shoot", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		existingShoot, err := Convert(runtime, WithConverterConfig(fixConverterConfig()), WithAuditLogData(fixAuditLogData()))
+		require.NoError(t, err)
+		existingShoot.Spec.Kubernetes.Version = "1.30"
+
+		// when
+		shoot, err := Convert(runtime,
+			WithConverterConfig(fixConverterConfig()),
+			WithAuditLogData(fixAuditLogData()),
+			ForPatch(existingShoot),
+		)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.30", shoot.Spec.Kubernetes.Version)
+		assert.Equal(t, existingShoot.Spec.Extensions, shoot.Spec.Extensions)
+		assertAuditLogSecretReference(t, shoot, "auditlog-credentials", "auditlog-secret")
+	})
+
+	t.Run("Should keep the secret binding of the existing shoot in the patch", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		runtime.Spec.Shoot.SecretBindingName = ""
+		converterConfig := fixConverterConfig()
+		converterConfig.Gardener.DefaultSecretBindingName = "default-secret-binding"
+
+		existingShoot, err := Convert(runtime, WithConverterConfig(converterConfig))
+		require.NoError(t, err)
+		existingShoot.Spec.SecretBindingName = ptr.To("existing-secret-binding")
+
+		// when
+		shoot, err := Convert(runtime, WithConverterConfig(converterConfig), ForPatch(existingShoot))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To("existing-secret-binding"), shoot.Spec.SecretBindingName)
+	})
+}
+
+func hasExtension(shoot gardener.Shoot, extensionType string) bool {
+	for _, extension := range shoot.Spec.Extensions {
+		if extension.Type == extensionType {
+			return true
+		}
+	}
+	return false
+}
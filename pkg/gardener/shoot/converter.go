@@ -20,25 +20,35 @@ import (
 
 type Extend func(imv1.Runtime, *gardener.Shoot) error
 
-func baseExtenders() []Extend {
-
-	return []Extend{
-		extender2.ExtendWithAnnotations,
-		extender2.ExtendWithLabels,
-		extender2.ExtendWithSeedSelector,
-		extender2.NewOidcExtender(),
-		extender2.ExtendWithCloudProfile,
-		extender2.ExtendWithExposureClassName,
-		restrictions.ExtendWithAccessRestriction(),
+func baseExtenders(cfg config.ConverterConfig) []scopedExtender {
+
+	return []scopedExtender{
+		mutating(extender2.ExtendWithAnnotations, subtreeMetadata),
+		mutating(extender2.ExtendWithLabels, subtreeMetadata),
+		mutating(extender2.ExtendWithSeedSelector, subtreeSeedSelector),
+		mutating(extender2.ExtendWithNetworkingNodes, subtreeNetworking),
+		mutating(extender2.ExtendWithNetworkingProviderConfig, subtreeNetworking),
+		mutating(extender2.NewOidcExtender(cfg.Kubernetes.DefaultOperatorOidc.ToOIDCConfig()), subtreeKubernetes),
+		mutating(extender2.ExtendWithServiceAccountConfig, subtreeKubernetes),
+		mutating(extender2.ExtendWithDefaultTolerationSeconds, subtreeKubernetes),
+		mutating(extender2.ExtendWithKubeAPIServerLogging, subtreeKubernetes),
+		mutating(extender2.ExtendWithEventTTL, subtreeKubernetes),
+		mutating(extender2.NewCloudProfileExtender(cfg.Provider.AllowedCloudProfiles), subtreeCloudProfile),
+		mutating(extender2.ExtendWithExposureClassName, subtreeExposureClass),
+		mutating(extender2.ExtendWithClusterAutoscaler, subtreeKubernetes),
+		mutating(extender2.ExtendWithKubeScheduler, subtreeKubernetes),
+		mutating(extender2.ExtendWithKubeControllerManager, subtreeKubernetes),
+		mutating(extender2.ExtendWithSystemComponents, subtreeSystemComponents),
+		mutating(restrictions.ExtendWithAccessRestriction(), subtreeAccessRestrictions),
 	}
 }
 
 type Converter struct {
-	extenders []Extend
+	extenders []scopedExtender
 	config    config.ConverterConfig
 }
 
-func newConverter(config config.ConverterConfig, extenders ...Extend) Converter {
+func newConverter(config config.ConverterConfig, extenders ...scopedExtender) Converter {
 	return Converter{
 		extenders: extenders,
 		config:    config,
@@ -49,6 +59,7 @@ type CreateOpts struct {
 	config.ConverterConfig
 	auditlogs.AuditLogData
 	*gardener.MaintenanceTimeWindow
+	AvailableKubernetesVersions []string
 }
 
 type WorkerZones struct {
@@ -60,71 +71,140 @@ type PatchOpts struct {
 	config.ConverterConfig
 	auditlogs.AuditLogData
 	*gardener.MaintenanceTimeWindow
-	ShootK8SVersion      string
-	Workers              []gardener.Worker
+	// ShootName is the name of the existing shoot, it is kept when the shoot naming of the config changes
+	ShootName string
+	// SecretBindingName is the secret binding of the existing shoot, it is kept when the Runtime does not specify one
+	SecretBindingName string
+	ShootK8SVersion   string
+	Workers           []gardener.Worker
+	// NodeCIDRMaskSize is the node CIDR mask size of the existing shoot, the kubelet max pods of the workers must fit into it
+	NodeCIDRMaskSize     *int32
 	Extensions           []gardener.Extension
 	Resources            []gardener.NamedResourceReference
 	InfrastructureConfig *runtime.RawExtension
 	ControlPlaneConfig   *runtime.RawExtension
+	Addons               *gardener.Addons
 	Log                  *logr.Logger
+	// AvailableKubernetesVersions are the versions of the cloud profile a Kubernetes minor version is resolved to
+	AvailableKubernetesVersions []string
 }
 
+// NewConverterCreate returns a converter for creating a new shoot, it is a shorthand for NewConverter with the corresponding options
 func NewConverterCreate(opts CreateOpts) Converter {
-	extendersForCreate := baseExtenders()
+	return NewConverter(
+		WithConverterConfig(opts.ConverterConfig),
+		WithAuditLogData(opts.AuditLogData),
+		WithMaintenanceTimeWindow(opts.MaintenanceTimeWindow),
+		WithAvailableKubernetesVersions(opts.AvailableKubernetesVersions),
+	)
+}
+
+// NewConverterPatch returns a converter for patching an existing shoot, it is a shorthand for NewConverter with the corresponding options
+func NewConverterPatch(opts PatchOpts) Converter {
+	return NewConverter(withPatchOpts(opts))
+}
+
+func newConverterCreate(opts CreateOpts) Converter {
+	extendersForCreate := baseExtenders(opts.ConverterConfig)
 
 	extendersForCreate = append(extendersForCreate,
-		provider.NewProviderExtenderForCreateOperation(
+		mutating(extender2.NewShootNameExtender(opts.Gardener, ""), subtreeMetadata),
+		mutating(extender2.NewSecretBindingExtender(opts.Gardener.DefaultSecretBindingName, ""), subtreeSecretBinding),
+		mutating(provider.NewProviderExtenderForCreateOperation(
 			opts.Provider.AWS.EnableIMDSv2,
 			opts.MachineImage.DefaultName,
 			opts.MachineImage.DefaultVersion,
-		),
-		extender2.NewTolerationsExtender(opts.Tolerations),
+		), subtreeProvider),
+		mutating(provider.NewControlPlaneConfigExtender(opts.Provider), subtreeProvider),
+		mutating(extender2.NewTolerationsExtender(opts.Tolerations, opts.LicenceTypeTolerations), subtreeTolerations),
+		mutating(extender2.NewWorkerDefaultsExtender(opts.Workers), subtreeProvider),
+		mutating(extender2.NewWorkerArchitectureExtender(opts.Workers.Architectures), subtreeProvider),
+		mutating(extender2.NewWorkerZonesExtender(opts.Workers.ZoneBalancing, nil), subtreeProvider),
+		mutating(extender2.NewWorkerMachineTypeZonesExtender(opts.Workers.ZoneMachineTypes), subtreeProvider),
+		mutating(extender2.NewAddonsExtender(opts.Addons, nil), subtreeAddons),
+		mutating(extender2.NewKubeletExtender(opts.Kubernetes.DefaultKubeletMaxPods, nil, nil), subtreeProvider),
+		mutating(extender2.ExtendWithDataVolumes, subtreeProvider),
+		mutating(extender2.ExtendWithWorkerScaling, subtreeProvider),
+		mutating(extender2.ExtendWithWorkerSysctls, subtreeProvider),
+		mutating(extender2.ExtendWithMachineControllerManagerSettings, subtreeProvider),
+		mutating(extender2.NewKubeProxyExtender(opts.Networking.KubeProxyReplacementTypes), subtreeKubernetes),
 	)
 
 	if !opts.DNS.IsGardenerInternal() {
-		extendersForCreate = append(extendersForCreate, extender2.NewDNSExtender(opts.DNS.SecretName, opts.DNS.DomainPrefix, opts.DNS.ProviderType))
+		extendersForCreate = append(extendersForCreate, mutating(extender2.NewDNSExtender(opts.DNS), subtreeDNS, subtreeMetadata))
 	}
-	extendersForCreate = append(extendersForCreate, extensions.NewExtensionsExtenderForCreate(opts.ConverterConfig, opts.AuditLogData, nil))
+	extendersForCreate = append(extendersForCreate, exclusive(extensions.NewExtensionsExtenderForCreate(opts.ConverterConfig, opts.AuditLogData, nil)))
 	extendersForCreate = append(extendersForCreate,
-		extender2.NewKubernetesExtender(opts.Kubernetes.DefaultVersion, ""))
+		mutating(extender2.NewKubernetesExtender(opts.Kubernetes.DefaultVersion, "", opts.Kubernetes.SupportedVersions), subtreeKubernetes),
+		mutating(extender2.NewKubernetesPatchVersionExtender(opts.AvailableKubernetesVersions), subtreeKubernetes),
+		mutating(extender2.NewWorkerKubernetesVersionExtender("", nil), subtreeProvider, subtreeKubernetes))
 
-	extendersForCreate = append(extendersForCreate, maintenance.NewMaintenanceExtender(opts.Kubernetes.EnableKubernetesVersionAutoUpdate, opts.Kubernetes.EnableMachineImageVersionAutoUpdate, opts.MaintenanceTimeWindow))
+	extendersForCreate = append(extendersForCreate, mutating(maintenance.NewMaintenanceExtender(opts.Kubernetes.EnableKubernetesVersionAutoUpdate, opts.Kubernetes.EnableMachineImageVersionAutoUpdate, opts.MaintenanceTimeWindow), subtreeMaintenance))
 
 	if opts.AuditLogData != (auditlogs.AuditLogData{}) {
 		extendersForCreate = append(extendersForCreate,
-			auditlogs.NewAuditlogExtenderForCreate(
+			mutating(auditlogs.NewAuditlogExtenderForCreate(
 				opts.AuditLog.PolicyConfigMapName,
-				opts.AuditLogData))
+				opts.AuditLog.GetSecretReferenceName(),
+				opts.AuditLogData), subtreeKubernetes, subtreeResources))
 	}
 
+	extendersForCreate = append(extendersForCreate,
+		mutating(extender2.ExtendWithNormalizedResources, subtreeResources),
+		mutating(extensions.ExtendWithNormalizedExtensions, subtreeExtensions))
+
 	return newConverter(opts.ConverterConfig, extendersForCreate...)
 }
 
-func NewConverterPatch(opts PatchOpts) Converter {
-	extendersForPatch := baseExtenders()
+func newConverterPatch(opts PatchOpts) Converter {
+	extendersForPatch := baseExtenders(opts.ConverterConfig)
 
 	extendersForPatch = append(extendersForPatch,
-		provider.NewProviderExtenderPatchOperation(
+		mutating(extender2.NewShootNameExtender(opts.Gardener, opts.ShootName), subtreeMetadata),
+		mutating(extender2.NewSecretBindingExtender(opts.Gardener.DefaultSecretBindingName, opts.SecretBindingName), subtreeSecretBinding),
+		mutating(provider.NewProviderExtenderPatchOperation(
 			opts.Provider.AWS.EnableIMDSv2,
 			opts.MachineImage.DefaultName,
 			opts.MachineImage.DefaultVersion,
 			opts.Workers,
 			opts.InfrastructureConfig,
-			opts.ControlPlaneConfig))
+			opts.ControlPlaneConfig), subtreeProvider),
+		mutating(provider.NewControlPlaneConfigExtender(opts.Provider), subtreeProvider),
+		mutating(extender2.NewWorkerDefaultsExtender(opts.ConverterConfig.Workers), subtreeProvider),
+		mutating(extender2.NewWorkerArchitectureExtender(opts.ConverterConfig.Workers.Architectures), subtreeProvider),
+		mutating(extender2.NewWorkerZonesExtender(opts.ConverterConfig.Workers.ZoneBalancing, opts.Workers), subtreeProvider),
+		mutating(extender2.NewWorkerMachineTypeZonesExtender(opts.ConverterConfig.Workers.ZoneMachineTypes), subtreeProvider),
+		mutating(extender2.NewAddonsExtender(opts.ConverterConfig.Addons, opts.Addons), subtreeAddons),
+		mutating(extender2.NewKubeletExtender(opts.ConverterConfig.Kubernetes.DefaultKubeletMaxPods, opts.Workers, opts.NodeCIDRMaskSize), subtreeProvider),
+		mutating(extender2.ExtendWithDataVolumes, subtreeProvider),
+		mutating(extender2.ExtendWithWorkerScaling, subtreeProvider),
+		mutating(extender2.ExtendWithWorkerSysctls, subtreeProvider),
+		mutating(extender2.ExtendWithMachineControllerManagerSettings, subtreeProvider),
+		mutating(extender2.NewKubeProxyExtender(opts.Networking.KubeProxyReplacementTypes), subtreeKubernetes))
 
 	extendersForPatch = append(extendersForPatch,
-		extender2.NewResourcesExtenderForPatch(opts.Resources),
-		extensions.NewExtensionsExtenderForPatch(opts.AuditLogData, opts.Extensions))
+		mutating(extender2.NewResourcesExtenderForPatch(opts.Resources), subtreeResources),
+		exclusive(extensions.NewExtensionsExtenderForPatch(opts.AuditLogData, opts.AuditLog.GetSecretReferenceName(), opts.Extensions)))
 
-	extendersForPatch = append(extendersForPatch, extender2.NewKubernetesExtender(opts.Kubernetes.DefaultVersion, opts.ShootK8SVersion))
+	extendersForPatch = append(extendersForPatch,
+		mutating(extender2.NewKubernetesExtender(opts.Kubernetes.DefaultVersion, opts.ShootK8SVersion, opts.Kubernetes.SupportedVersions), subtreeKubernetes),
+		mutating(extender2.NewKubernetesPatchVersionExtender(opts.AvailableKubernetesVersions), subtreeKubernetes),
+		mutating(extender2.NewWorkerKubernetesVersionExtender(opts.ShootK8SVersion, opts.Workers), subtreeProvider, subtreeKubernetes))
 
-	extendersForPatch = append(extendersForPatch, maintenance.NewMaintenanceExtender(opts.Kubernetes.EnableKubernetesVersionAutoUpdate, opts.Kubernetes.EnableMachineImageVersionAutoUpdate, opts.MaintenanceTimeWindow))
+	extendersForPatch = append(extendersForPatch, mutating(maintenance.NewMaintenanceExtender(opts.Kubernetes.EnableKubernetesVersionAutoUpdate, opts.Kubernetes.EnableMachineImageVersionAutoUpdate, opts.MaintenanceTimeWindow), subtreeMaintenance))
 
 	if opts.AuditLogData != (auditlogs.AuditLogData{}) {
 		extendersForPatch = append(extendersForPatch,
-			auditlogs.NewAuditlogExtenderForPatch(opts.AuditLog.PolicyConfigMapName))
+			mutating(auditlogs.NewAuditlogExtenderForPatch(
+				opts.AuditLog.PolicyConfigMapName,
+				opts.AuditLog.GetSecretReferenceName(),
+				opts.AuditLogData), subtreeKubernetes, subtreeResources))
 	}
 
+	extendersForPatch = append(extendersForPatch,
+		mutating(extender2.ExtendWithNormalizedResources, subtreeResources),
+		mutating(extensions.ExtendWithNormalizedExtensions, subtreeExtensions))
+
 	return newConverter(opts.ConverterConfig, extendersForPatch...)
 }
 
@@ -132,10 +212,23 @@ func (c Converter) ToShoot(runtime imv1.Runtime) (gardener.Shoot, error) {
 	// The original implementation in the Provisioner: https://github.com/kyma-project/control-plane/blob/3dd257826747384479986d5d79eb20f847741aa6/components/provisioner/internal/model/gardener_config.go#L127
 
 	// If you need to enhance the converter please adhere to the following convention:
-	// - fields taken directly from Runtime CR must be added in this function
+	// - fields taken directly from Runtime CR must be added in baseShoot
 	// - if any logic is needed to be implemented, either enhance existing, or create a new extender
 
-	shoot := gardener.Shoot{
+	// extenders mutate the shoot, which would otherwise share slices and pointers such as the worker pools with the Runtime
+	runtime = *runtime.DeepCopy()
+	shoot := c.baseShoot(runtime)
+
+	if err := runExtenders(runtime, &shoot, c.extenders); err != nil {
+		return gardener.Shoot{}, err
+	}
+
+	return shoot, nil
+}
+
+// baseShoot returns the shoot with the fields taken directly from the Runtime CR, before running the extenders
+func (c Converter) baseShoot(runtime imv1.Runtime) gardener.Shoot {
+	return gardener.Shoot{
 		TypeMeta: v1.TypeMeta{
 			Kind:       "Shoot",
 			APIVersion: "core.gardener.cloud/v1beta1",
@@ -150,19 +243,10 @@ func (c Converter) ToShoot(runtime imv1.Runtime) (gardener.Shoot, error) {
 			SecretBindingName: &runtime.Spec.Shoot.SecretBindingName,
 			Networking: &gardener.Networking{
 				Type:     runtime.Spec.Shoot.Networking.Type,
-				Nodes:    &runtime.Spec.Shoot.Networking.Nodes,
 				Pods:     &runtime.Spec.Shoot.Networking.Pods,
 				Services: &runtime.Spec.Shoot.Networking.Services,
 			},
 			ControlPlane: runtime.Spec.Shoot.ControlPlane,
 		},
 	}
-
-	for _, extend := range c.extenders {
-		if err := extend(runtime, &shoot); err != nil {
-			return gardener.Shoot{}, err
-		}
-	}
-
-	return shoot, nil
 }
@@ -1,10 +1,13 @@
 package shoot
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/extensions"
@@ -76,6 +79,251 @@ func TestConverter(t *testing.T) {
 		require.Equalf(t, 4, extensionLen, "unexpected number of extensions: %d, expected: 4", extensionLen)
 	})
 
+	t.Run("Create shoot from Runtime with default Auditlog secret reference name", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		converter := NewConverterCreate(CreateOpts{
+			ConverterConfig: fixConverterConfig(),
+			AuditLogData:    fixAuditLogData(),
+		})
+
+		// when
+		shoot, err := converter.ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assertAuditLogSecretReference(t, shoot, "auditlog-credentials", "auditlog-secret")
+	})
+
+	t.Run("Create shoot from Runtime with custom Auditlog secret reference name", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		converterConfig := fixConverterConfig()
+		converterConfig.AuditLog.SecretReferenceName = "custom-auditlog-credentials"
+		converter := NewConverterCreate(CreateOpts{
+			ConverterConfig: converterConfig,
+			AuditLogData:    fixAuditLogData(),
+		})
+
+		// when
+		shoot, err := converter.ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assertAuditLogSecretReference(t, shoot, "custom-auditlog-credentials", "auditlog-secret")
+	})
+
+	t.Run("Patch shoot from Runtime with custom Auditlog secret reference name", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		converterConfig := fixConverterConfig()
+		converterConfig.AuditLog.SecretReferenceName = "custom-auditlog-credentials"
+		converter := NewConverterPatch(PatchOpts{
+			ConverterConfig:      converterConfig,
+			AuditLogData:         fixAuditLogData(),
+			ShootK8SVersion:      "1.28",
+			Workers:              runtime.Spec.Shoot.Provider.Workers,
+			Extensions:           fixAllExtensionsOnTheShoot(),
+			InfrastructureConfig: fixAWSInfrastructureConfig("10.250.0.0/16", []string{"eu-central-1c", "eu-central-1b", "eu-central-1a"}),
+			ControlPlaneConfig:   fixAWSControlPlaneConfig(),
+		})
+
+		// when
+		shoot, err := converter.ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assertAuditLogSecretReference(t, shoot, "custom-auditlog-credentials", "auditlog-secret")
+	})
+
+	t.Run("Create and patch shoot from Runtime with deterministic worker zone order", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		converterConfig := fixConverterConfig()
+		converterConfig.Workers.ZoneBalancing = config.WorkerZoneBalancingConfig{Enabled: true}
+		expectedZones := []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}
+
+		// when
+		createdShoot, err := NewConverterCreate(CreateOpts{ConverterConfig: converterConfig}).ToShoot(runtime)
+		require.NoError(t, err)
+
+		patchedShoot, err := NewConverterPatch(PatchOpts{
+			ConverterConfig:      converterConfig,
+			Workers:              createdShoot.Spec.Provider.Workers,
+			ShootK8SVersion:      createdShoot.Spec.Kubernetes.Version,
+			InfrastructureConfig: createdShoot.Spec.Provider.InfrastructureConfig,
+			ControlPlaneConfig:   createdShoot.Spec.Provider.ControlPlaneConfig,
+		}).ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expectedZones, createdShoot.Spec.Provider.Workers[0].Zones)
+		assert.Equal(t, expectedZones, patchedShoot.Spec.Provider.Workers[0].Zones)
+	})
+
+	t.Run("Create shoot with a shoot name prefix and the DNS domain of the prefixed shoot name", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		runtime.Spec.Shoot.Name = "myshoot"
+		converterConfig := fixConverterConfig()
+		converterConfig.Gardener.ShootNamePrefix = "p-"
+		expectedDomain := "p-myshoot.dev.mydomain.com"
+
+		// when
+		shoot, err := NewConverterCreate(CreateOpts{ConverterConfig: converterConfig}).ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "p-myshoot", shoot.Name)
+		assert.Equal(t, expectedDomain, *shoot.Spec.DNS.Domain)
+		assert.Equal(t, []string{expectedDomain}, shoot.Spec.DNS.Providers[0].Domains.Include) //nolint:staticcheck
+
+		dnsExtensionIndex := slices.IndexFunc(shoot.Spec.Extensions, func(e gardener.Extension) bool {
+			return e.Type == extensions.DNSExtensionType
+		})
+		require.NotEqual(t, -1, dnsExtensionIndex)
+
+		var dnsConfig extensions.DNSExtensionProviderConfig
+		require.NoError(t, json.Unmarshal(shoot.Spec.Extensions[dnsExtensionIndex].ProviderConfig.Raw, &dnsConfig))
+		assert.Equal(t, []string{expectedDomain}, dnsConfig.Providers[0].Domains.Include)
+	})
+
+	t.Run("Create and patch shoot without changing the Runtime", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		converterConfig := fixConverterConfig()
+		converterConfig.Workers.DefaultAnnotations = map[string]string{"annotation": "value"}
+		expectedRuntime := runtime.DeepCopy()
+
+		// when
+		createdShoot, err := NewConverterCreate(CreateOpts{ConverterConfig: converterConfig}).ToShoot(runtime)
+		require.NoError(t, err)
+
+		patchedShoot, err := NewConverterPatch(PatchOpts{
+			ConverterConfig:      converterConfig,
+			Workers:              createdShoot.Spec.Provider.Workers,
+			ShootK8SVersion:      createdShoot.Spec.Kubernetes.Version,
+			InfrastructureConfig: createdShoot.Spec.Provider.InfrastructureConfig,
+			ControlPlaneConfig:   createdShoot.Spec.Provider.ControlPlaneConfig,
+		}).ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "value", createdShoot.Spec.Provider.Workers[0].Annotations["annotation"])
+		assert.Equal(t, "value", patchedShoot.Spec.Provider.Workers[0].Annotations["annotation"])
+		assert.Equal(t, *expectedRuntime, runtime)
+	})
+
+	t.Run("Patch shoot keeping the kubelet max pods when the default changed", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		converterConfig := fixConverterConfig()
+		converterConfig.Kubernetes.DefaultKubeletMaxPods = 110
+
+		createdShoot, err := NewConverterCreate(CreateOpts{ConverterConfig: converterConfig}).ToShoot(runtime)
+		require.NoError(t, err)
+		converterConfig.Kubernetes.DefaultKubeletMaxPods = 200
+
+		// when
+		patchedShoot, err := NewConverterPatch(PatchOpts{
+			ConverterConfig:      converterConfig,
+			Workers:              createdShoot.Spec.Provider.Workers,
+			ShootK8SVersion:      createdShoot.Spec.Kubernetes.Version,
+			InfrastructureConfig: createdShoot.Spec.Provider.InfrastructureConfig,
+			ControlPlaneConfig:   createdShoot.Spec.Provider.ControlPlaneConfig,
+		}).ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(int32(110)), createdShoot.Spec.Provider.Workers[0].Kubernetes.Kubelet.MaxPods)
+		assert.Equal(t, ptr.To(int32(110)), patchedShoot.Spec.Provider.Workers[0].Kubernetes.Kubelet.MaxPods, "the default must not roll the nodes of existing shoots")
+		assert.Nil(t, runtime.Spec.Shoot.Provider.Workers[0].Kubernetes, "the default must not be saved to the Runtime")
+	})
+
+	t.Run("Validate worker machine types against the zones", func(t *testing.T) {
+		// given
+		converterConfig := fixConverterConfig()
+		converterConfig.Workers.ZoneMachineTypes = map[string]map[string]map[string][]string{
+			hyperscaler.TypeAWS: {
+				"eu-central-1": {"eu-central-1c": {"m6g.large"}},
+			},
+		}
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+
+		// when
+		_, err := NewConverterCreate(CreateOpts{ConverterConfig: converterConfig}).ToShoot(runtime)
+
+		// then
+		require.EqualError(t, err, "machine type m6i.large of worker pool worker is not available in zones [eu-central-1c]")
+	})
+
+	t.Run("Patch shoot keeping its secret binding when the default secret binding changed", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		runtime.Spec.Shoot.SecretBindingName = ""
+		converterConfig := fixConverterConfig()
+		converterConfig.Gardener.DefaultSecretBindingName = "old-default-binding"
+
+		createdShoot, err := NewConverterCreate(CreateOpts{ConverterConfig: converterConfig}).ToShoot(runtime)
+		require.NoError(t, err)
+		converterConfig.Gardener.DefaultSecretBindingName = "new-default-binding"
+
+		// when
+		patchedShoot, err := NewConverterPatch(PatchOpts{
+			ConverterConfig:      converterConfig,
+			SecretBindingName:    *createdShoot.Spec.SecretBindingName,
+			Workers:              createdShoot.Spec.Provider.Workers,
+			ShootK8SVersion:      createdShoot.Spec.Kubernetes.Version,
+			InfrastructureConfig: createdShoot.Spec.Provider.InfrastructureConfig,
+			ControlPlaneConfig:   createdShoot.Spec.Provider.ControlPlaneConfig,
+		}).ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "old-default-binding", *createdShoot.Spec.SecretBindingName)
+		assert.Equal(t, "old-default-binding", *patchedShoot.Spec.SecretBindingName)
+	})
+
+	t.Run("Create shoot from Runtime with machine-controller-manager settings", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		settings := &gardener.MachineControllerManagerSettings{
+			MachineDrainTimeout:  &v1.Duration{Duration: 30 * time.Minute},
+			MachineHealthTimeout: &v1.Duration{Duration: 10 * time.Minute},
+			MaxEvictRetries:      ptr.To(int32(20)),
+		}
+		runtime.Spec.Shoot.Provider.Workers[0].MachineControllerManagerSettings = settings
+
+		converter := NewConverterCreate(CreateOpts{
+			ConverterConfig: fixConverterConfig(),
+			AuditLogData:    auditlogs.AuditLogData{},
+		})
+
+		// when
+		shoot, err := converter.ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, settings, shoot.Spec.Provider.Workers[0].MachineControllerManagerSettings)
+	})
+
+	t.Run("Create shoot from Runtime without machine-controller-manager settings", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+
+		converter := NewConverterCreate(CreateOpts{
+			ConverterConfig: fixConverterConfig(),
+			AuditLogData:    auditlogs.AuditLogData{},
+		})
+
+		// when
+		shoot, err := converter.ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Provider.Workers[0].MachineControllerManagerSettings)
+	})
+
 	t.Run("Create shoot with default converter config versions", func(t *testing.T) {
 		// given
 		runtime := fixRuntimeWithNoVersionsSpecified()
@@ -277,6 +525,24 @@ func TestConverter(t *testing.T) {
 
 		assert.Equal(t, expectedMaintenanceWindow, shoot.Spec.Maintenance.TimeWindow)
 	})
+
+	t.Run("Create shoot from Runtime with worker sysctls", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		runtime.Spec.Shoot.Provider.Workers[0].Sysctls = map[string]string{"net.core.somaxconn": "4096"}
+
+		converter := NewConverterCreate(CreateOpts{
+			ConverterConfig: fixConverterConfig(),
+			AuditLogData:    fixAuditLogData(),
+		})
+
+		// when
+		shoot, err := converter.ToShoot(runtime)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"net.core.somaxconn": "4096"}, shoot.Spec.Provider.Workers[0].Sysctls)
+	})
 }
 
 func assertShootFields(t *testing.T, runtime imv1.Runtime, shoot gardener.Shoot) {
@@ -313,6 +579,31 @@ func fixWorkersWithReversedZones(machineImageName, machineImageVersion string) [
 	}
 }
 
+func fixAuditLogData() auditlogs.AuditLogData {
+	return auditlogs.AuditLogData{
+		TenantID:   "test-auditlog-tenant",
+		ServiceURL: "test-auditlog-service-url",
+		SecretName: "auditlog-secret",
+	}
+}
+
+func assertAuditLogSecretReference(t *testing.T, shoot gardener.Shoot, expectedReferenceName, expectedSecretName string) {
+	auditLogIndex := slices.IndexFunc(shoot.Spec.Extensions, func(e gardener.Extension) bool {
+		return e.Type == extensions.AuditlogExtensionType
+	})
+	require.NotEqual(t, -1, auditLogIndex, "audit log extension not found")
+
+	var auditLogConfig extensions.AuditlogExtensionConfig
+	require.NoError(t, json.Unmarshal(shoot.Spec.Extensions[auditLogIndex].ProviderConfig.Raw, &auditLogConfig))
+	assert.Equal(t, expectedReferenceName, auditLogConfig.SecretReferenceName)
+
+	resourceIndex := slices.IndexFunc(shoot.Spec.Resources, func(r gardener.NamedResourceReference) bool {
+		return r.Name == expectedReferenceName
+	})
+	require.NotEqual(t, -1, resourceIndex, "audit log secret reference %s not found", expectedReferenceName)
+	assert.Equal(t, expectedSecretName, shoot.Spec.Resources[resourceIndex].ResourceRef.Name)
+}
+
 func fixConverterConfig() config.ConverterConfig {
 	return config.ConverterConfig{
 		Kubernetes: config.KubernetesConfig{
@@ -0,0 +1,54 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"k8s.io/utils/ptr"
+)
+
+// NewAddonsExtender enables or disables addons managed by Gardener.
+// Addons disabled in `converter_config.json` are always disabled, otherwise the value from the Runtime is used.
+// Addons not specified in either place keep their current state on the shoot.
+func NewAddonsExtender(addonsConfig config.AddonsConfig, currentAddons *gardener.Addons) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		var runtimeAddons imv1.Addons
+		if runtime.Spec.Shoot.Addons != nil {
+			runtimeAddons = *runtime.Spec.Shoot.Addons
+		}
+
+		addons := currentAddons.DeepCopy()
+
+		if enabled := addonEnabled(runtimeAddons.KubernetesDashboard, addonsConfig.DisableKubernetesDashboard); enabled != nil {
+			if addons == nil {
+				addons = &gardener.Addons{}
+			}
+			if addons.KubernetesDashboard == nil {
+				addons.KubernetesDashboard = &gardener.KubernetesDashboard{}
+			}
+			addons.KubernetesDashboard.Enabled = *enabled
+		}
+
+		if enabled := addonEnabled(runtimeAddons.NginxIngress, addonsConfig.DisableNginxIngress); enabled != nil {
+			if addons == nil {
+				addons = &gardener.Addons{}
+			}
+			if addons.NginxIngress == nil {
+				addons.NginxIngress = &gardener.NginxIngress{}
+			}
+			addons.NginxIngress.Enabled = *enabled
+		}
+
+		shoot.Spec.Addons = addons
+
+		return nil
+	}
+}
+
+func addonEnabled(runtimeValue *bool, disabledByConfig bool) *bool {
+	if disabledByConfig {
+		return ptr.To(false)
+	}
+
+	return runtimeValue
+}
@@ -0,0 +1,110 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestAddonsExtender(t *testing.T) {
+	t.Run("Should disable kubernetes dashboard and nginx ingress when disabled in config", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAddons(&imv1.Addons{KubernetesDashboard: ptr.To(true)})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		addonsConfig := config.AddonsConfig{DisableKubernetesDashboard: true, DisableNginxIngress: true}
+
+		// when
+		err := NewAddonsExtender(addonsConfig, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Addons)
+		assert.False(t, shoot.Spec.Addons.KubernetesDashboard.Enabled)
+		assert.False(t, shoot.Spec.Addons.NginxIngress.Enabled)
+	})
+
+	t.Run("Should disable kubernetes dashboard when disabled in Runtime", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAddons(&imv1.Addons{KubernetesDashboard: ptr.To(false)})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewAddonsExtender(config.AddonsConfig{}, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Addons)
+		assert.False(t, shoot.Spec.Addons.KubernetesDashboard.Enabled)
+		assert.Nil(t, shoot.Spec.Addons.NginxIngress)
+	})
+
+	t.Run("Should not set addons when not configured", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAddons(nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewAddonsExtender(config.AddonsConfig{}, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Addons)
+	})
+
+	t.Run("Should preserve current enabled state of the shoot when not configured", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAddons(nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		currentAddons := &gardener.Addons{
+			KubernetesDashboard: &gardener.KubernetesDashboard{
+				Addon:              gardener.Addon{Enabled: true},
+				AuthenticationMode: ptr.To("token"),
+			},
+		}
+
+		// when
+		err := NewAddonsExtender(config.AddonsConfig{}, currentAddons)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, currentAddons, shoot.Spec.Addons)
+	})
+
+	t.Run("Should disable current kubernetes dashboard keeping its settings", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAddons(nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		currentAddons := &gardener.Addons{
+			KubernetesDashboard: &gardener.KubernetesDashboard{
+				Addon:              gardener.Addon{Enabled: true},
+				AuthenticationMode: ptr.To("token"),
+			},
+		}
+
+		// when
+		err := NewAddonsExtender(config.AddonsConfig{DisableKubernetesDashboard: true}, currentAddons)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, shoot.Spec.Addons.KubernetesDashboard.Enabled)
+		assert.Equal(t, ptr.To("token"), shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode)
+		assert.True(t, currentAddons.KubernetesDashboard.Enabled)
+	})
+}
+
+func fixRuntimeWithAddons(addons *imv1.Addons) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name:   "shoot",
+				Addons: addons,
+			},
+		},
+	}
+}
@@ -3,7 +3,8 @@ package auditlogs
 import "fmt"
 
 var (
-	ErrConfigurationNotFound = fmt.Errorf("audit logs configuration not found")
+	ErrConfigurationNotFound   = fmt.Errorf("audit logs configuration not found")
+	ErrPolicyConfigMapNotFound = fmt.Errorf("audit policy config map not found")
 )
 
 type region = string
@@ -16,6 +16,11 @@ type Extend = func(runtime imv1.Runtime, shoot *gardener.Shoot) error
 
 type operation = func(*gardener.Shoot) error
 
+// PolicyConfigMapName returns the name of the audit policy config map referenced by the shoot of the Runtime
+func PolicyConfigMapName(runtime imv1.Runtime, defaultPolicyMapName string) string {
+	return fixPolicyConfigMapName(runtime.Annotations, defaultPolicyMapName)
+}
+
 func fixPolicyConfigMapName(annotations map[string]string, defaultPolicyMapName string) string {
 	annotationVal, found := annotations[experimentalAuditPolicyAnnotationName]
 	if found && strings.ToLower(annotationVal) == "true" {
@@ -24,11 +29,11 @@ func fixPolicyConfigMapName(annotations map[string]string, defaultPolicyMapName
 	return defaultPolicyMapName
 }
 
-func NewAuditlogExtenderForCreate(policyConfigMapName string, data AuditLogData) Extend {
+func NewAuditlogExtenderForCreate(policyConfigMapName, secretReferenceName string, data AuditLogData) Extend {
 	return func(rt imv1.Runtime, shoot *gardener.Shoot) error {
 		policyConfigMapName := fixPolicyConfigMapName(rt.Annotations, policyConfigMapName)
 		for _, f := range []operation{
-			oSetSecret(data.SecretName),
+			oSetSecret(data.SecretName, secretReferenceName),
 			oSetPolicyConfigmap(policyConfigMapName),
 		} {
 			if err := f(shoot); err != nil {
@@ -39,9 +44,18 @@ func NewAuditlogExtenderForCreate(policyConfigMapName string, data AuditLogData)
 	}
 }
 
-func NewAuditlogExtenderForPatch(policyConfigMapName string) Extend {
+// NewAuditlogExtenderForPatch sets the audit policy and the secret reference, so the reference matches the one used by the audit log extension
+func NewAuditlogExtenderForPatch(policyConfigMapName, secretReferenceName string, data AuditLogData) Extend {
 	return func(rt imv1.Runtime, shoot *gardener.Shoot) error {
 		policyConfigMapName := fixPolicyConfigMapName(rt.Annotations, policyConfigMapName)
-		return oSetPolicyConfigmap(policyConfigMapName)(shoot)
+		for _, f := range []operation{
+			oSetSecret(data.SecretName, secretReferenceName),
+			oSetPolicyConfigmap(policyConfigMapName),
+		} {
+			if err := f(shoot); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 }
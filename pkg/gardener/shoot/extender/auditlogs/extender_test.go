@@ -6,6 +6,7 @@ import (
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -72,7 +73,7 @@ func Test_AuditlogExtenderExperimentalCfg(t *testing.T) {
 		},
 	} {
 		// given
-		extendWithAuditlogs := NewAuditlogExtenderForCreate(defaultPolicyConfigmapName, tc.data)
+		extendWithAuditlogs := NewAuditlogExtenderForCreate(defaultPolicyConfigmapName, "auditlog-credentials", tc.data)
 
 		// when
 		err := extendWithAuditlogs(tc.rt, &tc.shoot)
@@ -101,7 +102,7 @@ func Test_AuditlogExtender(t *testing.T) {
 		},
 	} {
 		// given
-		extendWithAuditlogs := NewAuditlogExtenderForCreate(tc.policyConfigmapName, tc.data)
+		extendWithAuditlogs := NewAuditlogExtenderForCreate(tc.policyConfigmapName, "auditlog-credentials", tc.data)
 
 		// when
 		err := extendWithAuditlogs(zero, &tc.shoot)
@@ -110,3 +111,29 @@ func Test_AuditlogExtender(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func Test_AuditlogExtenderForPatch(t *testing.T) {
+	// given
+	shoot := gardener.Shoot{
+		Spec: gardener.ShootSpec{
+			Resources: []gardener.NamedResourceReference{
+				{Name: "custom-auditlog-credentials", ResourceRef: v1.CrossVersionObjectReference{Name: "old-secret"}},
+			},
+		},
+	}
+	data := AuditLogData{
+		TenantID:   "tenant-id",
+		ServiceURL: "testme",
+		SecretName: "new-secret",
+	}
+	extendWithAuditlogs := NewAuditlogExtenderForPatch("policy", "custom-auditlog-credentials", data)
+
+	// when
+	err := extendWithAuditlogs(imv1.Runtime{}, &shoot)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, shoot.Spec.Resources, 1)
+	requireNoErrorAssertContainsSecretResource(t, "custom-auditlog-credentials", "new-secret", shoot.Spec.Resources)
+	require.Equal(t, "policy", shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.AuditPolicy.ConfigMapRef.Name)
+}
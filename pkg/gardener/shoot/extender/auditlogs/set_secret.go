@@ -7,12 +7,10 @@ import (
 	v1 "k8s.io/api/autoscaling/v1"
 )
 
-const auditlogSecretReference = "auditlog-credentials"
-
-func oSetSecret(secretName string) operation {
+func oSetSecret(secretName, secretReferenceName string) operation {
 	return func(s *gardener.Shoot) error {
 		resource := gardener.NamedResourceReference{
-			Name: auditlogSecretReference,
+			Name: secretReferenceName,
 			ResourceRef: v1.CrossVersionObjectReference{
 				Name:       secretName,
 				Kind:       "Secret",
@@ -20,7 +18,7 @@ func oSetSecret(secretName string) operation {
 			},
 		}
 		index := slices.IndexFunc(s.Spec.Resources, func(r gardener.NamedResourceReference) bool {
-			return r.Name == auditlogSecretReference
+			return r.Name == secretReferenceName
 		})
 
 		if index == -1 {
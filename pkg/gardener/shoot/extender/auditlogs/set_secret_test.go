@@ -7,35 +7,55 @@ import (
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/autoscaling/v1"
 )
 
 func Test_oSetSecret(t *testing.T) {
 	for _, testCase := range []struct {
-		shoot      gardener.Shoot
-		secretName string
+		shoot               gardener.Shoot
+		secretName          string
+		secretReferenceName string
 	}{
 		{
-			shoot:      gardener.Shoot{},
-			secretName: "test-secret",
+			shoot:               gardener.Shoot{},
+			secretName:          "test-secret",
+			secretReferenceName: "auditlog-credentials",
+		},
+		{
+			shoot:               gardener.Shoot{},
+			secretName:          "test-secret",
+			secretReferenceName: "custom-auditlog-credentials",
+		},
+		{
+			shoot: gardener.Shoot{
+				Spec: gardener.ShootSpec{
+					Resources: []gardener.NamedResourceReference{
+						{Name: "custom-auditlog-credentials", ResourceRef: v1.CrossVersionObjectReference{Name: "old-secret"}},
+					},
+				},
+			},
+			secretName:          "test-secret",
+			secretReferenceName: "custom-auditlog-credentials",
 		},
 	} {
 		// given
-		operate := oSetSecret(testCase.secretName)
+		operate := oSetSecret(testCase.secretName, testCase.secretReferenceName)
 
 		// when
 		err := operate(&testCase.shoot)
 
 		// then
 		require.NoError(t, err)
-		requireNoErrorAssertContainsSecretResource(t, testCase.secretName, testCase.shoot.Spec.Resources)
+		require.Len(t, testCase.shoot.Spec.Resources, 1)
+		requireNoErrorAssertContainsSecretResource(t, testCase.secretReferenceName, testCase.secretName, testCase.shoot.Spec.Resources)
 	}
 }
 
-func requireNoErrorAssertContainsSecretResource(t *testing.T, expected string, actual []gardener.NamedResourceReference) {
+func requireNoErrorAssertContainsSecretResource(t *testing.T, expectedReferenceName, expected string, actual []gardener.NamedResourceReference) {
 	index := slices.IndexFunc(actual, func(r gardener.NamedResourceReference) bool {
-		return r.Name == auditlogSecretReference
+		return r.Name == expectedReferenceName
 	})
-	require.NotEqual(t, -1, index, "'%s' NamedResourceReference not found", auditlogSecretReference)
-	assert.Equal(t, auditlogSecretReference, actual[index].Name)
+	require.NotEqual(t, -1, index, "'%s' NamedResourceReference not found", expectedReferenceName)
+	assert.Equal(t, expectedReferenceName, actual[index].Name)
 	assert.Equal(t, expected, actual[index].ResourceRef.Name)
 }
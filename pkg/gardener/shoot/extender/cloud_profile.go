@@ -1,6 +1,9 @@
 package extender
 
 import (
+	"fmt"
+	"slices"
+
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
@@ -15,16 +18,41 @@ const (
 	DefaultOpenStackCloudProfileName = "converged-cloud-kyma"
 )
 
-func ExtendWithCloudProfile(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-	cloudProfileName, err := getCloudProfileName(runtime)
+// NewCloudProfileExtender sets the cloud profile name requested in the Runtime, or the default cloud profile of the provider when it is not specified.
+// The requested cloud profile must be listed in `allowedCloudProfiles` for the Runtime provider.
+func NewCloudProfileExtender(allowedCloudProfiles map[string][]string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		requestedCloudProfileName := ptr.Deref(runtime.Spec.Shoot.CloudProfileName, "")
+		if requestedCloudProfileName != "" {
+			providerType := runtime.Spec.Shoot.Provider.Type
+			if !slices.Contains(allowedCloudProfiles[providerType], requestedCloudProfileName) {
+				return fmt.Errorf("cloud profile %s is not allowed for provider %s", requestedCloudProfileName, providerType)
+			}
+
+			shoot.Spec.CloudProfileName = ptr.To(requestedCloudProfileName)
+
+			return nil
+		}
+
+		cloudProfileName, err := getCloudProfileName(runtime)
 
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
+
+		shoot.Spec.CloudProfileName = ptr.To(cloudProfileName)
+
+		return nil
 	}
+}
 
-	shoot.Spec.CloudProfileName = ptr.To(cloudProfileName)
+// RuntimeCloudProfileName returns the name of the cloud profile requested in the Runtime or the default cloud profile of its provider
+func RuntimeCloudProfileName(runtime imv1.Runtime) (string, error) {
+	if requestedCloudProfileName := ptr.Deref(runtime.Spec.Shoot.CloudProfileName, ""); requestedCloudProfileName != "" {
+		return requestedCloudProfileName, nil
+	}
 
-	return nil
+	return getCloudProfileName(runtime)
 }
 
 func getCloudProfileName(runtime imv1.Runtime) (string, error) {
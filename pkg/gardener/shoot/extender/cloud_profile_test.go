@@ -53,7 +53,7 @@ func TestExtendWithCloudProfile(t *testing.T) {
 			shoot := testutils.FixEmptyGardenerShoot("test", "dev")
 
 			// when
-			err := ExtendWithCloudProfile(runtime, &shoot)
+			err := NewCloudProfileExtender(nil)(runtime, &shoot)
 
 			// then
 			require.NoError(t, err)
@@ -76,9 +76,67 @@ func TestExtendWithCloudProfile(t *testing.T) {
 		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
 
 		// when
-		err := ExtendWithCloudProfile(runtime, &shoot)
+		err := NewCloudProfileExtender(nil)(runtime, &shoot)
 
 		// then
 		require.Error(t, err)
 	})
+
+	t.Run("Set cloud profile name requested in the Runtime", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithCloudProfileName(hyperscaler.TypeAWS, ptr.To("aws-custom"))
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewCloudProfileExtender(allowedCloudProfiles)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To("aws-custom"), shoot.Spec.CloudProfileName)
+	})
+
+	t.Run("Return error for cloud profile name not allowed for the provider", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithCloudProfileName(hyperscaler.TypeGCP, ptr.To("aws-custom"))
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewCloudProfileExtender(allowedCloudProfiles)(runtime, &shoot)
+
+		// then
+		require.ErrorContains(t, err, "cloud profile aws-custom is not allowed for provider gcp")
+		assert.Nil(t, shoot.Spec.CloudProfileName)
+	})
+
+	t.Run("Set default cloud profile name when not requested in the Runtime", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithCloudProfileName(hyperscaler.TypeAWS, nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewCloudProfileExtender(allowedCloudProfiles)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(DefaultAWSCloudProfileName), shoot.Spec.CloudProfileName)
+	})
+}
+
+var allowedCloudProfiles = map[string][]string{
+	hyperscaler.TypeAWS: {"aws", "aws-custom"},
+	hyperscaler.TypeGCP: {"gcp"},
+}
+
+func fixRuntimeWithCloudProfileName(providerType string, cloudProfileName *string) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name:             "myshoot",
+				CloudProfileName: cloudProfileName,
+				Provider: imv1.Provider{
+					Type: providerType,
+				},
+			},
+		},
+	}
 }
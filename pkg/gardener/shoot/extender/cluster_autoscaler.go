@@ -0,0 +1,36 @@
+package extender
+
+import (
+	"fmt"
+	"slices"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+)
+
+var supportedExpanders = []gardener.ExpanderMode{
+	gardener.ClusterAutoscalerExpanderLeastWaste,
+	gardener.ClusterAutoscalerExpanderMostPods,
+	gardener.ClusterAutoscalerExpanderPriority,
+	gardener.ClusterAutoscalerExpanderRandom,
+}
+
+// ExtendWithClusterAutoscaler sets the cluster autoscaler expander strategy when it is specified in the Runtime
+func ExtendWithClusterAutoscaler(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	clusterAutoscaler := runtime.Spec.Shoot.Kubernetes.ClusterAutoscaler
+	if clusterAutoscaler == nil || clusterAutoscaler.Expander == nil {
+		return nil
+	}
+
+	expander := *clusterAutoscaler.Expander
+	if !slices.Contains(supportedExpanders, expander) {
+		return fmt.Errorf("unsupported cluster autoscaler expander: %s", expander)
+	}
+
+	if shoot.Spec.Kubernetes.ClusterAutoscaler == nil {
+		shoot.Spec.Kubernetes.ClusterAutoscaler = &gardener.ClusterAutoscaler{}
+	}
+	shoot.Spec.Kubernetes.ClusterAutoscaler.Expander = &expander
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestClusterAutoscalerExtender(t *testing.T) {
+	for _, expander := range []gardener.ExpanderMode{
+		gardener.ClusterAutoscalerExpanderLeastWaste,
+		gardener.ClusterAutoscalerExpanderMostPods,
+		gardener.ClusterAutoscalerExpanderPriority,
+		gardener.ClusterAutoscalerExpanderRandom,
+	} {
+		t.Run("Should set expander "+string(expander), func(t *testing.T) {
+			// given
+			runtime := fixRuntimeWithClusterAutoscaler(&imv1.ClusterAutoscaler{Expander: ptr.To(expander)})
+			shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+			// when
+			err := ExtendWithClusterAutoscaler(runtime, &shoot)
+
+			// then
+			require.NoError(t, err)
+			require.NotNil(t, shoot.Spec.Kubernetes.ClusterAutoscaler)
+			assert.Equal(t, expander, *shoot.Spec.Kubernetes.ClusterAutoscaler.Expander)
+		})
+	}
+
+	t.Run("Should not set cluster autoscaler when not specified", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithClusterAutoscaler(nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithClusterAutoscaler(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.ClusterAutoscaler)
+	})
+
+	t.Run("Should return error for unsupported expander", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithClusterAutoscaler(&imv1.ClusterAutoscaler{Expander: ptr.To(gardener.ExpanderMode("fastest"))})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithClusterAutoscaler(runtime, &shoot)
+
+		// then
+		require.ErrorContains(t, err, "unsupported cluster autoscaler expander: fastest")
+		assert.Nil(t, shoot.Spec.Kubernetes.ClusterAutoscaler)
+	})
+}
+
+func fixRuntimeWithClusterAutoscaler(clusterAutoscaler *imv1.ClusterAutoscaler) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Kubernetes: imv1.Kubernetes{
+					ClusterAutoscaler: clusterAutoscaler,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package extender
+
+import (
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ExtendWithDataVolumes validates the data volumes of every worker pool taken from the Runtime.
+// It must run after the provider extender which sets the shoot workers.
+func ExtendWithDataVolumes(_ imv1.Runtime, shoot *gardener.Shoot) error {
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if err := validateDataVolumes(worker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateDataVolumes(worker gardener.Worker) error {
+	if len(worker.DataVolumes) == 0 {
+		return nil
+	}
+
+	if worker.Volume == nil {
+		return fmt.Errorf("worker pool %s must define a volume when data volumes are specified", worker.Name)
+	}
+
+	names := map[string]struct{}{}
+	for _, dataVolume := range worker.DataVolumes {
+		if dataVolume.Name == "" {
+			return fmt.Errorf("data volume name must not be empty in worker pool %s", worker.Name)
+		}
+
+		if _, found := names[dataVolume.Name]; found {
+			return fmt.Errorf("duplicated data volume %s in worker pool %s", dataVolume.Name, worker.Name)
+		}
+		names[dataVolume.Name] = struct{}{}
+
+		size, err := resource.ParseQuantity(dataVolume.VolumeSize)
+		if err != nil || size.Sign() <= 0 {
+			return fmt.Errorf("invalid size %q of data volume %s in worker pool %s", dataVolume.VolumeSize, dataVolume.Name, worker.Name)
+		}
+
+		if dataVolume.Type != nil && *dataVolume.Type == "" {
+			return fmt.Errorf("data volume %s in worker pool %s must not have an empty type", dataVolume.Name, worker.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestDataVolumesExtender(t *testing.T) {
+	t.Run("Should keep data volumes of the worker pools", func(t *testing.T) {
+		// given
+		dataVolumes := []gardener.DataVolume{
+			{Name: "containerd", Type: ptr.To("gp3"), VolumeSize: "100Gi"},
+			{Name: "data", VolumeSize: "50Gi", Encrypted: ptr.To(true)},
+		}
+		shoot := fixShootWithWorkers(
+			fixWorkerWithDataVolumes("with-data-volumes", dataVolumes...),
+			gardener.Worker{Name: "without-data-volumes"},
+		)
+
+		// when
+		err := ExtendWithDataVolumes(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, dataVolumes, shoot.Spec.Provider.Workers[0].DataVolumes)
+		assert.Empty(t, shoot.Spec.Provider.Workers[1].DataVolumes)
+	})
+
+	for _, tc := range []struct {
+		name        string
+		worker      gardener.Worker
+		expectedErr string
+	}{
+		{
+			name: "Should return error when worker volume is missing",
+			worker: gardener.Worker{
+				Name:        "pool",
+				DataVolumes: []gardener.DataVolume{{Name: "data", VolumeSize: "50Gi"}},
+			},
+			expectedErr: "worker pool pool must define a volume when data volumes are specified",
+		},
+		{
+			name:        "Should return error for empty name",
+			worker:      fixWorkerWithDataVolumes("pool", gardener.DataVolume{VolumeSize: "50Gi"}),
+			expectedErr: "data volume name must not be empty in worker pool pool",
+		},
+		{
+			name: "Should return error for duplicated name",
+			worker: fixWorkerWithDataVolumes("pool",
+				gardener.DataVolume{Name: "data", VolumeSize: "50Gi"},
+				gardener.DataVolume{Name: "data", VolumeSize: "60Gi"}),
+			expectedErr: "duplicated data volume data in worker pool pool",
+		},
+		{
+			name:        "Should return error for invalid size",
+			worker:      fixWorkerWithDataVolumes("pool", gardener.DataVolume{Name: "data", VolumeSize: "fifty"}),
+			expectedErr: `invalid size "fifty" of data volume data in worker pool pool`,
+		},
+		{
+			name:        "Should return error for zero size",
+			worker:      fixWorkerWithDataVolumes("pool", gardener.DataVolume{Name: "data", VolumeSize: "0Gi"}),
+			expectedErr: `invalid size "0Gi" of data volume data in worker pool pool`,
+		},
+		{
+			name:        "Should return error for empty type",
+			worker:      fixWorkerWithDataVolumes("pool", gardener.DataVolume{Name: "data", Type: ptr.To(""), VolumeSize: "50Gi"}),
+			expectedErr: "data volume data in worker pool pool must not have an empty type",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			shoot := fixShootWithWorkers(tc.worker)
+
+			// when
+			err := ExtendWithDataVolumes(imv1.Runtime{}, &shoot)
+
+			// then
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func fixWorkerWithDataVolumes(name string, dataVolumes ...gardener.DataVolume) gardener.Worker {
+	return gardener.Worker{
+		Name:        name,
+		Volume:      &gardener.Volume{Type: ptr.To("gp3"), VolumeSize: "50Gi"},
+		DataVolumes: dataVolumes,
+	}
+}
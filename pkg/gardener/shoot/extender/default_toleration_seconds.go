@@ -0,0 +1,30 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+// ExtendWithDefaultTolerationSeconds sets the default notReady and unreachable toleration seconds of the kube-apiserver.
+// When not specified in the Runtime, Gardener defaults are used.
+func ExtendWithDefaultTolerationSeconds(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	kubeAPIServer := runtime.Spec.Shoot.Kubernetes.KubeAPIServer
+	if kubeAPIServer.DefaultNotReadyTolerationSeconds == nil && kubeAPIServer.DefaultUnreachableTolerationSeconds == nil {
+		return nil
+	}
+
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{}
+	}
+
+	if kubeAPIServer.DefaultNotReadyTolerationSeconds != nil {
+		shoot.Spec.Kubernetes.KubeAPIServer.DefaultNotReadyTolerationSeconds = ptr.To(*kubeAPIServer.DefaultNotReadyTolerationSeconds)
+	}
+
+	if kubeAPIServer.DefaultUnreachableTolerationSeconds != nil {
+		shoot.Spec.Kubernetes.KubeAPIServer.DefaultUnreachableTolerationSeconds = ptr.To(*kubeAPIServer.DefaultUnreachableTolerationSeconds)
+	}
+
+	return nil
+}
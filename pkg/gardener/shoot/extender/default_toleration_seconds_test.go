@@ -0,0 +1,77 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestDefaultTolerationSecondsExtender(t *testing.T) {
+	t.Run("Should set explicit default toleration seconds", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAPIServer(imv1.APIServer{
+			DefaultNotReadyTolerationSeconds:    ptr.To(int64(600)),
+			DefaultUnreachableTolerationSeconds: ptr.To(int64(900)),
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithDefaultTolerationSeconds(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer)
+		assert.Equal(t, ptr.To(int64(600)), shoot.Spec.Kubernetes.KubeAPIServer.DefaultNotReadyTolerationSeconds)
+		assert.Equal(t, ptr.To(int64(900)), shoot.Spec.Kubernetes.KubeAPIServer.DefaultUnreachableTolerationSeconds)
+	})
+
+	t.Run("Should set only the configured toleration and keep existing kube-apiserver settings", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAPIServer(imv1.APIServer{
+			DefaultUnreachableTolerationSeconds: ptr.To(int64(120)),
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewOidcExtender(gardener.OIDCConfig{})(runtime, &shoot)
+		require.NoError(t, err)
+		err = ExtendWithDefaultTolerationSeconds(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeAPIServer.DefaultNotReadyTolerationSeconds)
+		assert.Equal(t, ptr.To(int64(120)), shoot.Spec.Kubernetes.KubeAPIServer.DefaultUnreachableTolerationSeconds)
+		assert.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication)
+	})
+
+	t.Run("Should leave Gardener defaults when not configured", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAPIServer(imv1.APIServer{})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithDefaultTolerationSeconds(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeAPIServer)
+	})
+}
+
+func fixRuntimeWithAPIServer(kubeAPIServer imv1.APIServer) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name: "shoot",
+				Kubernetes: imv1.Kubernetes{
+					KubeAPIServer: kubeAPIServer,
+				},
+			},
+		},
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 )
 
 // The types were copied from the following file: https://github.com/gardener/gardener-extension-shoot-dns-service/blob/master/pkg/apis/service/types.go
@@ -51,9 +52,12 @@ type DNSProviderReplication struct {
 	Enabled bool `json:"enabled"`
 }
 
-func NewDNSExtender(secretName, domainPrefix, dnsProviderType string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+func NewDNSExtender(dnsConfig config.DNSConfig) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-		domain := fmt.Sprintf("%s.%s", runtime.Spec.Shoot.Name, domainPrefix)
+		domainPrefix := dnsConfig.GetDomainPrefix(string(runtime.Spec.Shoot.Purpose))
+		domain := fmt.Sprintf("%s.%s", shoot.Name, domainPrefix)
+		secretName := dnsConfig.SecretName
+		dnsProviderType := dnsConfig.ProviderType
 		isPrimary := true
 
 		shoot.Spec.DNS = &gardener.DNS{
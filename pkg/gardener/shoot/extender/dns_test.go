@@ -4,7 +4,9 @@ import (
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
 	"testing"
 
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,8 +24,12 @@ func TestDNSExtender(t *testing.T) {
 				},
 			},
 		}
-		extender := NewDNSExtender(secretName, domainPrefix, dnsProviderType)
-		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewDNSExtender(config.DNSConfig{
+			SecretName:   secretName,
+			DomainPrefix: domainPrefix,
+			ProviderType: dnsProviderType,
+		})
+		shoot := testutils.FixEmptyGardenerShoot("myshoot", "dev")
 
 		// when
 		err := extender(runtimeShoot, &shoot)
@@ -36,4 +42,47 @@ func TestDNSExtender(t *testing.T) {
 		assert.Equal(t, secretName, *shoot.Spec.DNS.Providers[0].SecretName)                               //nolint:staticcheck
 		assert.Equal(t, true, *shoot.Spec.DNS.Providers[0].Primary)                                        //nolint:staticcheck
 	})
+
+	for tname, tc := range map[string]struct {
+		purpose        gardener.ShootPurpose
+		expectedDomain string
+	}{
+		"Should use the domain prefix configured for the purpose": {
+			purpose:        "production",
+			expectedDomain: "myshoot.prod.mydomain.com",
+		},
+		"Should fall back to the default domain prefix for a purpose without override": {
+			purpose:        "evaluation",
+			expectedDomain: "myshoot.dev.mydomain.com",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtimeShoot := imv1.Runtime{
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						Name:    "myshoot",
+						Purpose: tc.purpose,
+					},
+				},
+			}
+			extender := NewDNSExtender(config.DNSConfig{
+				SecretName:   "my-secret",
+				DomainPrefix: "dev.mydomain.com",
+				ProviderType: "aws-route53",
+				DomainPrefixByPurpose: map[string]string{
+					"production": "prod.mydomain.com",
+				},
+			})
+			shoot := testutils.FixEmptyGardenerShoot("myshoot", "dev")
+
+			// when
+			err := extender(runtimeShoot, &shoot)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDomain, *shoot.Spec.DNS.Domain)
+			assert.Equal(t, []string{tc.expectedDomain}, shoot.Spec.DNS.Providers[0].Domains.Include) //nolint:staticcheck
+		})
+	}
 }
@@ -0,0 +1,34 @@
+package extender
+
+import (
+	"fmt"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaxEventTTL is the longest event TTL accepted by Gardener
+const MaxEventTTL = 7 * 24 * time.Hour
+
+// ExtendWithEventTTL sets the amount of time the kube-apiserver retains events.
+// When not specified in the Runtime, Gardener defaults are used.
+func ExtendWithEventTTL(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	eventTTL := runtime.Spec.Shoot.Kubernetes.KubeAPIServer.EventTTL
+	if eventTTL == nil {
+		return nil
+	}
+
+	if eventTTL.Duration < 0 || eventTTL.Duration > MaxEventTTL {
+		return fmt.Errorf("event TTL %s must be between 0 and %s", eventTTL.Duration, MaxEventTTL)
+	}
+
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{}
+	}
+
+	shoot.Spec.Kubernetes.KubeAPIServer.EventTTL = &metav1.Duration{Duration: eventTTL.Duration}
+
+	return nil
+}
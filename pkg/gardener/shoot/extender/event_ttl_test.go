@@ -0,0 +1,78 @@
+package extender
+
+import (
+	"testing"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventTTLExtender(t *testing.T) {
+	t.Run("Should set event TTL", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAPIServer(imv1.APIServer{
+			EventTTL: &metav1.Duration{Duration: 24 * time.Hour},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithEventTTL(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer)
+		assert.Equal(t, &metav1.Duration{Duration: 24 * time.Hour}, shoot.Spec.Kubernetes.KubeAPIServer.EventTTL)
+	})
+
+	t.Run("Should accept the maximal event TTL", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAPIServer(imv1.APIServer{
+			EventTTL: &metav1.Duration{Duration: MaxEventTTL},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithEventTTL(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &metav1.Duration{Duration: MaxEventTTL}, shoot.Spec.Kubernetes.KubeAPIServer.EventTTL)
+	})
+
+	for name, eventTTL := range map[string]time.Duration{
+		"negative":           -time.Hour,
+		"longer than 7 days": MaxEventTTL + time.Second,
+	} {
+		t.Run("Should return error for "+name+" event TTL", func(t *testing.T) {
+			// given
+			runtime := fixRuntimeWithAPIServer(imv1.APIServer{
+				EventTTL: &metav1.Duration{Duration: eventTTL},
+			})
+			shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+			// when
+			err := ExtendWithEventTTL(runtime, &shoot)
+
+			// then
+			require.Error(t, err)
+			assert.Nil(t, shoot.Spec.Kubernetes.KubeAPIServer)
+		})
+	}
+
+	t.Run("Should leave Gardener default when event TTL is not set", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAPIServer(imv1.APIServer{})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithEventTTL(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeAPIServer)
+	})
+}
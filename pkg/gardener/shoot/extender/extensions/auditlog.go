@@ -10,10 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
-const (
-	AuditlogExtensionType = "shoot-auditlog-service"
-	auditlogReferenceName = "auditlog-credentials"
-)
+const AuditlogExtensionType = "shoot-auditlog-service"
 
 type AuditlogExtensionConfig struct {
 	metav1.TypeMeta `json:",inline"`
@@ -27,7 +24,7 @@ type AuditlogExtensionConfig struct {
 	SecretReferenceName string `json:"secretReferenceName"`
 }
 
-func NewAuditLogExtension(d auditlogs.AuditLogData) (*gardener.Extension, error) {
+func NewAuditLogExtension(d auditlogs.AuditLogData, secretReferenceName string) (*gardener.Extension, error) {
 	cfg := AuditlogExtensionConfig{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "AuditlogConfig",
@@ -36,7 +33,7 @@ func NewAuditLogExtension(d auditlogs.AuditLogData) (*gardener.Extension, error)
 		Type:                "standard",
 		TenantID:            d.TenantID,
 		ServiceURL:          d.ServiceURL,
-		SecretReferenceName: auditlogReferenceName,
+		SecretReferenceName: secretReferenceName,
 	}
 	var buffer bytes.Buffer
 	if err := json.NewEncoder(&buffer).Encode(&cfg); err != nil {
@@ -3,7 +3,10 @@ package extensions
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 )
@@ -89,6 +92,43 @@ func NewDNSExtensionInternal() (*gardener.Extension, error) {
 	return serializedDNSExtension(providerConfig)
 }
 
+// NewDNSEntriesExtension enables the replication of DNS providers in the DNS extension of the shoot when the Runtime opts in to DNS entries,
+// so the shoot-dns-service extension syncs the DNS entries created by the workloads for domains beyond the primary domain.
+// It returns nil when the Runtime does not opt in, which leaves the DNS extension of the shoot unchanged.
+func NewDNSEntriesExtension(dnsEntries *imv1.DNSEntries, shoot gardener.Shoot) (*gardener.Extension, error) {
+	if dnsEntries == nil || !dnsEntries.Enabled {
+		return nil, nil
+	}
+
+	index := slices.IndexFunc(shoot.Spec.Extensions, func(e gardener.Extension) bool {
+		return e.Type == DNSExtensionType
+	})
+
+	if index == -1 {
+		extension, err := NewDNSExtensionInternal()
+		if err != nil {
+			return nil, err
+		}
+
+		return withDNSProviderReplication(extension)
+	}
+
+	return withDNSProviderReplication(&shoot.Spec.Extensions[index])
+}
+
+func withDNSProviderReplication(extension *gardener.Extension) (*gardener.Extension, error) {
+	var providerConfig DNSExtensionProviderConfig
+	if extension.ProviderConfig != nil {
+		if err := json.Unmarshal(extension.ProviderConfig.Raw, &providerConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	providerConfig.DNSProviderReplication = &DNSProviderReplication{Enabled: true}
+
+	return serializedDNSExtension(&providerConfig)
+}
+
 func serializedDNSExtension(providerConfig *DNSExtensionProviderConfig) (*gardener.Extension, error) {
 	extensionJSON, err := json.Marshal(providerConfig)
 	if err != nil {
@@ -3,8 +3,10 @@ package extensions
 import (
 	"encoding/json"
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
 	"testing"
 )
 
@@ -94,3 +96,79 @@ func verifyLocalDNSExtension(t *testing.T, ext *gardener.Extension) {
 
 	require.Len(t, dnsConfig.Providers, 0)
 }
+
+func TestDNSEntriesExtension(t *testing.T) {
+	// DNS extension of an existing shoot, without the replication of DNS providers
+	dnsExtension := gardener.Extension{
+		Type: DNSExtensionType,
+		ProviderConfig: &apimachineryruntime.RawExtension{
+			Raw: []byte(`{"apiVersion":"service.dns.extensions.gardener.cloud/v1alpha1","kind":"DNSConfig","providers":[{"secretName":"aws-route53-secret-dev","type":"aws-route53"}],"syncProvidersFromShootSpecDNS":true}`),
+		},
+	}
+
+	for _, testcase := range []struct {
+		name              string
+		dnsEntries        *imv1.DNSEntries
+		shootExtensions   []gardener.Extension
+		expectExtension   bool
+		expectedProviders int
+	}{
+		{
+			name:              "Should enable DNS provider replication in the DNS extension of the shoot when the Runtime opts in",
+			dnsEntries:        &imv1.DNSEntries{Enabled: true},
+			shootExtensions:   []gardener.Extension{dnsExtension},
+			expectExtension:   true,
+			expectedProviders: 1,
+		},
+		{
+			name:            "Should generate DNS extension with DNS provider replication when the shoot has none and the Runtime opts in",
+			dnsEntries:      &imv1.DNSEntries{Enabled: true},
+			expectExtension: true,
+		},
+		{
+			name:            "Should not generate DNS extension when the Runtime opts out",
+			dnsEntries:      &imv1.DNSEntries{Enabled: false},
+			shootExtensions: []gardener.Extension{dnsExtension},
+		},
+		{
+			name:            "Should not generate DNS extension when DNS entries are not configured",
+			shootExtensions: []gardener.Extension{dnsExtension},
+		},
+		{
+			name:       "Should leave the DNS provider replication enabled in the shoot unchanged when the Runtime opts out",
+			dnsEntries: &imv1.DNSEntries{Enabled: false},
+			shootExtensions: []gardener.Extension{{
+				Type: DNSExtensionType,
+				ProviderConfig: &apimachineryruntime.RawExtension{
+					Raw: []byte(`{"apiVersion":"service.dns.extensions.gardener.cloud/v1alpha1","kind":"DNSConfig","dnsProviderReplication":{"enabled":true},"providers":[],"syncProvidersFromShootSpecDNS":true}`),
+				},
+			}},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			shoot := gardener.Shoot{Spec: gardener.ShootSpec{Extensions: testcase.shootExtensions}}
+
+			ext, err := NewDNSEntriesExtension(testcase.dnsEntries, shoot)
+			require.NoError(t, err)
+
+			if !testcase.expectExtension {
+				assert.Nil(t, ext)
+				return
+			}
+
+			require.NotNil(t, ext)
+			assert.Equal(t, DNSExtensionType, ext.Type)
+
+			var dnsConfig DNSExtensionProviderConfig
+			require.NoError(t, json.Unmarshal(ext.ProviderConfig.Raw, &dnsConfig))
+
+			assert.Equal(t, "service.dns.extensions.gardener.cloud/v1alpha1", dnsConfig.APIVersion)
+			assert.Equal(t, "DNSConfig", dnsConfig.Kind)
+			require.NotNil(t, dnsConfig.DNSProviderReplication)
+			assert.True(t, dnsConfig.DNSProviderReplication.Enabled)
+			require.NotNil(t, dnsConfig.SyncProvidersFromShootSpecDNS)
+			assert.True(t, *dnsConfig.SyncProvidersFromShootSpecDNS)
+			assert.Len(t, dnsConfig.Providers, testcase.expectedProviders)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package extensions
 import (
 	"encoding/json"
 	"slices"
+	"strings"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
@@ -33,11 +34,20 @@ func NewExtensionsExtenderForCreate(config config.ConverterConfig, auditLogData
 		},
 		{
 			Type: DNSExtensionType,
-			Create: func(_ imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
+			Create: func(runtime imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
+				var extension *gardener.Extension
+				var err error
 				if config.DNS.IsGardenerInternal() {
-					return NewDNSExtensionInternal()
+					extension, err = NewDNSExtensionInternal()
+				} else {
+					domainPrefix := config.DNS.GetDomainPrefix(string(runtime.Spec.Shoot.Purpose))
+					extension, err = NewDNSExtensionExternal(shoot.Name, config.DNS.SecretName, domainPrefix, config.DNS.ProviderType)
+				}
+				if err != nil || runtime.Spec.Shoot.DNSEntries == nil || !runtime.Spec.Shoot.DNSEntries.Enabled {
+					return extension, err
 				}
-				return NewDNSExtensionExternal(shoot.Name, config.DNS.SecretName, config.DNS.DomainPrefix, config.DNS.ProviderType)
+
+				return withDNSProviderReplication(extension)
 			},
 		},
 		{
@@ -53,7 +63,7 @@ func NewExtensionsExtenderForCreate(config config.ConverterConfig, auditLogData
 					return nil, nil
 				}
 
-				return NewAuditLogExtension(auditLogData)
+				return NewAuditLogExtension(auditLogData, config.AuditLog.GetSecretReferenceName())
 			},
 		},
 		{
@@ -69,7 +79,7 @@ func NewExtensionsExtenderForCreate(config config.ConverterConfig, auditLogData
 	}, nil)
 }
 
-func NewExtensionsExtenderForPatch(auditLogData auditlogs.AuditLogData, extensionsOnTheShoot []gardener.Extension) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+func NewExtensionsExtenderForPatch(auditLogData auditlogs.AuditLogData, auditLogSecretReferenceName string, extensionsOnTheShoot []gardener.Extension) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 	return newExtensionsExtender([]Extension{
 		{
 			AuditlogExtensionType,
@@ -78,7 +88,7 @@ func NewExtensionsExtenderForPatch(auditLogData auditlogs.AuditLogData, extensio
 					return nil, nil
 				}
 
-				newAuditLogExtension, err := NewAuditLogExtension(auditLogData)
+				newAuditLogExtension, err := NewAuditLogExtension(auditLogData, auditLogSecretReferenceName)
 				if err != nil {
 					return nil, err
 				}
@@ -113,6 +123,12 @@ func NewExtensionsExtenderForPatch(auditLogData auditlogs.AuditLogData, extensio
 				return NewNetworkFilterExtension(runtime.Spec.Security.Networking.Filter)
 			},
 		},
+		{
+			Type: DNSExtensionType,
+			Create: func(runtime imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
+				return NewDNSEntriesExtension(runtime.Spec.Shoot.DNSEntries, shoot)
+			},
+		},
 		{
 			Type: RegistryCacheExtensionType,
 			Create: func(runtime imv1.Runtime, shoot gardener.Shoot) (*gardener.Extension, error) {
@@ -163,3 +179,29 @@ func newExtensionsExtender(extensionsToApply []Extension, currentGardenerExtensi
 		return nil
 	}
 }
+
+// ExtendWithNormalizedExtensions de-duplicates shoot extensions by type and sorts them, so that repeated conversions produce the same output.
+// It must be the last extender touching shoot extensions. When a type occurs more than once, the last extension wins.
+func ExtendWithNormalizedExtensions(_ imv1.Runtime, shoot *gardener.Shoot) error {
+	if len(shoot.Spec.Extensions) == 0 {
+		return nil
+	}
+
+	extensionsByType := make(map[string]gardener.Extension, len(shoot.Spec.Extensions))
+	for _, extension := range shoot.Spec.Extensions {
+		extensionsByType[extension.Type] = extension
+	}
+
+	normalized := make([]gardener.Extension, 0, len(extensionsByType))
+	for _, extension := range extensionsByType {
+		normalized = append(normalized, extension)
+	}
+
+	slices.SortFunc(normalized, func(a, b gardener.Extension) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+
+	shoot.Spec.Extensions = normalized
+
+	return nil
+}
@@ -268,7 +268,7 @@ func TestNewExtensionsExtenderForPatch(t *testing.T) {
 			auditLogDataProvided := testCase.inputAuditLogData != (auditlogs.AuditLogData{})
 			registryCacheDataProvided := len(testCase.registryCaches) != 0
 
-			extender := NewExtensionsExtenderForPatch(testCase.inputAuditLogData, testCase.previousExtensions)
+			extender := NewExtensionsExtenderForPatch(testCase.inputAuditLogData, config.DefaultAuditLogSecretReferenceName, testCase.previousExtensions)
 			orderMap := getExpectedExtensionsOrderMapForPatch(testCase.previousExtensions, testCase.enableNetworkFilter, auditLogDataProvided, registryCacheDataProvided)
 
 			err := extender(runtime, shoot)
@@ -304,6 +304,79 @@ func TestNewExtensionsExtenderForPatch(t *testing.T) {
 	}
 }
 
+func TestExtendWithNormalizedExtensions(t *testing.T) {
+	t.Run("Should de-duplicate extensions by type keeping the last provider config", func(t *testing.T) {
+		// given
+		updatedRegistryCache := fixRegistryCacheExtension()
+		updatedRegistryCache.ProviderConfig = &runtime.RawExtension{
+			Raw: []byte(`{"apiVersion":"registry.extensions.gardener.cloud/v1alpha3","kind":"RegistryConfig","caches":[{"upstream":"gcr.io"}]}`),
+		}
+		shoot := fixShootForExtensionsExtenderTests([]gardener.Extension{fixRegistryCacheExtension(), fixNetworkExtension(), updatedRegistryCache})
+
+		// when
+		err := ExtendWithNormalizedExtensions(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []gardener.Extension{updatedRegistryCache, fixNetworkExtension()}, shoot.Spec.Extensions)
+	})
+
+	t.Run("Should produce stable extensions across two patch conversions", func(t *testing.T) {
+		// given
+		auditLogData := auditlogs.AuditLogData{
+			TenantID:   "test-auditlog-new-tenant",
+			ServiceURL: "test-auditlog-new-service",
+			SecretName: "doesnt matter",
+		}
+		runtimeCR := fixRuntimeCRForExtensionExtenderTests(true, nil)
+		convert := func(extensionsOnTheShoot []gardener.Extension) []gardener.Extension {
+			shoot := fixShootForExtensionsExtenderTests(nil)
+
+			err := NewExtensionsExtenderForPatch(auditLogData, config.DefaultAuditLogSecretReferenceName, extensionsOnTheShoot)(runtimeCR, &shoot)
+			require.NoError(t, err)
+			err = ExtendWithNormalizedExtensions(runtimeCR, &shoot)
+			require.NoError(t, err)
+
+			return shoot.Spec.Extensions
+		}
+
+		// when
+		firstConversion := convert(fixAllExtensionsOnTheShoot())
+		secondConversion := convert(firstConversion)
+
+		// then
+		assert.Equal(t, firstConversion, secondConversion)
+		assert.Len(t, firstConversion, len(fixAllExtensionsOnTheShoot()))
+		assert.IsNonDecreasing(t, extensionTypes(firstConversion))
+
+		for _, ext := range firstConversion {
+			if ext.Type == AuditlogExtensionType {
+				verifyAuditLogExtension(t, ext, auditLogData)
+			}
+		}
+	})
+}
+
+func fixShootForExtensionsExtenderTests(extensions []gardener.Extension) gardener.Shoot {
+	return gardener.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-shoot",
+			Namespace: "garden-test",
+		},
+		Spec: gardener.ShootSpec{
+			Extensions: extensions,
+		},
+	}
+}
+
+func extensionTypes(extensions []gardener.Extension) []string {
+	types := make([]string, 0, len(extensions))
+	for _, ext := range extensions {
+		types = append(types, ext.Type)
+	}
+	return types
+}
+
 func fixAllExtensionsOnTheShoot() []gardener.Extension {
 	return []gardener.Extension{
 		fixAuditLogExtensions(),
@@ -432,7 +505,7 @@ func verifyAuditLogExtension(t *testing.T, ext gardener.Extension, expected audi
 	assert.Equal(t, "standard", auditlogConfig.Type)
 	assert.Equal(t, expected.TenantID, auditlogConfig.TenantID)
 	assert.Equal(t, expected.ServiceURL, auditlogConfig.ServiceURL)
-	assert.Equal(t, auditlogReferenceName, auditlogConfig.SecretReferenceName)
+	assert.Equal(t, config.DefaultAuditLogSecretReferenceName, auditlogConfig.SecretReferenceName)
 	assert.Equal(t, "service.auditlog.extensions.gardener.cloud/v1alpha1", auditlogConfig.APIVersion)
 	assert.Equal(t, "AuditlogConfig", auditlogConfig.Kind)
 }
@@ -0,0 +1,22 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+)
+
+// NewFeatureFlagExtender runs the experimental extender only for Runtimes which enable the given feature flag.
+// It allows rolling out new extenders gradually, Runtime by Runtime.
+func NewFeatureFlagExtender(featureFlag string, extend func(runtime imv1.Runtime, shoot *gardener.Shoot) error) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		if !IsFeatureFlagEnabled(runtime, featureFlag) {
+			return nil
+		}
+
+		return extend(runtime, shoot)
+	}
+}
+
+func IsFeatureFlagEnabled(runtime imv1.Runtime, featureFlag string) bool {
+	return runtime.Spec.Shoot.FeatureFlags[featureFlag]
+}
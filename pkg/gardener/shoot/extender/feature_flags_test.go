@@ -0,0 +1,85 @@
+package extender
+
+import (
+	"errors"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureFlagExtender(t *testing.T) {
+	const featureFlag = "experimental-extender"
+
+	for _, testCase := range []struct {
+		name          string
+		featureFlags  map[string]bool
+		expectedToRun bool
+	}{
+		{
+			name:          "Should run experimental extender when its feature flag is enabled",
+			featureFlags:  map[string]bool{featureFlag: true},
+			expectedToRun: true,
+		},
+		{
+			name:          "Should skip experimental extender when its feature flag is disabled",
+			featureFlags:  map[string]bool{featureFlag: false},
+			expectedToRun: false,
+		},
+		{
+			name:          "Should skip experimental extender when only other feature flags are enabled",
+			featureFlags:  map[string]bool{"other-extender": true},
+			expectedToRun: false,
+		},
+		{
+			name:          "Should skip experimental extender when no feature flags are set",
+			featureFlags:  nil,
+			expectedToRun: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			runtime := fixRuntimeWithFeatureFlags(testCase.featureFlags)
+			shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+			extenderRun := false
+
+			// when
+			err := NewFeatureFlagExtender(featureFlag, func(_ imv1.Runtime, _ *gardener.Shoot) error {
+				extenderRun = true
+				return nil
+			})(runtime, &shoot)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedToRun, extenderRun)
+		})
+	}
+
+	t.Run("Should return error of the experimental extender", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithFeatureFlags(map[string]bool{featureFlag: true})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewFeatureFlagExtender(featureFlag, func(_ imv1.Runtime, _ *gardener.Shoot) error {
+			return errors.New("experimental extender failed")
+		})(runtime, &shoot)
+
+		// then
+		require.EqualError(t, err, "experimental extender failed")
+	})
+}
+
+func fixRuntimeWithFeatureFlags(featureFlags map[string]bool) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name:         "shoot",
+				FeatureFlags: featureFlags,
+			},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+// ExtendWithKubeAPIServerLogging sets the log verbosity of the kube-apiserver.
+// When not specified in the Runtime the logging configuration is cleared, so the Gardener defaults are restored on patch.
+// It must run after the OIDC extender which initialises the kube-apiserver configuration.
+func ExtendWithKubeAPIServerLogging(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	logging := runtime.Spec.Shoot.Kubernetes.KubeAPIServer.Logging
+	if logging == nil || (logging.Verbosity == nil && logging.HTTPAccessVerbosity == nil) {
+		if shoot.Spec.Kubernetes.KubeAPIServer != nil {
+			shoot.Spec.Kubernetes.KubeAPIServer.Logging = nil
+		}
+		return nil
+	}
+
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{}
+	}
+
+	shoot.Spec.Kubernetes.KubeAPIServer.Logging = &gardener.APIServerLogging{}
+	if logging.Verbosity != nil {
+		shoot.Spec.Kubernetes.KubeAPIServer.Logging.Verbosity = ptr.To(*logging.Verbosity)
+	}
+	if logging.HTTPAccessVerbosity != nil {
+		shoot.Spec.Kubernetes.KubeAPIServer.Logging.HTTPAccessVerbosity = ptr.To(*logging.HTTPAccessVerbosity)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestKubeAPIServerLoggingExtender(t *testing.T) {
+	t.Run("Should set explicit kube-apiserver log verbosity", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAPIServer(imv1.APIServer{
+			Logging: &imv1.APIServerLogging{
+				Verbosity:           ptr.To(int32(5)),
+				HTTPAccessVerbosity: ptr.To(int32(3)),
+			},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewOidcExtender(gardener.OIDCConfig{})(runtime, &shoot)
+		require.NoError(t, err)
+		err = ExtendWithKubeAPIServerLogging(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.APIServerLogging{
+			Verbosity:           ptr.To(int32(5)),
+			HTTPAccessVerbosity: ptr.To(int32(3)),
+		}, shoot.Spec.Kubernetes.KubeAPIServer.Logging)
+		assert.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication)
+	})
+
+	t.Run("Should reset kube-apiserver logging to Gardener defaults when not configured", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAPIServer(imv1.APIServer{})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{
+			Logging: &gardener.APIServerLogging{Verbosity: ptr.To(int32(8))},
+		}
+
+		// when
+		err := ExtendWithKubeAPIServerLogging(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeAPIServer.Logging)
+	})
+
+	t.Run("Should not initialise kube-apiserver configuration when logging is not configured", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithAPIServer(imv1.APIServer{Logging: &imv1.APIServerLogging{}})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeAPIServerLogging(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeAPIServer)
+	})
+}
@@ -0,0 +1,42 @@
+package extender
+
+import (
+	"errors"
+	"maps"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExtendWithKubeControllerManager sets the kube-controller-manager configuration when it is specified in the Runtime
+func ExtendWithKubeControllerManager(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	kubeControllerManager := runtime.Spec.Shoot.Kubernetes.KubeControllerManager
+	if kubeControllerManager == nil || (kubeControllerManager.PodEvictionTimeout == nil && kubeControllerManager.NodeMonitorGracePeriod == nil && len(kubeControllerManager.FeatureGates) == 0) {
+		return nil
+	}
+
+	for gate := range kubeControllerManager.FeatureGates {
+		if gate == "" {
+			return errors.New("kube-controller-manager feature gate name must not be empty")
+		}
+	}
+
+	if shoot.Spec.Kubernetes.KubeControllerManager == nil {
+		shoot.Spec.Kubernetes.KubeControllerManager = &gardener.KubeControllerManagerConfig{}
+	}
+
+	if kubeControllerManager.PodEvictionTimeout != nil {
+		shoot.Spec.Kubernetes.KubeControllerManager.PodEvictionTimeout = &metav1.Duration{Duration: kubeControllerManager.PodEvictionTimeout.Duration}
+	}
+
+	if kubeControllerManager.NodeMonitorGracePeriod != nil {
+		shoot.Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod = &metav1.Duration{Duration: kubeControllerManager.NodeMonitorGracePeriod.Duration}
+	}
+
+	if len(kubeControllerManager.FeatureGates) > 0 {
+		shoot.Spec.Kubernetes.KubeControllerManager.FeatureGates = maps.Clone(kubeControllerManager.FeatureGates)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package extender
+
+import (
+	"testing"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKubeControllerManagerExtender(t *testing.T) {
+	t.Run("Should set PodEvictionTimeout", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeControllerManager(&imv1.KubeControllerManager{
+			PodEvictionTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeControllerManager(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeControllerManager)
+		assert.Equal(t, &metav1.Duration{Duration: 5 * time.Minute}, shoot.Spec.Kubernetes.KubeControllerManager.PodEvictionTimeout)
+	})
+
+	t.Run("Should set NodeMonitorGracePeriod", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeControllerManager(&imv1.KubeControllerManager{
+			NodeMonitorGracePeriod: &metav1.Duration{Duration: 2 * time.Minute},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeControllerManager(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeControllerManager)
+		assert.Equal(t, &metav1.Duration{Duration: 2 * time.Minute}, shoot.Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeControllerManager.PodEvictionTimeout)
+	})
+
+	t.Run("Should leave NodeMonitorGracePeriod to the Gardener default when not specified", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeControllerManager(&imv1.KubeControllerManager{
+			PodEvictionTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeControllerManager(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeControllerManager)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod)
+	})
+
+	t.Run("Should set FeatureGates", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeControllerManager(&imv1.KubeControllerManager{
+			FeatureGates: map[string]bool{"SomeFeature": true, "OtherFeature": false},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeControllerManager(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeControllerManager)
+		assert.Equal(t, map[string]bool{"SomeFeature": true, "OtherFeature": false}, shoot.Spec.Kubernetes.KubeControllerManager.FeatureGates)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeControllerManager.PodEvictionTimeout)
+	})
+
+	t.Run("Should not set kube-controller-manager for empty FeatureGates", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeControllerManager(&imv1.KubeControllerManager{
+			FeatureGates: map[string]bool{},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeControllerManager(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeControllerManager)
+	})
+
+	t.Run("Should fail for empty feature gate name", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeControllerManager(&imv1.KubeControllerManager{
+			FeatureGates: map[string]bool{"": true},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeControllerManager(runtime, &shoot)
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("Should not set kube-controller-manager when not specified", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeControllerManager(nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeControllerManager(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeControllerManager)
+	})
+}
+
+func fixRuntimeWithKubeControllerManager(kubeControllerManager *imv1.KubeControllerManager) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Kubernetes: imv1.Kubernetes{
+					KubeControllerManager: kubeControllerManager,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package extender
+
+import (
+	"fmt"
+	"slices"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+// NewKubeProxyExtender sets the kube-proxy configuration when it is specified in the Runtime.
+// Disabling kube-proxy is allowed only for networking types listed in `kubeProxyReplacementTypes`, as otherwise the cluster would have no service routing.
+func NewKubeProxyExtender(kubeProxyReplacementTypes []string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		kubeProxy := runtime.Spec.Shoot.Kubernetes.KubeProxy
+		if kubeProxy == nil || kubeProxy.Enabled == nil {
+			return nil
+		}
+
+		if !*kubeProxy.Enabled {
+			networkingType := ptr.Deref(runtime.Spec.Shoot.Networking.Type, "")
+			if !slices.Contains(kubeProxyReplacementTypes, networkingType) {
+				return fmt.Errorf("kube-proxy cannot be disabled for networking type %q, supported networking types: %v", networkingType, kubeProxyReplacementTypes)
+			}
+		}
+
+		if shoot.Spec.Kubernetes.KubeProxy == nil {
+			shoot.Spec.Kubernetes.KubeProxy = &gardener.KubeProxyConfig{}
+		}
+		shoot.Spec.Kubernetes.KubeProxy.Enabled = ptr.To(*kubeProxy.Enabled)
+
+		return nil
+	}
+}
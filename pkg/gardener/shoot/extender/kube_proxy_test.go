@@ -0,0 +1,95 @@
+package extender
+
+import (
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestKubeProxyExtender(t *testing.T) {
+	kubeProxyReplacementTypes := []string{"cilium"}
+
+	t.Run("Should disable kube-proxy for networking type replacing it", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeProxy(ptr.To("cilium"), &imv1.KubeProxy{Enabled: ptr.To(false)})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewKubeProxyExtender(kubeProxyReplacementTypes)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeProxy)
+		assert.Equal(t, ptr.To(false), shoot.Spec.Kubernetes.KubeProxy.Enabled)
+	})
+
+	t.Run("Should return error when disabling kube-proxy for networking type not replacing it", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeProxy(ptr.To("calico"), &imv1.KubeProxy{Enabled: ptr.To(false)})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewKubeProxyExtender(kubeProxyReplacementTypes)(runtime, &shoot)
+
+		// then
+		require.ErrorContains(t, err, `kube-proxy cannot be disabled for networking type "calico"`)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeProxy)
+	})
+
+	t.Run("Should return error when disabling kube-proxy without networking type", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeProxy(nil, &imv1.KubeProxy{Enabled: ptr.To(false)})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewKubeProxyExtender(kubeProxyReplacementTypes)(runtime, &shoot)
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("Should enable kube-proxy for any networking type", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeProxy(ptr.To("calico"), &imv1.KubeProxy{Enabled: ptr.To(true)})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewKubeProxyExtender(kubeProxyReplacementTypes)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(true), shoot.Spec.Kubernetes.KubeProxy.Enabled)
+	})
+
+	t.Run("Should not set kube-proxy when not specified", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeProxy(ptr.To("calico"), nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewKubeProxyExtender(kubeProxyReplacementTypes)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeProxy)
+	})
+}
+
+func fixRuntimeWithKubeProxy(networkingType *string, kubeProxy *imv1.KubeProxy) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Kubernetes: imv1.Kubernetes{
+					KubeProxy: kubeProxy,
+				},
+				Networking: imv1.Networking{
+					Type: networkingType,
+				},
+			},
+		},
+	}
+}
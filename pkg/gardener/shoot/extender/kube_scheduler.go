@@ -0,0 +1,22 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+// ExtendWithKubeScheduler sets the kube-scheduler configuration when it is specified in the Runtime
+func ExtendWithKubeScheduler(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	kubeScheduler := runtime.Spec.Shoot.Kubernetes.KubeScheduler
+	if kubeScheduler == nil || kubeScheduler.KubeMaxPDVols == nil {
+		return nil
+	}
+
+	if shoot.Spec.Kubernetes.KubeScheduler == nil {
+		shoot.Spec.Kubernetes.KubeScheduler = &gardener.KubeSchedulerConfig{}
+	}
+	shoot.Spec.Kubernetes.KubeScheduler.KubeMaxPDVols = ptr.To(*kubeScheduler.KubeMaxPDVols)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package extender
+
+import (
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestKubeSchedulerExtender(t *testing.T) {
+	t.Run("Should set KubeMaxPDVols", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeScheduler(&imv1.KubeScheduler{KubeMaxPDVols: ptr.To("64")})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeScheduler(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeScheduler)
+		assert.Equal(t, ptr.To("64"), shoot.Spec.Kubernetes.KubeScheduler.KubeMaxPDVols)
+	})
+
+	t.Run("Should not set kube-scheduler when not specified", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeScheduler(nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeScheduler(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeScheduler)
+	})
+
+	t.Run("Should not set kube-scheduler when KubeMaxPDVols is not specified", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeScheduler(&imv1.KubeScheduler{})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithKubeScheduler(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeScheduler)
+	})
+}
+
+func fixRuntimeWithKubeScheduler(kubeScheduler *imv1.KubeScheduler) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Kubernetes: imv1.Kubernetes{
+					KubeScheduler: kubeScheduler,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,119 @@
+package extender
+
+import (
+	"fmt"
+	"math/bits"
+	"net"
+	"slices"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+// defaultNodeCIDRMaskSize is the node CIDR mask size Gardener uses unless the max pods per node need a larger node CIDR
+const defaultNodeCIDRMaskSize = 24
+
+// NewKubeletExtender sets the kubelet image pull settings and the max pods from the Runtime on every worker pool.
+// The max pods of the Runtime take precedence over the max pods of the existing worker pool, which take precedence over defaultMaxPods.
+// defaultMaxPods applies only to worker pools not existing yet, so changing it does not roll the nodes of existing shoots, 0 leaves the Gardener default.
+// Values already set in the kubelet configuration of a worker pool take precedence.
+// The max pods are validated against nodeCIDRMaskSize of the existing shoot, Gardener does not allow changing it once the shoot is created.
+// It must run after the provider extender which sets the shoot workers.
+func NewKubeletExtender(defaultMaxPods int32, existingWorkers []gardener.Worker, nodeCIDRMaskSize *int32) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		kubeletConfig := runtime.Spec.Shoot.Kubernetes.Kubelet
+		if kubeletConfig == nil {
+			kubeletConfig = &imv1.KubeletConfig{}
+		}
+		hasImagePullSettings := kubeletConfig.SerializeImagePulls != nil || kubeletConfig.RegistryPullQPS != nil || kubeletConfig.RegistryBurst != nil
+
+		for i := range shoot.Spec.Provider.Workers {
+			worker := &shoot.Spec.Provider.Workers[i]
+			maxPods := ptr.Deref(kubeletConfig.MaxPods, workerMaxPods(worker.Name, existingWorkers, defaultMaxPods))
+
+			hasKubelet := worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil
+			if !hasKubelet && !hasImagePullSettings && maxPods == 0 {
+				continue
+			}
+
+			if worker.Kubernetes == nil {
+				worker.Kubernetes = &gardener.WorkerKubernetes{}
+			}
+			if worker.Kubernetes.Kubelet == nil {
+				worker.Kubernetes.Kubelet = &gardener.KubeletConfig{}
+			}
+
+			kubelet := worker.Kubernetes.Kubelet
+			if kubelet.SerializeImagePulls == nil && kubeletConfig.SerializeImagePulls != nil {
+				kubelet.SerializeImagePulls = ptr.To(*kubeletConfig.SerializeImagePulls)
+			}
+			if kubelet.RegistryPullQPS == nil && kubeletConfig.RegistryPullQPS != nil {
+				kubelet.RegistryPullQPS = ptr.To(*kubeletConfig.RegistryPullQPS)
+			}
+			if kubelet.RegistryBurst == nil && kubeletConfig.RegistryBurst != nil {
+				kubelet.RegistryBurst = ptr.To(*kubeletConfig.RegistryBurst)
+			}
+			if kubelet.MaxPods == nil && maxPods > 0 {
+				kubelet.MaxPods = ptr.To(maxPods)
+			}
+
+			if kubelet.MaxPods != nil {
+				if err := validateMaxPods(*kubelet.MaxPods, worker.Name, runtime.Spec.Shoot.Networking.Pods, nodeCIDRMaskSize); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// workerMaxPods returns the max pods of the existing worker pool, or defaultMaxPods for a worker pool not existing yet.
+// An existing worker pool without max pods keeps the Gardener default.
+func workerMaxPods(workerName string, existingWorkers []gardener.Worker, defaultMaxPods int32) int32 {
+	index := slices.IndexFunc(existingWorkers, func(w gardener.Worker) bool {
+		return w.Name == workerName
+	})
+	if index == -1 {
+		return defaultMaxPods
+	}
+
+	existingWorker := existingWorkers[index]
+	if existingWorker.Kubernetes == nil || existingWorker.Kubernetes.Kubelet == nil {
+		return 0
+	}
+
+	return ptr.Deref(existingWorker.Kubernetes.Kubelet.MaxPods, 0)
+}
+
+// validateMaxPods checks that the node CIDR of a node with maxPods fits the pods CIDR and the node CIDR mask size of the existing shoot.
+// Gardener sizes the node CIDR for twice as many pod IPs as the max pods, but not smaller than the default node CIDR.
+func validateMaxPods(maxPods int32, workerName, podsCIDR string, existingNodeCIDRMaskSize *int32) error {
+	if maxPods <= 0 {
+		return fmt.Errorf("kubelet maxPods %d of worker pool %s must be positive", maxPods, workerName)
+	}
+
+	requiredNodeCIDRMaskSize := net.IPv4len*8 - bits.Len32(uint32(2*maxPods-1))
+	if existingNodeCIDRMaskSize != nil && int(*existingNodeCIDRMaskSize) > requiredNodeCIDRMaskSize {
+		return fmt.Errorf("kubelet maxPods %d of worker pool %s requires a node CIDR mask size of /%d, which does not fit the node CIDR mask size /%d of the shoot", maxPods, workerName, requiredNodeCIDRMaskSize, *existingNodeCIDRMaskSize)
+	}
+
+	_, podsNetwork, err := net.ParseCIDR(podsCIDR)
+	if err != nil {
+		// the pods CIDR is optional and is validated by Gardener
+		return nil
+	}
+
+	podsMaskSize, addressBits := podsNetwork.Mask.Size()
+	if addressBits != net.IPv4len*8 {
+		return nil
+	}
+
+	nodeCIDRMaskSize := min(defaultNodeCIDRMaskSize, addressBits-bits.Len32(uint32(2*maxPods-1)))
+	if podsMaskSize > nodeCIDRMaskSize {
+		return fmt.Errorf("kubelet maxPods %d of worker pool %s requires a node CIDR mask size of /%d, which does not fit the pods CIDR %s", maxPods, workerName, nodeCIDRMaskSize, podsCIDR)
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestKubeletExtender(t *testing.T) {
+	t.Run("Should set explicit kubelet image pull settings on every worker pool", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubelet(&imv1.KubeletConfig{
+			SerializeImagePulls: ptr.To(false),
+			RegistryPullQPS:     ptr.To(int32(20)),
+			RegistryBurst:       ptr.To(int32(40)),
+		})
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"}, gardener.Worker{Name: "additional"})
+
+		// when
+		err := NewKubeletExtender(0, nil, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		for _, worker := range shoot.Spec.Provider.Workers {
+			require.NotNil(t, worker.Kubernetes)
+			require.NotNil(t, worker.Kubernetes.Kubelet)
+			assert.Equal(t, ptr.To(false), worker.Kubernetes.Kubelet.SerializeImagePulls)
+			assert.Equal(t, ptr.To(int32(20)), worker.Kubernetes.Kubelet.RegistryPullQPS)
+			assert.Equal(t, ptr.To(int32(40)), worker.Kubernetes.Kubelet.RegistryBurst)
+		}
+	})
+
+	t.Run("Should keep values set on the worker pool", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubelet(&imv1.KubeletConfig{
+			SerializeImagePulls: ptr.To(false),
+			RegistryPullQPS:     ptr.To(int32(20)),
+		})
+		shoot := fixShootWithWorkers(gardener.Worker{
+			Name: "worker",
+			Kubernetes: &gardener.WorkerKubernetes{
+				Kubelet: &gardener.KubeletConfig{
+					RegistryPullQPS: ptr.To(int32(50)),
+				},
+			},
+		})
+
+		// when
+		err := NewKubeletExtender(0, nil, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		kubelet := shoot.Spec.Provider.Workers[0].Kubernetes.Kubelet
+		assert.Equal(t, ptr.To(false), kubelet.SerializeImagePulls)
+		assert.Equal(t, ptr.To(int32(50)), kubelet.RegistryPullQPS)
+		assert.Nil(t, kubelet.RegistryBurst)
+	})
+
+	t.Run("Should leave Gardener defaults when kubelet is not configured", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubelet(nil)
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"})
+
+		// when
+		err := NewKubeletExtender(0, nil, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Provider.Workers[0].Kubernetes)
+	})
+
+	t.Run("Should set the default max pods on every worker pool", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeletAndPods(nil, "100.64.0.0/12")
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"}, gardener.Worker{Name: "additional"})
+
+		// when
+		err := NewKubeletExtender(200, nil, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		for _, worker := range shoot.Spec.Provider.Workers {
+			require.NotNil(t, worker.Kubernetes)
+			require.NotNil(t, worker.Kubernetes.Kubelet)
+			assert.Equal(t, ptr.To(int32(200)), worker.Kubernetes.Kubelet.MaxPods)
+		}
+	})
+
+	t.Run("Should prefer the max pods of the Runtime and of the worker pool over the default", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeletAndPods(&imv1.KubeletConfig{MaxPods: ptr.To(int32(150))}, "100.64.0.0/12")
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"}, gardener.Worker{
+			Name: "additional",
+			Kubernetes: &gardener.WorkerKubernetes{
+				Kubelet: &gardener.KubeletConfig{
+					MaxPods: ptr.To(int32(64)),
+				},
+			},
+		})
+
+		// when
+		err := NewKubeletExtender(200, nil, nil)(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(int32(150)), shoot.Spec.Provider.Workers[0].Kubernetes.Kubelet.MaxPods)
+		assert.Equal(t, ptr.To(int32(64)), shoot.Spec.Provider.Workers[1].Kubernetes.Kubelet.MaxPods)
+	})
+
+	t.Run("Should fail when the node CIDR for the max pods does not fit the pods CIDR", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeletAndPods(nil, "100.64.0.0/24")
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"})
+
+		// when
+		err := NewKubeletExtender(250, nil, nil)(runtime, &shoot)
+
+		// then
+		assert.EqualError(t, err, "kubelet maxPods 250 of worker pool worker requires a node CIDR mask size of /23, which does not fit the pods CIDR 100.64.0.0/24")
+	})
+
+	t.Run("Should keep the max pods of existing worker pools and set the default on new ones", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeletAndPods(nil, "100.64.0.0/12")
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"}, gardener.Worker{Name: "gardener-default"}, gardener.Worker{Name: "new"})
+		existingWorkers := []gardener.Worker{
+			fixWorkerWithMaxPods("worker", ptr.To(int32(110))),
+			{Name: "gardener-default"},
+		}
+
+		// when
+		err := NewKubeletExtender(200, existingWorkers, ptr.To(int32(23)))(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(int32(110)), shoot.Spec.Provider.Workers[0].Kubernetes.Kubelet.MaxPods)
+		assert.Nil(t, shoot.Spec.Provider.Workers[1].Kubernetes)
+		assert.Equal(t, ptr.To(int32(200)), shoot.Spec.Provider.Workers[2].Kubernetes.Kubelet.MaxPods)
+	})
+
+	t.Run("Should prefer the max pods of the Runtime over the max pods of existing worker pools", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeletAndPods(&imv1.KubeletConfig{MaxPods: ptr.To(int32(64))}, "100.64.0.0/12")
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"})
+
+		// when
+		err := NewKubeletExtender(200, []gardener.Worker{fixWorkerWithMaxPods("worker", ptr.To(int32(110)))}, ptr.To(int32(24)))(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(int32(64)), shoot.Spec.Provider.Workers[0].Kubernetes.Kubelet.MaxPods)
+	})
+
+	t.Run("Should fail when the max pods do not fit the node CIDR mask size of the shoot", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithKubeletAndPods(nil, "100.64.0.0/12")
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "new"})
+
+		// when
+		err := NewKubeletExtender(200, []gardener.Worker{fixWorkerWithMaxPods("worker", nil)}, ptr.To(int32(24)))(runtime, &shoot)
+
+		// then
+		assert.EqualError(t, err, "kubelet maxPods 200 of worker pool new requires a node CIDR mask size of /23, which does not fit the node CIDR mask size /24 of the shoot")
+	})
+}
+
+func fixRuntimeWithKubelet(kubelet *imv1.KubeletConfig) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name: "shoot",
+				Kubernetes: imv1.Kubernetes{
+					Kubelet: kubelet,
+				},
+			},
+		},
+	}
+}
+
+func fixRuntimeWithKubeletAndPods(kubelet *imv1.KubeletConfig, pods string) imv1.Runtime {
+	runtime := fixRuntimeWithKubelet(kubelet)
+	runtime.Spec.Shoot.Networking.Pods = pods
+
+	return runtime
+}
+
+func fixWorkerWithMaxPods(name string, maxPods *int32) gardener.Worker {
+	return gardener.Worker{
+		Name: name,
+		Kubernetes: &gardener.WorkerKubernetes{
+			Kubelet: &gardener.KubeletConfig{
+				MaxPods: maxPods,
+			},
+		},
+	}
+}
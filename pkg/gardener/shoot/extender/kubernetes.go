@@ -4,17 +4,29 @@ import (
 	"github.com/Masterminds/semver/v3"
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 )
 
 // NewKubernetesExtender creates a new Kubernetes extender function.
 // It sets the Kubernetes version of the Shoot to the version specified in the Runtime.
 // If the version is not specified in the Runtime, it sets the version to the `defaultKubernetesVersion`, set in `converter_config.json`.
+// If `supportedKubernetesVersions` is not empty, the version specified in the Runtime must be one of them; a version without a patch number (e.g. `1.29`) is resolved to the latest supported patch version.
 // If the current Kubernetes version on Shoot is greater than the version determined above, it sets the version to the current Kubernetes version.
-func NewKubernetesExtender(defaultKubernetesVersion, currentKubernetesVersion string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+// An unsupported version of the Runtime is not rejected when the Shoot is already on the same or a greater version, as it would not be applied.
+func NewKubernetesExtender(defaultKubernetesVersion, currentKubernetesVersion string, supportedKubernetesVersions []string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 		kubernetesVersion := runtime.Spec.Shoot.Kubernetes.Version
 		if kubernetesVersion == nil || *kubernetesVersion == "" {
 			kubernetesVersion = &defaultKubernetesVersion
+		} else if len(supportedKubernetesVersions) > 0 {
+			supportedVersion, err := config.ResolveSupportedVersion(*kubernetesVersion, supportedKubernetesVersions)
+			if err != nil {
+				if !isVersionNotGreater(*kubernetesVersion, currentKubernetesVersion) {
+					return err
+				}
+				supportedVersion = currentKubernetesVersion
+			}
+			kubernetesVersion = &supportedVersion
 		}
 
 		shoot.Spec.Kubernetes.Version = *kubernetesVersion
@@ -31,6 +43,35 @@ func NewKubernetesExtender(defaultKubernetesVersion, currentKubernetesVersion st
 	}
 }
 
+// NewKubernetesPatchVersionExtender resolves the Kubernetes version of the Shoot without a patch number (e.g. `1.29`) to the latest of `availableKubernetesVersions` with the same minor version.
+// The available versions are taken from the cloud profile of the Shoot, a version with a patch number is left unchanged.
+func NewKubernetesPatchVersionExtender(availableKubernetesVersions []string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+		if len(availableKubernetesVersions) == 0 || !config.IsMinorVersion(shoot.Spec.Kubernetes.Version) {
+			return nil
+		}
+
+		patchVersion, err := config.ResolveSupportedVersion(shoot.Spec.Kubernetes.Version, availableKubernetesVersions)
+		if err != nil {
+			return err
+		}
+
+		shoot.Spec.Kubernetes.Version = patchVersion
+
+		return nil
+	}
+}
+
+// isVersionNotGreater returns true when the version is the same or lower than the current Kubernetes version of the Shoot
+func isVersionNotGreater(version, currentKubernetesVersion string) bool {
+	if currentKubernetesVersion == "" {
+		return false
+	}
+
+	result, err := CompareVersions(version, currentKubernetesVersion)
+	return err == nil && result <= 0
+}
+
 func CompareVersions(prevVersion, currVersion string) (int, error) {
 	v1, err := semver.NewVersion(prevVersion)
 	if err != nil {
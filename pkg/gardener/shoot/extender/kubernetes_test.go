@@ -17,7 +17,7 @@ func TestKubernetesVersionExtender(t *testing.T) {
 		runtime := imv1.Runtime{}
 
 		// when
-		kubernetesVersionExtender := NewKubernetesExtender("1.99", "1.99")
+		kubernetesVersionExtender := NewKubernetesExtender("1.99", "1.99", nil)
 		err := kubernetesVersionExtender(runtime, &shoot)
 
 		// then
@@ -39,7 +39,7 @@ func TestKubernetesVersionExtender(t *testing.T) {
 		}
 
 		// when
-		kubernetesVersionExtender := NewKubernetesExtender("1.99", "1.88")
+		kubernetesVersionExtender := NewKubernetesExtender("1.99", "1.88", nil)
 		err := kubernetesVersionExtender(runtime, &shoot)
 
 		// then
@@ -61,7 +61,7 @@ func TestKubernetesVersionExtender(t *testing.T) {
 		}
 
 		// when
-		kubernetesVersionExtender := NewKubernetesExtender("1.99.0", "2.0.0")
+		kubernetesVersionExtender := NewKubernetesExtender("1.99.0", "2.0.0", nil)
 		err := kubernetesVersionExtender(runtime, &shoot)
 
 		// then
@@ -83,7 +83,7 @@ func TestKubernetesVersionExtender(t *testing.T) {
 		}
 
 		// when
-		kubernetesVersionExtender := NewKubernetesExtender("1.88", "1.77")
+		kubernetesVersionExtender := NewKubernetesExtender("1.88", "1.77", nil)
 		err := kubernetesVersionExtender(runtime, &shoot)
 
 		// then
@@ -105,7 +105,7 @@ func TestKubernetesVersionExtender(t *testing.T) {
 		}
 
 		// when
-		kubernetesVersionExtender := NewKubernetesExtender("1.88", "1.77")
+		kubernetesVersionExtender := NewKubernetesExtender("1.88", "1.77", nil)
 		err := kubernetesVersionExtender(runtime, &shoot)
 
 		// then
@@ -114,6 +114,190 @@ func TestKubernetesVersionExtender(t *testing.T) {
 	})
 }
 
+func TestKubernetesVersionExtenderWithSupportedVersions(t *testing.T) {
+	supportedVersions := []string{"1.29.4", "1.29.10", "1.30.2"}
+
+	for _, tc := range []struct {
+		name            string
+		version         string
+		expectedVersion string
+		expectedErr     string
+	}{
+		{
+			name:            "Accept supported version",
+			version:         "1.30.2",
+			expectedVersion: "1.30.2",
+		},
+		{
+			name:            "Normalize minor version to the latest supported patch version",
+			version:         "1.29",
+			expectedVersion: "1.29.10",
+		},
+		{
+			name:        "Reject unsupported patch version",
+			version:     "1.29.5",
+			expectedErr: "unsupported Kubernetes version: 1.29.5, supported versions: 1.29.4, 1.29.10, 1.30.2",
+		},
+		{
+			name:        "Reject unsupported minor version",
+			version:     "1.31",
+			expectedErr: "unsupported Kubernetes version: 1.31",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+			runtime := imv1.Runtime{
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						Kubernetes: imv1.Kubernetes{
+							Version: ptr.To(tc.version),
+						},
+					},
+				},
+			}
+
+			// when
+			kubernetesVersionExtender := NewKubernetesExtender("1.30.2", "", supportedVersions)
+			err := kubernetesVersionExtender(runtime, &shoot)
+
+			// then
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedVersion, shoot.Spec.Kubernetes.Version)
+		})
+	}
+
+	for _, tc := range []struct {
+		name                     string
+		version                  string
+		currentKubernetesVersion string
+		expectedVersion          string
+		expectedErr              string
+	}{
+		{
+			name:                     "Keep current version of the Shoot when unsupported version of the Runtime is lower",
+			version:                  "1.28.5",
+			currentKubernetesVersion: "1.30.2",
+			expectedVersion:          "1.30.2",
+		},
+		{
+			name:                     "Keep current version of the Shoot when unsupported version of the Runtime is the same",
+			version:                  "1.29.5",
+			currentKubernetesVersion: "1.29.5",
+			expectedVersion:          "1.29.5",
+		},
+		{
+			name:                     "Keep current version of the Shoot when unsupported minor version of the Runtime is lower",
+			version:                  "1.28",
+			currentKubernetesVersion: "1.29.10",
+			expectedVersion:          "1.29.10",
+		},
+		{
+			name:                     "Reject unsupported version of the Runtime when it is greater than current version of the Shoot",
+			version:                  "1.31.0",
+			currentKubernetesVersion: "1.30.2",
+			expectedErr:              "unsupported Kubernetes version: 1.31.0",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+			runtime := imv1.Runtime{
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						Kubernetes: imv1.Kubernetes{
+							Version: ptr.To(tc.version),
+						},
+					},
+				},
+			}
+
+			// when
+			kubernetesVersionExtender := NewKubernetesExtender("1.30.2", tc.currentKubernetesVersion, supportedVersions)
+			err := kubernetesVersionExtender(runtime, &shoot)
+
+			// then
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedVersion, shoot.Spec.Kubernetes.Version)
+		})
+	}
+
+	t.Run("Do not validate default version", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+
+		// when
+		kubernetesVersionExtender := NewKubernetesExtender("1.28.0", "", supportedVersions)
+		err := kubernetesVersionExtender(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.28.0", shoot.Spec.Kubernetes.Version)
+	})
+}
+
+func TestKubernetesPatchVersionExtender(t *testing.T) {
+	availableVersions := []string{"1.29.2", "1.30.1", "1.29.10", "1.30.0"}
+
+	for tname, tc := range map[string]struct {
+		shootVersion    string
+		expectedVersion string
+		expectedError   string
+	}{
+		"Should resolve minor version to the latest available patch version": {
+			shootVersion:    "1.29",
+			expectedVersion: "1.29.10",
+		},
+		"Should leave patch version unchanged": {
+			shootVersion:    "1.29.2",
+			expectedVersion: "1.29.2",
+		},
+		"Should fail for minor version without available patch version": {
+			shootVersion:  "1.31",
+			expectedError: "unsupported Kubernetes version: 1.31",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+			shoot.Spec.Kubernetes.Version = tc.shootVersion
+
+			// when
+			err := NewKubernetesPatchVersionExtender(availableVersions)(imv1.Runtime{}, &shoot)
+
+			// then
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedVersion, shoot.Spec.Kubernetes.Version)
+		})
+	}
+
+	t.Run("Should leave minor version unchanged without available versions", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+		shoot.Spec.Kubernetes.Version = "1.29"
+
+		// when
+		err := NewKubernetesPatchVersionExtender(nil)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.29", shoot.Spec.Kubernetes.Version)
+	})
+}
+
 func TestCompareVersions(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -0,0 +1,45 @@
+package extender
+
+import (
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExtendWithMachineControllerManagerSettings validates the machine-controller-manager settings of every worker pool taken from the Runtime.
+// The settings are copied to the shoot together with the worker pools, pools without settings use the Gardener defaults.
+// It must run after the provider extender which sets the shoot workers.
+func ExtendWithMachineControllerManagerSettings(_ imv1.Runtime, shoot *gardener.Shoot) error {
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if err := validateMachineControllerManagerSettings(worker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMachineControllerManagerSettings(worker gardener.Worker) error {
+	settings := worker.MachineControllerManagerSettings
+	if settings == nil {
+		return nil
+	}
+
+	for name, timeout := range map[string]*metav1.Duration{
+		"machineDrainTimeout":    settings.MachineDrainTimeout,
+		"machineHealthTimeout":   settings.MachineHealthTimeout,
+		"machineCreationTimeout": settings.MachineCreationTimeout,
+	} {
+		if timeout != nil && timeout.Duration <= 0 {
+			return fmt.Errorf("%s must be positive in worker pool %s, got %s", name, worker.Name, timeout.Duration)
+		}
+	}
+
+	if settings.MaxEvictRetries != nil && *settings.MaxEvictRetries < 0 {
+		return fmt.Errorf("maxEvictRetries must not be negative in worker pool %s, got %d", worker.Name, *settings.MaxEvictRetries)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package extender
+
+import (
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestMachineControllerManagerSettingsExtender(t *testing.T) {
+	t.Run("Should keep explicit settings of the worker pool", func(t *testing.T) {
+		// given
+		settings := &gardener.MachineControllerManagerSettings{
+			MachineDrainTimeout:  &metav1.Duration{Duration: 30 * time.Minute},
+			MachineHealthTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+			MaxEvictRetries:      ptr.To(int32(20)),
+		}
+		shoot := fixShootWithWorkers(
+			gardener.Worker{Name: "worker", MachineControllerManagerSettings: settings},
+			gardener.Worker{Name: "without-settings"},
+		)
+
+		// when
+		err := ExtendWithMachineControllerManagerSettings(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, settings, shoot.Spec.Provider.Workers[0].MachineControllerManagerSettings)
+		assert.Nil(t, shoot.Spec.Provider.Workers[1].MachineControllerManagerSettings)
+	})
+
+	for _, tc := range []struct {
+		name        string
+		settings    *gardener.MachineControllerManagerSettings
+		expectedErr string
+	}{
+		{
+			name:        "Should return error for non-positive drain timeout",
+			settings:    &gardener.MachineControllerManagerSettings{MachineDrainTimeout: &metav1.Duration{}},
+			expectedErr: "machineDrainTimeout must be positive in worker pool worker, got 0s",
+		},
+		{
+			name:        "Should return error for negative health timeout",
+			settings:    &gardener.MachineControllerManagerSettings{MachineHealthTimeout: &metav1.Duration{Duration: -time.Minute}},
+			expectedErr: "machineHealthTimeout must be positive in worker pool worker, got -1m0s",
+		},
+		{
+			name:        "Should return error for negative max evict retries",
+			settings:    &gardener.MachineControllerManagerSettings{MaxEvictRetries: ptr.To(int32(-1))},
+			expectedErr: "maxEvictRetries must not be negative in worker pool worker, got -1",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			shoot := fixShootWithWorkers(gardener.Worker{Name: "worker", MachineControllerManagerSettings: tc.settings})
+
+			// when
+			err := ExtendWithMachineControllerManagerSettings(imv1.Runtime{}, &shoot)
+
+			// then
+			require.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
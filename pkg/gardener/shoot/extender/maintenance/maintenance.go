@@ -6,11 +6,18 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+// NewMaintenanceExtender sets the automatic updates and the time window of the shoot maintenance.
+// The automatic Kubernetes version updates are enabled or disabled for the Runtime when it pre-approves or blocks forced version updates.
 func NewMaintenanceExtender(enableKubernetesVersionAutoUpdate, enableMachineImageVersionAutoUpdate bool, maintenanceTimeWindow *gardener.MaintenanceTimeWindow) func(runtime imv1.Runtime, shoot *gardener.Shoot) error { //nolint:revive
 	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error { //nolint:revive
+		kubernetesVersionAutoUpdate := enableKubernetesVersionAutoUpdate
+		if forcedUpdate := runtime.Spec.Shoot.Kubernetes.ForcedUpdate; forcedUpdate != nil {
+			kubernetesVersionAutoUpdate = *forcedUpdate == imv1.ForcedVersionUpdateApproved
+		}
+
 		shoot.Spec.Maintenance = &gardener.Maintenance{
 			AutoUpdate: &gardener.MaintenanceAutoUpdate{
-				KubernetesVersion:   enableKubernetesVersionAutoUpdate,
+				KubernetesVersion:   kubernetesVersionAutoUpdate,
 				MachineImageVersion: ptr.To(enableMachineImageVersionAutoUpdate),
 			},
 		}
@@ -7,6 +7,7 @@ import (
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
 )
 
 func TestMaintenanceExtender(t *testing.T) {
@@ -72,3 +73,53 @@ func TestMaintenanceExtender(t *testing.T) {
 		})
 	}
 }
+
+func TestMaintenanceExtenderForcedUpdate(t *testing.T) {
+	for _, testCase := range []struct {
+		name                              string
+		enableKubernetesVersionAutoUpdate bool
+		forcedUpdate                      *imv1.ForcedVersionUpdate
+		expectedKubernetesAutoUpdate      bool
+	}{
+		{
+			name:                              "Should enable Kubernetes version auto-update when forced update is approved",
+			enableKubernetesVersionAutoUpdate: false,
+			forcedUpdate:                      ptr.To(imv1.ForcedVersionUpdateApproved),
+			expectedKubernetesAutoUpdate:      true,
+		},
+		{
+			name:                              "Should disable Kubernetes version auto-update when forced update is blocked",
+			enableKubernetesVersionAutoUpdate: true,
+			forcedUpdate:                      ptr.To(imv1.ForcedVersionUpdateBlocked),
+			expectedKubernetesAutoUpdate:      false,
+		},
+		{
+			name:                              "Should use the configured Kubernetes version auto-update when forced update is not set",
+			enableKubernetesVersionAutoUpdate: true,
+			expectedKubernetesAutoUpdate:      true,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+			runtimeShoot := imv1.Runtime{
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						Name: "test",
+						Kubernetes: imv1.Kubernetes{
+							ForcedUpdate: testCase.forcedUpdate,
+						},
+					},
+				},
+			}
+
+			// when
+			extender := NewMaintenanceExtender(testCase.enableKubernetesVersionAutoUpdate, true, nil)
+			err := extender(runtimeShoot, &shoot)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedKubernetesAutoUpdate, shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package extender
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	networkingTypeCilium = "cilium"
+	networkingTypeCalico = "calico"
+
+	networkConfigKind = "NetworkConfig"
+)
+
+// networkConfigAPIVersions lists the API versions of the network config accepted for every networking type
+var networkConfigAPIVersions = map[string]string{
+	networkingTypeCilium: "cilium.networking.extensions.gardener.cloud/v1alpha1",
+	networkingTypeCalico: "calico.networking.extensions.gardener.cloud/v1alpha1",
+}
+
+// ciliumNetworkConfig contains the fields of the Cilium network config validated before they are passed to the Shoot
+type ciliumNetworkConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	Tunnel          *string         `json:"tunnel,omitempty"`
+	MTU             *int            `json:"mtu,omitempty"`
+	Overlay         *networkOverlay `json:"overlay,omitempty"`
+}
+
+// calicoNetworkConfig contains the fields of the Calico network config validated before they are passed to the Shoot
+type calicoNetworkConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	IPv4            *calicoIPv4     `json:"ipv4,omitempty"`
+	VethMTU         *string         `json:"vethMTU,omitempty"`
+	Overlay         *networkOverlay `json:"overlay,omitempty"`
+}
+
+type calicoIPv4 struct {
+	Mode *string `json:"mode,omitempty"`
+}
+
+type networkOverlay struct {
+	Enabled bool `json:"enabled"`
+}
+
+// providersRequiringNodes lists providers for which the infrastructure config is generated from the nodes CIDR
+var providersRequiringNodes = []string{
+	hyperscaler.TypeAWS,
+	hyperscaler.TypeAzure,
+	hyperscaler.TypeGCP,
+	hyperscaler.TypeOpenStack,
+}
+
+// ExtendWithNetworkingNodes sets the nodes CIDR when it is specified in the Runtime, it is required only for some providers
+func ExtendWithNetworkingNodes(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	nodes := runtime.Spec.Shoot.Networking.Nodes
+	if nodes == "" {
+		if slices.Contains(providersRequiringNodes, runtime.Spec.Shoot.Provider.Type) {
+			return fmt.Errorf("networking nodes CIDR is required for provider: %s", runtime.Spec.Shoot.Provider.Type)
+		}
+		return nil
+	}
+
+	if shoot.Spec.Networking == nil {
+		shoot.Spec.Networking = &gardener.Networking{}
+	}
+	shoot.Spec.Networking.Nodes = &nodes
+
+	return nil
+}
+
+// ExtendWithNetworkingProviderConfig passes the network config from the Runtime to the Shoot.
+// The config is validated against the networking type, only Cilium and Calico configs are accepted.
+func ExtendWithNetworkingProviderConfig(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	providerConfig := runtime.Spec.Shoot.Networking.ProviderConfig
+	if providerConfig == nil {
+		return nil
+	}
+
+	networkingType := ptr.Deref(runtime.Spec.Shoot.Networking.Type, "")
+	if err := validateNetworkConfig(networkingType, providerConfig.Raw); err != nil {
+		return fmt.Errorf("invalid networking provider config: %w", err)
+	}
+
+	if shoot.Spec.Networking == nil {
+		shoot.Spec.Networking = &gardener.Networking{}
+	}
+	shoot.Spec.Networking.ProviderConfig = providerConfig.DeepCopy()
+
+	return nil
+}
+
+func validateNetworkConfig(networkingType string, data []byte) error {
+	switch networkingType {
+	case networkingTypeCilium:
+		var config ciliumNetworkConfig
+		if err := decodeNetworkConfig(networkingType, data, &config); err != nil {
+			return err
+		}
+
+		if config.Tunnel != nil && !slices.Contains([]string{"vxlan", "geneve", "disabled"}, *config.Tunnel) {
+			return fmt.Errorf("unsupported tunnel mode %q", *config.Tunnel)
+		}
+
+		if config.MTU != nil && *config.MTU <= 0 {
+			return fmt.Errorf("mtu must be positive, got %d", *config.MTU)
+		}
+	case networkingTypeCalico:
+		var config calicoNetworkConfig
+		if err := decodeNetworkConfig(networkingType, data, &config); err != nil {
+			return err
+		}
+
+		if config.IPv4 != nil && config.IPv4.Mode != nil && !slices.Contains([]string{"Always", "Never", "CrossSubnet"}, *config.IPv4.Mode) {
+			return fmt.Errorf("unsupported IPv4 mode %q", *config.IPv4.Mode)
+		}
+
+		if config.VethMTU != nil {
+			if mtu, err := strconv.Atoi(*config.VethMTU); err != nil || mtu <= 0 {
+				return fmt.Errorf("vethMTU must be a positive number, got %q", *config.VethMTU)
+			}
+		}
+	default:
+		return fmt.Errorf("provider config is not supported for networking type %q", networkingType)
+	}
+
+	return nil
+}
+
+func decodeNetworkConfig(networkingType string, data []byte, config schema.ObjectKind) error {
+	if err := json.Unmarshal(data, config); err != nil {
+		return fmt.Errorf("failed to decode %s network config: %w", networkingType, err)
+	}
+
+	gvk := config.GroupVersionKind()
+	if gvk.GroupVersion().String() != networkConfigAPIVersions[networkingType] || gvk.Kind != networkConfigKind {
+		return fmt.Errorf("expected %s %s for networking type %s, got %s %s", networkConfigAPIVersions[networkingType], networkConfigKind, networkingType, gvk.GroupVersion().String(), gvk.Kind)
+	}
+
+	return nil
+}
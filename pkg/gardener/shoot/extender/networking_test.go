@@ -0,0 +1,149 @@
+package extender
+
+import (
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestNetworkingNodesExtender(t *testing.T) {
+	t.Run("Should set nodes CIDR when specified", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithNetworkingNodes(hyperscaler.TypeAWS, "10.250.0.0/16")
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithNetworkingNodes(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Networking)
+		assert.Equal(t, "10.250.0.0/16", *shoot.Spec.Networking.Nodes)
+	})
+
+	t.Run("Should return error when nodes CIDR is missing for provider requiring it", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithNetworkingNodes(hyperscaler.TypeGCP, "")
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithNetworkingNodes(runtime, &shoot)
+
+		// then
+		require.ErrorContains(t, err, "networking nodes CIDR is required for provider: gcp")
+	})
+
+	t.Run("Should omit nodes CIDR for provider not requiring it", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithNetworkingNodes("alicloud", "")
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithNetworkingNodes(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		if shoot.Spec.Networking != nil {
+			assert.Nil(t, shoot.Spec.Networking.Nodes)
+		}
+	})
+}
+
+func fixRuntimeWithNetworkingNodes(providerType, nodes string) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name: "shoot",
+				Provider: imv1.Provider{
+					Type: providerType,
+				},
+				Networking: imv1.Networking{
+					Nodes: nodes,
+				},
+			},
+		},
+	}
+}
+
+func TestNetworkingProviderConfigExtender(t *testing.T) {
+	for tname, tc := range map[string]struct {
+		networkingType string
+		providerConfig string
+		expectedError  string
+	}{
+		"Should pass Cilium config to the shoot": {
+			networkingType: "cilium",
+			providerConfig: `{"apiVersion":"cilium.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","overlay":{"enabled":false},"tunnel":"disabled","mtu":1460}`,
+		},
+		"Should pass Calico config to the shoot": {
+			networkingType: "calico",
+			providerConfig: `{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","ipv4":{"mode":"Never"},"vethMTU":"1440"}`,
+		},
+		"Should fail for malformed config": {
+			networkingType: "cilium",
+			providerConfig: `{"apiVersion":"cilium.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","mtu":"large"}`,
+			expectedError:  "failed to decode cilium network config",
+		},
+		"Should fail for config of other networking type": {
+			networkingType: "cilium",
+			providerConfig: `{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig"}`,
+			expectedError:  "expected cilium.networking.extensions.gardener.cloud/v1alpha1 NetworkConfig for networking type cilium",
+		},
+		"Should fail for unsupported Cilium tunnel mode": {
+			networkingType: "cilium",
+			providerConfig: `{"apiVersion":"cilium.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","tunnel":"ipip"}`,
+			expectedError:  "unsupported tunnel mode \"ipip\"",
+		},
+		"Should fail for invalid Calico MTU": {
+			networkingType: "calico",
+			providerConfig: `{"apiVersion":"calico.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig","vethMTU":"-1"}`,
+			expectedError:  "vethMTU must be a positive number",
+		},
+		"Should fail without networking type": {
+			providerConfig: `{"apiVersion":"cilium.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig"}`,
+			expectedError:  "provider config is not supported for networking type \"\"",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtimeShoot := imv1.Runtime{}
+			if tc.networkingType != "" {
+				runtimeShoot.Spec.Shoot.Networking.Type = ptr.To(tc.networkingType)
+			}
+			runtimeShoot.Spec.Shoot.Networking.ProviderConfig = &runtime.RawExtension{Raw: []byte(tc.providerConfig)}
+			shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+			// when
+			err := ExtendWithNetworkingProviderConfig(runtimeShoot, &shoot)
+
+			// then
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, shoot.Spec.Networking)
+			require.NotNil(t, shoot.Spec.Networking.ProviderConfig)
+			assert.JSONEq(t, tc.providerConfig, string(shoot.Spec.Networking.ProviderConfig.Raw))
+		})
+	}
+
+	t.Run("Should leave the shoot unchanged without config", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithNetworkingProviderConfig(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Networking)
+	})
+}
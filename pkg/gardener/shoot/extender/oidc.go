@@ -1,18 +1,34 @@
 package extender
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
+
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/structuredauth"
 )
 
 const (
 	StructuredAuthConfigFmt = "structured-auth-config-%s"
 )
 
-func NewOidcExtender() func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+// NewOidcExtender validates the OIDC configs of the Runtime and points the shoot to the structured authentication config map.
+// The uniqueness of the OIDC clients is checked for the configs written to the config map, which use `defaultOidc` when the Runtime does not set a complete OIDC config.
+func NewOidcExtender(defaultOidc gardener.OIDCConfig) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 
 	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		if err := validateOidcConfigs(runtime.Spec.Shoot.Kubernetes.KubeAPIServer); err != nil {
+			return err
+		}
+
+		appliedOidcConfig := structuredauth.GetOIDCConfigOrDefault(runtime, defaultOidc)
+		if err := validateUniqueOidcClients(appliedOidcConfig, structuredauth.GetAdditionalOIDCConfigs(runtime)); err != nil {
+			return err
+		}
+
 		cmName := fmt.Sprintf(StructuredAuthConfigFmt, runtime.Spec.Shoot.Name)
 
 		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{
@@ -25,3 +41,96 @@ func NewOidcExtender() func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 		return nil
 	}
 }
+
+func validateOidcConfigs(apiServer imv1.APIServer) error {
+	if err := validateOidcConfig(apiServer.OidcConfig); err != nil {
+		return err
+	}
+
+	if apiServer.AdditionalOidcConfig == nil {
+		return nil
+	}
+
+	for _, oidcConfig := range *apiServer.AdditionalOidcConfig {
+		if err := validateOidcConfig(oidcConfig.OIDCConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateUniqueOidcClients checks that every issuer and client ID combination is configured only once
+func validateUniqueOidcClients(oidcConfig gardener.OIDCConfig, additionalOidcConfigs []gardener.OIDCConfig) error {
+	oidcConfigs := append([]gardener.OIDCConfig{oidcConfig}, additionalOidcConfigs...)
+
+	type oidcClient struct {
+		issuerURL string
+		clientID  string
+	}
+
+	oidcClients := map[oidcClient]struct{}{}
+	for _, oidcConfig := range oidcConfigs {
+		if oidcConfig.IssuerURL == nil || oidcConfig.ClientID == nil {
+			continue
+		}
+
+		client := oidcClient{issuerURL: *oidcConfig.IssuerURL, clientID: *oidcConfig.ClientID}
+		if _, found := oidcClients[client]; found {
+			return fmt.Errorf("OIDC client %s of issuer %s is configured more than once", client.clientID, client.issuerURL)
+		}
+		oidcClients[client] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateOidcConfig(oidcConfig gardener.OIDCConfig) error {
+	if oidcConfig.IssuerURL != nil {
+		issuerURL, err := url.Parse(*oidcConfig.IssuerURL)
+		if err != nil || issuerURL.Scheme != "https" || issuerURL.Host == "" {
+			return fmt.Errorf("OIDC issuer URL must use https scheme: %s", *oidcConfig.IssuerURL)
+		}
+	}
+
+	for key, value := range oidcConfig.RequiredClaims {
+		if key == "" {
+			return errors.New("OIDC required claim name must not be empty")
+		}
+
+		if value == "" {
+			return fmt.Errorf("OIDC required claim %s must have a non-empty value", key)
+		}
+	}
+
+	if err := config.ValidateOidcSigningAlgs(oidcConfig.SigningAlgs); err != nil {
+		return err
+	}
+
+	return validateOidcClaims(oidcConfig)
+}
+
+// validateOidcClaims checks that the claim mappings are consistent, as the structured authentication maps the claims and prefixes as they are
+func validateOidcClaims(oidcConfig gardener.OIDCConfig) error {
+	if oidcConfig.UsernameClaim != nil && *oidcConfig.UsernameClaim == "" {
+		return errors.New("OIDC username claim must not be empty")
+	}
+
+	if oidcConfig.GroupsClaim != nil && *oidcConfig.GroupsClaim == "" {
+		return errors.New("OIDC groups claim must not be empty")
+	}
+
+	if oidcConfig.UsernamePrefix != nil && *oidcConfig.UsernamePrefix != "" && oidcConfig.UsernameClaim == nil {
+		return fmt.Errorf("OIDC username prefix %s requires the username claim", *oidcConfig.UsernamePrefix)
+	}
+
+	if oidcConfig.GroupsPrefix != nil && *oidcConfig.GroupsPrefix != "" && oidcConfig.GroupsClaim == nil {
+		return fmt.Errorf("OIDC groups prefix %s requires the groups claim", *oidcConfig.GroupsPrefix)
+	}
+
+	if oidcConfig.GroupsClaim != nil && oidcConfig.UsernameClaim == nil {
+		return fmt.Errorf("OIDC groups claim %s requires the username claim", *oidcConfig.GroupsClaim)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestOidcExtender(t *testing.T) {
@@ -47,7 +48,7 @@ func TestOidcExtender(t *testing.T) {
 		}
 
 		// when
-		extender := NewOidcExtender()
+		extender := NewOidcExtender(gardener.OIDCConfig{})
 		err := extender(runtimeShoot, &shoot)
 
 		// then
@@ -57,4 +58,243 @@ func TestOidcExtender(t *testing.T) {
 		require.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication)
 		assert.Equal(t, "structured-auth-config-shoot", shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication.ConfigMapName)
 	})
+
+	t.Run("OIDC should replace the previous configuration in update scenario", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{
+			OIDCConfig: &gardener.OIDCConfig{ //nolint:staticcheck
+				ClientID:     ptr.To("old-client-id"),
+				IssuerURL:    ptr.To("https://old.tokens.com"),
+				GroupsPrefix: ptr.To("old-prefix-"),
+			},
+		}
+		runtimeShoot := imv1.Runtime{
+			Spec: imv1.RuntimeSpec{
+				Shoot: imv1.RuntimeShoot{
+					Name: "shoot",
+					Kubernetes: imv1.Kubernetes{
+						KubeAPIServer: imv1.APIServer{
+							OidcConfig: gardener.OIDCConfig{
+								ClientID:  &defaultOidc.ClientID,
+								IssuerURL: &defaultOidc.IssuerURL,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		// when
+		extender := NewOidcExtender(gardener.OIDCConfig{})
+		err := extender(runtimeShoot, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		require.Nil(t, shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig) //nolint:staticcheck
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication)
+		assert.Equal(t, "structured-auth-config-shoot", shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication.ConfigMapName)
+	})
+
+	t.Run("OIDC should accept well-formed required claims", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+		runtimeShoot := fixRuntimeWithAdditionalOidcConfig(gardener.OIDCConfig{
+			ClientID:       &defaultOidc.ClientID,
+			IssuerURL:      &defaultOidc.IssuerURL,
+			RequiredClaims: map[string]string{"aud": "kyma", "tenant": "my-tenant"},
+		})
+
+		// when
+		err := NewOidcExtender(gardener.OIDCConfig{})(runtimeShoot, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication)
+	})
+
+	t.Run("OIDC should fail for required claim with empty value", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+		runtimeShoot := fixRuntimeWithAdditionalOidcConfig(gardener.OIDCConfig{
+			ClientID:       &defaultOidc.ClientID,
+			IssuerURL:      &defaultOidc.IssuerURL,
+			RequiredClaims: map[string]string{"tenant": ""},
+		})
+
+		// when
+		err := NewOidcExtender(gardener.OIDCConfig{})(runtimeShoot, &shoot)
+
+		// then
+		require.EqualError(t, err, "OIDC required claim tenant must have a non-empty value")
+	})
+
+	t.Run("OIDC should fail for non-https issuer", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+		issuerURL := "http://my.cool.tokens.com"
+		runtimeShoot := fixRuntimeWithAdditionalOidcConfig(gardener.OIDCConfig{
+			ClientID:  &defaultOidc.ClientID,
+			IssuerURL: &issuerURL,
+		})
+
+		// when
+		err := NewOidcExtender(gardener.OIDCConfig{})(runtimeShoot, &shoot)
+
+		// then
+		require.EqualError(t, err, "OIDC issuer URL must use https scheme: http://my.cool.tokens.com")
+	})
+
+	t.Run("OIDC should accept additional clients of the same issuer", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+		runtimeShoot := fixRuntimeWithAdditionalOidcConfig(gardener.OIDCConfig{
+			ClientID:  ptr.To("additional-client-id"),
+			IssuerURL: &defaultOidc.IssuerURL,
+		})
+		runtimeShoot.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig = gardener.OIDCConfig{
+			ClientID:  &defaultOidc.ClientID,
+			IssuerURL: &defaultOidc.IssuerURL,
+		}
+
+		// when
+		err := NewOidcExtender(gardener.OIDCConfig{})(runtimeShoot, &shoot)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("OIDC should fail for duplicated issuer and client ID", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+		runtimeShoot := fixRuntimeWithAdditionalOidcConfig(gardener.OIDCConfig{
+			ClientID:  &defaultOidc.ClientID,
+			IssuerURL: &defaultOidc.IssuerURL,
+		})
+		runtimeShoot.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig = gardener.OIDCConfig{
+			ClientID:  &defaultOidc.ClientID,
+			IssuerURL: &defaultOidc.IssuerURL,
+		}
+
+		// when
+		err := NewOidcExtender(gardener.OIDCConfig{})(runtimeShoot, &shoot)
+
+		// then
+		require.EqualError(t, err, "OIDC client client-id of issuer https://my.cool.tokens.com is configured more than once")
+	})
+
+	t.Run("OIDC should fail for additional config duplicating the default OIDC config", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+		runtimeShoot := fixRuntimeWithAdditionalOidcConfig(gardener.OIDCConfig{
+			ClientID:  &defaultOidc.ClientID,
+			IssuerURL: &defaultOidc.IssuerURL,
+		})
+
+		// when
+		err := NewOidcExtender(defaultOidc.ToOIDCConfig())(runtimeShoot, &shoot)
+
+		// then
+		require.EqualError(t, err, "OIDC client client-id of issuer https://my.cool.tokens.com is configured more than once")
+	})
+
+	for tname, tc := range map[string]struct {
+		oidcConfig    gardener.OIDCConfig
+		expectedError string
+	}{
+		"OIDC should accept consistent claims and prefixes": {
+			oidcConfig: gardener.OIDCConfig{
+				UsernameClaim:  ptr.To("sub"),
+				UsernamePrefix: ptr.To("-"),
+				GroupsClaim:    ptr.To("groups"),
+				GroupsPrefix:   ptr.To("oidc:"),
+			},
+		},
+		"OIDC should accept the username claim without the groups claim": {
+			oidcConfig: gardener.OIDCConfig{
+				UsernameClaim: ptr.To("email"),
+			},
+		},
+		"OIDC should fail for empty username claim": {
+			oidcConfig: gardener.OIDCConfig{
+				UsernameClaim: ptr.To(""),
+			},
+			expectedError: "OIDC username claim must not be empty",
+		},
+		"OIDC should fail for empty groups claim": {
+			oidcConfig: gardener.OIDCConfig{
+				UsernameClaim: ptr.To("sub"),
+				GroupsClaim:   ptr.To(""),
+			},
+			expectedError: "OIDC groups claim must not be empty",
+		},
+		"OIDC should fail for username prefix without the username claim": {
+			oidcConfig: gardener.OIDCConfig{
+				UsernamePrefix: ptr.To("oidc:"),
+			},
+			expectedError: "OIDC username prefix oidc: requires the username claim",
+		},
+		"OIDC should fail for groups prefix without the groups claim": {
+			oidcConfig: gardener.OIDCConfig{
+				UsernameClaim: ptr.To("sub"),
+				GroupsPrefix:  ptr.To("oidc:"),
+			},
+			expectedError: "OIDC groups prefix oidc: requires the groups claim",
+		},
+		"OIDC should accept explicit signing algorithms": {
+			oidcConfig: gardener.OIDCConfig{
+				UsernameClaim: ptr.To("sub"),
+				SigningAlgs:   []string{"RS256", "ES256"},
+			},
+		},
+		"OIDC should fail for an unsupported signing algorithm": {
+			oidcConfig: gardener.OIDCConfig{
+				UsernameClaim: ptr.To("sub"),
+				SigningAlgs:   []string{"RS256", "HS256"},
+			},
+			expectedError: "OIDC signing algorithm HS256 is not supported, supported algorithms: [RS256 RS384 RS512 ES256 ES384 ES512 PS256 PS384 PS512]",
+		},
+		"OIDC should fail for groups claim without the username claim": {
+			oidcConfig: gardener.OIDCConfig{
+				GroupsClaim: ptr.To("groups"),
+			},
+			expectedError: "OIDC groups claim groups requires the username claim",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+			tc.oidcConfig.ClientID = &defaultOidc.ClientID
+			tc.oidcConfig.IssuerURL = &defaultOidc.IssuerURL
+			runtimeShoot := fixRuntimeWithAdditionalOidcConfig(tc.oidcConfig)
+
+			// when
+			err := NewOidcExtender(gardener.OIDCConfig{})(runtimeShoot, &shoot)
+
+			// then
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func fixRuntimeWithAdditionalOidcConfig(oidcConfig gardener.OIDCConfig) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name: "shoot",
+				Kubernetes: imv1.Kubernetes{
+					KubeAPIServer: imv1.APIServer{
+						AdditionalOidcConfig: &[]imv1.OIDCConfig{
+							{OIDCConfig: oidcConfig},
+						},
+					},
+				},
+			},
+		},
+	}
 }
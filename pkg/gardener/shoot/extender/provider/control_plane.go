@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"encoding/json"
+
+	awsext "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+// NewControlPlaneConfigExtender enriches the ControlPlaneConfig generated by the provider extender with provider-specific settings from the converter config.
+// Shoots of providers without such settings are left untouched.
+func NewControlPlaneConfigExtender(providerConfig config.ProviderConfig) func(rt imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+		if shoot.Spec.Provider.Type != hyperscaler.TypeAWS || isEmptyAWSControlPlaneConfig(providerConfig.AWS.ControlPlane) {
+			return nil
+		}
+
+		controlPlaneConfig := aws.NewControlPlaneConfig()
+		if shoot.Spec.Provider.ControlPlaneConfig != nil && len(shoot.Spec.Provider.ControlPlaneConfig.Raw) > 0 {
+			existingConfig, err := aws.DecodeControlPlaneConfig(shoot.Spec.Provider.ControlPlaneConfig.Raw)
+			if err != nil {
+				return errors.Wrap(err, "failed to decode AWS control plane config")
+			}
+			controlPlaneConfig = existingConfig
+		}
+
+		applyAWSControlPlaneConfig(providerConfig.AWS.ControlPlane, controlPlaneConfig)
+
+		controlPlaneConfigBytes, err := json.Marshal(controlPlaneConfig)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode AWS control plane config")
+		}
+
+		shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: controlPlaneConfigBytes}
+
+		return nil
+	}
+}
+
+func isEmptyAWSControlPlaneConfig(cfg config.AWSControlPlaneConfig) bool {
+	return !cfg.EnableLoadBalancerController && cfg.ManagedDefaultStorageClass == nil && len(cfg.CloudControllerManagerFeatureGates) == 0
+}
+
+func applyAWSControlPlaneConfig(cfg config.AWSControlPlaneConfig, controlPlaneConfig *awsext.ControlPlaneConfig) {
+	if cfg.EnableLoadBalancerController {
+		if controlPlaneConfig.LoadBalancerController == nil {
+			controlPlaneConfig.LoadBalancerController = &awsext.LoadBalancerControllerConfig{}
+		}
+		controlPlaneConfig.LoadBalancerController.Enabled = true
+	}
+
+	if cfg.ManagedDefaultStorageClass != nil {
+		controlPlaneConfig.Storage = &awsext.Storage{
+			ManagedDefaultClass: ptr.To(*cfg.ManagedDefaultStorageClass),
+		}
+	}
+
+	if len(cfg.CloudControllerManagerFeatureGates) > 0 {
+		if controlPlaneConfig.CloudControllerManager == nil {
+			controlPlaneConfig.CloudControllerManager = &awsext.CloudControllerManagerConfig{}
+		}
+		if controlPlaneConfig.CloudControllerManager.FeatureGates == nil {
+			controlPlaneConfig.CloudControllerManager.FeatureGates = map[string]bool{}
+		}
+		for gate, enabled := range cfg.CloudControllerManagerFeatureGates {
+			controlPlaneConfig.CloudControllerManager.FeatureGates[gate] = enabled
+		}
+	}
+}
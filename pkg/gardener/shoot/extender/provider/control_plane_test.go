@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestControlPlaneConfigExtender(t *testing.T) {
+	providerConfig := config.ProviderConfig{
+		AWS: config.AWSConfig{
+			ControlPlane: config.AWSControlPlaneConfig{
+				EnableLoadBalancerController:       true,
+				ManagedDefaultStorageClass:         ptr.To(false),
+				CloudControllerManagerFeatureGates: map[string]bool{"CustomGate": true},
+			},
+		},
+	}
+
+	t.Run("Should emit AWS control plane config", func(t *testing.T) {
+		// given
+		shoot := fixShootForControlPlaneConfigTests(hyperscaler.TypeAWS, fixAWSControlPlaneConfig())
+		extender := NewControlPlaneConfigExtender(providerConfig)
+
+		// when
+		err := extender(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		controlPlaneConfig, err := aws.DecodeControlPlaneConfig(shoot.Spec.Provider.ControlPlaneConfig.Raw)
+		require.NoError(t, err)
+
+		assert.Equal(t, "ControlPlaneConfig", controlPlaneConfig.Kind)
+		require.NotNil(t, controlPlaneConfig.LoadBalancerController)
+		assert.True(t, controlPlaneConfig.LoadBalancerController.Enabled)
+		require.NotNil(t, controlPlaneConfig.Storage)
+		assert.Equal(t, ptr.To(false), controlPlaneConfig.Storage.ManagedDefaultClass)
+		require.NotNil(t, controlPlaneConfig.CloudControllerManager)
+		assert.Equal(t, map[string]bool{"CustomGate": true}, controlPlaneConfig.CloudControllerManager.FeatureGates)
+	})
+
+	t.Run("Should keep existing AWS control plane settings", func(t *testing.T) {
+		// given
+		existingConfig := &runtime.RawExtension{Raw: []byte(`{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","loadBalancerController":{"enabled":false,"ingressClassName":"alb"},"cloudControllerManager":{"featureGates":{"ExistingGate":true}}}`)}
+		shoot := fixShootForControlPlaneConfigTests(hyperscaler.TypeAWS, existingConfig)
+		extender := NewControlPlaneConfigExtender(providerConfig)
+
+		// when
+		err := extender(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		controlPlaneConfig, err := aws.DecodeControlPlaneConfig(shoot.Spec.Provider.ControlPlaneConfig.Raw)
+		require.NoError(t, err)
+
+		assert.True(t, controlPlaneConfig.LoadBalancerController.Enabled)
+		assert.Equal(t, ptr.To("alb"), controlPlaneConfig.LoadBalancerController.IngressClassName)
+		assert.Equal(t, map[string]bool{"ExistingGate": true, "CustomGate": true}, controlPlaneConfig.CloudControllerManager.FeatureGates)
+	})
+
+	t.Run("Should leave AWS control plane config untouched when nothing is configured", func(t *testing.T) {
+		// given
+		controlPlaneConfig := fixAWSControlPlaneConfig()
+		shoot := fixShootForControlPlaneConfigTests(hyperscaler.TypeAWS, controlPlaneConfig)
+		extender := NewControlPlaneConfigExtender(config.ProviderConfig{})
+
+		// when
+		err := extender(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, controlPlaneConfig, shoot.Spec.Provider.ControlPlaneConfig)
+	})
+
+	for _, providerType := range []string{hyperscaler.TypeAzure, hyperscaler.TypeGCP, hyperscaler.TypeOpenStack} {
+		t.Run("Should leave control plane config untouched for "+providerType, func(t *testing.T) {
+			// given
+			controlPlaneConfig := &runtime.RawExtension{Raw: []byte(`{"kind":"ControlPlaneConfig"}`)}
+			shoot := fixShootForControlPlaneConfigTests(providerType, controlPlaneConfig)
+			extender := NewControlPlaneConfigExtender(providerConfig)
+
+			// when
+			err := extender(imv1.Runtime{}, &shoot)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, controlPlaneConfig, shoot.Spec.Provider.ControlPlaneConfig)
+		})
+	}
+
+	t.Run("Should return error for invalid AWS control plane config", func(t *testing.T) {
+		// given
+		shoot := fixShootForControlPlaneConfigTests(hyperscaler.TypeAWS, &runtime.RawExtension{Raw: []byte(`not json`)})
+		extender := NewControlPlaneConfigExtender(providerConfig)
+
+		// when
+		err := extender(imv1.Runtime{}, &shoot)
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func fixShootForControlPlaneConfigTests(providerType string, controlPlaneConfig *runtime.RawExtension) gardener.Shoot {
+	return gardener.Shoot{
+		Spec: gardener.ShootSpec{
+			Provider: gardener.Provider{
+				Type:               providerType,
+				ControlPlaneConfig: controlPlaneConfig,
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/azure"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/gcp"
+	"github.com/pkg/errors"
+)
+
+// getExistingNetworkConfigFuncs returns the config functions placing the shoot in the existing network referenced for the provider of the Runtime
+func getExistingNetworkConfigFuncs(provider imv1.Provider) (InfrastructureProviderFunc, ControlPlaneProviderFunc, error) {
+	existingNetwork := provider.ExistingNetwork
+
+	switch provider.Type {
+	case hyperscaler.TypeAWS:
+		if existingNetwork.AWS == nil || existingNetwork.AWS.VPCID == "" {
+			return nil, nil, errors.New("existing network for provider aws requires the VPC ID")
+		}
+
+		return func(workersCidr string, zones []string) ([]byte, error) {
+			return aws.GetInfrastructureConfigForExistingVPC(workersCidr, zones, existingNetwork.AWS.VPCID)
+		}, aws.GetControlPlaneConfig, nil
+	case hyperscaler.TypeAzure:
+		if existingNetwork.Azure == nil || existingNetwork.Azure.VNetName == "" || existingNetwork.Azure.ResourceGroup == "" {
+			return nil, nil, errors.New("existing network for provider azure requires the VNet name and resource group")
+		}
+
+		return func(workersCidr string, zones []string) ([]byte, error) {
+			return azure.GetInfrastructureConfigForExistingVNet(workersCidr, zones, existingNetwork.Azure.VNetName, existingNetwork.Azure.ResourceGroup)
+		}, azure.GetControlPlaneConfig, nil
+	case hyperscaler.TypeGCP:
+		if existingNetwork.GCP == nil || existingNetwork.GCP.VPCName == "" {
+			return nil, nil, errors.New("existing network for provider gcp requires the VPC name")
+		}
+
+		return func(workersCidr string, zones []string) ([]byte, error) {
+			return gcp.GetInfrastructureConfigForExistingVPC(workersCidr, zones, existingNetwork.GCP.VPCName)
+		}, gcp.GetControlPlaneConfig, nil
+	default:
+		return nil, nil, errors.Errorf("existing network is not supported for provider %s", provider.Type)
+	}
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	gcpext "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/azure"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/gcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+func TestProviderExtenderForCreateWithExistingNetwork(t *testing.T) {
+	t.Run("Should place AWS shoot in the existing VPC", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithExistingNetwork(hyperscaler.TypeAWS, []string{"eu-central-1a", "eu-central-1b"}, &imv1.ExistingNetwork{
+			AWS: &imv1.AWSExistingNetwork{VPCID: "vpc-123456"},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewProviderExtenderForCreateOperation(false, "gardenlinux", "1312.3.0")
+
+		// when
+		err := extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		infrastructureConfig, err := aws.DecodeInfrastructureConfig(shoot.Spec.Provider.InfrastructureConfig.Raw)
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To("vpc-123456"), infrastructureConfig.Networks.VPC.ID)
+		assert.Nil(t, infrastructureConfig.Networks.VPC.CIDR)
+		assert.Len(t, infrastructureConfig.Networks.Zones, 2)
+	})
+
+	t.Run("Should create a new AWS VPC by default", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithExistingNetwork(hyperscaler.TypeAWS, []string{"eu-central-1a"}, nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewProviderExtenderForCreateOperation(false, "gardenlinux", "1312.3.0")
+
+		// when
+		err := extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		infrastructureConfig, err := aws.DecodeInfrastructureConfig(shoot.Spec.Provider.InfrastructureConfig.Raw)
+		require.NoError(t, err)
+		assert.Nil(t, infrastructureConfig.Networks.VPC.ID)
+		assert.Equal(t, ptr.To("10.250.0.0/22"), infrastructureConfig.Networks.VPC.CIDR)
+	})
+
+	t.Run("Should place Azure shoot in the existing VNet", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithExistingNetwork(hyperscaler.TypeAzure, []string{"1", "2"}, &imv1.ExistingNetwork{
+			Azure: &imv1.AzureExistingNetwork{VNetName: "vnet", ResourceGroup: "network-rg"},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewProviderExtenderForCreateOperation(false, "gardenlinux", "1312.3.0")
+
+		// when
+		err := extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		infrastructureConfig, err := azure.DecodeInfrastructureConfig(shoot.Spec.Provider.InfrastructureConfig.Raw)
+		require.NoError(t, err)
+		assert.Equal(t, azure.VNet{Name: ptr.To("vnet"), ResourceGroup: ptr.To("network-rg")}, infrastructureConfig.Networks.VNet)
+	})
+
+	t.Run("Should place GCP shoot in the existing VPC", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithExistingNetwork(hyperscaler.TypeGCP, []string{"europe-west1-b"}, &imv1.ExistingNetwork{
+			GCP: &imv1.GCPExistingNetwork{VPCName: "shared-vpc"},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewProviderExtenderForCreateOperation(false, "gardenlinux", "1312.3.0")
+
+		// when
+		err := extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		var infrastructureConfig gcpext.InfrastructureConfig
+		require.NoError(t, json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infrastructureConfig))
+		require.NotNil(t, infrastructureConfig.Networks.VPC)
+		assert.Equal(t, "shared-vpc", infrastructureConfig.Networks.VPC.Name)
+	})
+
+	for tname, tc := range map[string]struct {
+		providerType    string
+		zones           []string
+		existingNetwork *imv1.ExistingNetwork
+	}{
+		"Should fail when the VPC ID is missing for AWS": {
+			providerType:    hyperscaler.TypeAWS,
+			zones:           []string{"eu-central-1a"},
+			existingNetwork: &imv1.ExistingNetwork{GCP: &imv1.GCPExistingNetwork{VPCName: "shared-vpc"}},
+		},
+		"Should fail when the resource group is missing for Azure": {
+			providerType:    hyperscaler.TypeAzure,
+			zones:           []string{"1"},
+			existingNetwork: &imv1.ExistingNetwork{Azure: &imv1.AzureExistingNetwork{VNetName: "vnet"}},
+		},
+		"Should fail when the VPC name is missing for GCP": {
+			providerType:    hyperscaler.TypeGCP,
+			zones:           []string{"europe-west1-b"},
+			existingNetwork: &imv1.ExistingNetwork{GCP: &imv1.GCPExistingNetwork{}},
+		},
+		"Should fail for OpenStack": {
+			providerType:    hyperscaler.TypeOpenStack,
+			zones:           []string{"eu-de-1a"},
+			existingNetwork: &imv1.ExistingNetwork{},
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := fixRuntimeWithExistingNetwork(tc.providerType, tc.zones, tc.existingNetwork)
+			shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+			extender := NewProviderExtenderForCreateOperation(false, "gardenlinux", "1312.3.0")
+
+			// when
+			err := extender(runtime, &shoot)
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestProviderExtenderForPatchWithExistingNetwork(t *testing.T) {
+	t.Run("Should keep the existing AWS VPC referenced by ID when zones are added", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithExistingNetwork(hyperscaler.TypeAWS, []string{"eu-central-1a", "eu-central-1b"}, &imv1.ExistingNetwork{
+			AWS: &imv1.AWSExistingNetwork{VPCID: "vpc-123456"},
+		})
+		existingInfrastructureConfig, err := aws.GetInfrastructureConfigForExistingVPC("10.250.0.0/22", []string{"eu-central-1a"}, "vpc-123456")
+		require.NoError(t, err)
+		existingControlPlaneConfig, err := aws.GetControlPlaneConfig(nil)
+		require.NoError(t, err)
+
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewProviderExtenderPatchOperation(false, "gardenlinux", "1312.3.0",
+			fixWorkers("main-worker", "m6i.large", "gardenlinux", "1312.2.0", 1, 3, []string{"eu-central-1a"}),
+			&apimachineryRuntime.RawExtension{Raw: existingInfrastructureConfig}, &apimachineryRuntime.RawExtension{Raw: existingControlPlaneConfig})
+
+		// when
+		err = extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		infrastructureConfig, err := aws.DecodeInfrastructureConfig(shoot.Spec.Provider.InfrastructureConfig.Raw)
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To("vpc-123456"), infrastructureConfig.Networks.VPC.ID)
+		assert.Nil(t, infrastructureConfig.Networks.VPC.CIDR)
+		assert.Len(t, infrastructureConfig.Networks.Zones, 2)
+	})
+
+	t.Run("Should keep the existing GCP VPC when zones are added", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithExistingNetwork(hyperscaler.TypeGCP, []string{"europe-west1-b", "europe-west1-c"}, &imv1.ExistingNetwork{
+			GCP: &imv1.GCPExistingNetwork{VPCName: "shared-vpc"},
+		})
+		existingInfrastructureConfig, err := gcp.GetInfrastructureConfigForExistingVPC("10.250.0.0/22", nil, "shared-vpc")
+		require.NoError(t, err)
+		existingControlPlaneConfig, err := gcp.GetControlPlaneConfig([]string{"europe-west1-b"})
+		require.NoError(t, err)
+
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewProviderExtenderPatchOperation(false, "gardenlinux", "1312.3.0",
+			fixWorkers("main-worker", "n2-standard-2", "gardenlinux", "1312.2.0", 1, 3, []string{"europe-west1-b"}),
+			&apimachineryRuntime.RawExtension{Raw: existingInfrastructureConfig}, &apimachineryRuntime.RawExtension{Raw: existingControlPlaneConfig})
+
+		// when
+		err = extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		var infrastructureConfig gcpext.InfrastructureConfig
+		require.NoError(t, json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infrastructureConfig))
+		require.NotNil(t, infrastructureConfig.Networks.VPC)
+		assert.Equal(t, "shared-vpc", infrastructureConfig.Networks.VPC.Name)
+	})
+}
+
+func fixRuntimeWithExistingNetwork(providerType string, zones []string, existingNetwork *imv1.ExistingNetwork) imv1.Runtime {
+	provider := fixProvider(providerType, "gardenlinux", "1312.2.0", zones)
+	provider.ExistingNetwork = existingNetwork
+
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Provider: provider,
+				Networking: imv1.Networking{
+					Nodes: "10.250.0.0/22",
+				},
+			},
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"encoding/json"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
 	"slices"
 	"sort"
@@ -184,6 +185,15 @@ func getConfig(runtimeShoot imv1.RuntimeShoot, zones []string, existingInfrastru
 		return &runtime.RawExtension{Raw: infrastructureConfigBytes}, &runtime.RawExtension{Raw: controlPlaneConfigBytes}, nil
 	}
 
+	if existingInfrastructureConfig == nil && runtimeShoot.Provider.ExistingNetwork != nil {
+		infrastructureConfigFunc, controlPlaneConfigFunc, err := getExistingNetworkConfigFuncs(runtimeShoot.Provider)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return getConfigForProvider(runtimeShoot, infrastructureConfigFunc, controlPlaneConfigFunc)
+	}
+
 	switch runtimeShoot.Provider.Type {
 	case hyperscaler.TypeAWS:
 		{
@@ -206,6 +216,11 @@ func getConfig(runtimeShoot imv1.RuntimeShoot, zones []string, existingInfrastru
 		}
 	case hyperscaler.TypeGCP:
 		{
+			if existingInfrastructureConfig != nil {
+				return getConfigForProvider(runtimeShoot, func(workersCidr string, zones []string) ([]byte, error) {
+					return gcp.GetInfrastructureConfigForPatch(workersCidr, zones, existingInfrastructureConfig)
+				}, gcp.GetControlPlaneConfig)
+			}
 			return getConfigForProvider(runtimeShoot, gcp.GetInfrastructureConfig, gcp.GetControlPlaneConfig)
 		}
 	case hyperscaler.TypeOpenStack:
@@ -217,8 +232,34 @@ func getConfig(runtimeShoot imv1.RuntimeShoot, zones []string, existingInfrastru
 	}
 }
 
-func getAWSWorkerConfig() (*runtime.RawExtension, error) {
-	workerConfigBytes, err := aws.GetWorkerConfig()
+// getAWSWorkerConfig returns the worker config enforcing IMDSv2, the other instance metadata options specified in the Runtime CR are kept
+func getAWSWorkerConfig(providerConfig *runtime.RawExtension) (*runtime.RawExtension, error) {
+	if providerConfig == nil {
+		workerConfigBytes, err := aws.GetWorkerConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		return &runtime.RawExtension{Raw: workerConfigBytes}, nil
+	}
+
+	workerConfig, err := aws.DecodeWorkerConfig(providerConfig.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultInstanceMetadataOptions := aws.NewWorkerConfig().InstanceMetadataOptions
+	if workerConfig.InstanceMetadataOptions == nil {
+		workerConfig.InstanceMetadataOptions = defaultInstanceMetadataOptions
+	}
+
+	// IMDSv2 requires the session tokens, the other instance metadata options of the pool are kept
+	workerConfig.InstanceMetadataOptions.HTTPTokens = defaultInstanceMetadataOptions.HTTPTokens
+	if workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit == nil {
+		workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit = defaultInstanceMetadataOptions.HTTPPutResponseHopLimit
+	}
+
+	workerConfigBytes, err := json.Marshal(workerConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -241,13 +282,17 @@ func getNetworkingZonesFromWorkers(workers []gardener.Worker) []string {
 }
 
 func setWorkerConfig(provider *gardener.Provider, providerType string, enableIMDSv2 bool) error {
+	if err := validateWorkerConfigs(providerType, provider.Workers); err != nil {
+		return err
+	}
+
 	if providerType != hyperscaler.TypeAWS || !enableIMDSv2 {
 		return nil
 	}
 
 	for i := 0; i < len(provider.Workers); i++ {
 		var err error
-		provider.Workers[i].ProviderConfig, err = getAWSWorkerConfig()
+		provider.Workers[i].ProviderConfig, err = getAWSWorkerConfig(provider.Workers[i].ProviderConfig)
 
 		if err != nil {
 			return err
@@ -257,6 +302,38 @@ func setWorkerConfig(provider *gardener.Provider, providerType string, enableIMD
 	return nil
 }
 
+// The provider config of the workers is passed to the shoot as it is, so it is checked against the worker config of the provider
+func validateWorkerConfigs(providerType string, workers []gardener.Worker) error {
+	for _, worker := range workers {
+		if worker.ProviderConfig == nil {
+			continue
+		}
+
+		if err := validateWorkerConfig(providerType, worker.ProviderConfig.Raw); err != nil {
+			return errors.Wrapf(err, "invalid provider config of worker %s", worker.Name)
+		}
+	}
+
+	return nil
+}
+
+func validateWorkerConfig(providerType string, data []byte) error {
+	var err error
+
+	switch providerType {
+	case hyperscaler.TypeAWS:
+		_, err = aws.DecodeWorkerConfig(data)
+	case hyperscaler.TypeAzure:
+		_, err = azure.DecodeWorkerConfig(data)
+	case hyperscaler.TypeGCP:
+		_, err = gcp.DecodeWorkerConfig(data)
+	case hyperscaler.TypeOpenStack:
+		_, err = openstack.DecodeWorkerConfig(data)
+	}
+
+	return err
+}
+
 func setWorkerSettings(provider *gardener.Provider) {
 	provider.WorkersSettings = &gardener.WorkersSettings{
 		SSHAccess: &gardener.SSHAccess{
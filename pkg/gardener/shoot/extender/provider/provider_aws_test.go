@@ -113,6 +113,88 @@ func TestProviderExtenderForCreateAWS(t *testing.T) {
 	}
 }
 
+func TestProviderExtenderWorkerConfigAWS(t *testing.T) {
+	t.Run("Should pass the worker config of the pool to the shoot and keep its instance metadata options", func(t *testing.T) {
+		// given
+		workerConfig := `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","instanceMetadataOptions":{"httpTokens":"optional","httpPutResponseHopLimit":3},"cpuOptions":{"coreCount":2,"threadsPerCore":1}}`
+		runtime := fixRuntimeWithWorkerConfig(hyperscaler.TypeAWS, workerConfig)
+		shoot := testutils.FixEmptyGardenerShoot("cluster", "kcp-system")
+
+		// when
+		extender := NewProviderExtenderForCreateOperation(false, "gardenlinux", "1312.3.0")
+		err := extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		actualConfig, err := aws.DecodeWorkerConfig(shoot.Spec.Provider.Workers[0].ProviderConfig.Raw)
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(awsext.HTTPTokensOptional), actualConfig.InstanceMetadataOptions.HTTPTokens)
+		assert.Equal(t, ptr.To(int64(3)), actualConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit)
+		require.NotNil(t, actualConfig.CpuOptions)
+		assert.Equal(t, ptr.To(int64(2)), actualConfig.CpuOptions.CoreCount)
+	})
+
+	t.Run("Should require the session tokens of IMDSv2 over the instance metadata options of the pool", func(t *testing.T) {
+		// given
+		workerConfig := `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","instanceMetadataOptions":{"httpTokens":"optional","httpPutResponseHopLimit":3},"cpuOptions":{"coreCount":2,"threadsPerCore":1}}`
+		runtime := fixRuntimeWithWorkerConfig(hyperscaler.TypeAWS, workerConfig)
+		shoot := testutils.FixEmptyGardenerShoot("cluster", "kcp-system")
+
+		// when
+		extender := NewProviderExtenderForCreateOperation(true, "gardenlinux", "1312.3.0")
+		err := extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		actualConfig, err := aws.DecodeWorkerConfig(shoot.Spec.Provider.Workers[0].ProviderConfig.Raw)
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(awsext.HTTPTokensRequired), actualConfig.InstanceMetadataOptions.HTTPTokens)
+		assert.Equal(t, ptr.To(int64(3)), actualConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit)
+		require.NotNil(t, actualConfig.CpuOptions)
+		assert.Equal(t, ptr.To(int64(2)), actualConfig.CpuOptions.CoreCount)
+	})
+
+	t.Run("Should enforce IMDSv2 when the worker config of the pool has no instance metadata options", func(t *testing.T) {
+		// given
+		workerConfig := `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","cpuOptions":{"coreCount":2,"threadsPerCore":1}}`
+		runtime := fixRuntimeWithWorkerConfig(hyperscaler.TypeAWS, workerConfig)
+		shoot := testutils.FixEmptyGardenerShoot("cluster", "kcp-system")
+
+		// when
+		extender := NewProviderExtenderForCreateOperation(true, "gardenlinux", "1312.3.0")
+		err := extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+
+		actualConfig, err := aws.DecodeWorkerConfig(shoot.Spec.Provider.Workers[0].ProviderConfig.Raw)
+		require.NoError(t, err)
+		assert.Equal(t, aws.NewWorkerConfig().InstanceMetadataOptions, actualConfig.InstanceMetadataOptions)
+		require.NotNil(t, actualConfig.CpuOptions)
+	})
+
+	for tname, workerConfig := range map[string]string{
+		"Should fail for malformed JSON":       `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig"`,
+		"Should fail for unknown field":        `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"WorkerConfig","minCpuPlatform":"Intel"}`,
+		"Should fail for config of other kind": `{"apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig"}`,
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := fixRuntimeWithWorkerConfig(hyperscaler.TypeAWS, workerConfig)
+			shoot := testutils.FixEmptyGardenerShoot("cluster", "kcp-system")
+
+			// when
+			extender := NewProviderExtenderForCreateOperation(false, "gardenlinux", "1312.3.0")
+			err := extender(runtime, &shoot)
+
+			// then
+			require.ErrorContains(t, err, "invalid provider config of worker worker")
+		})
+	}
+}
+
 func TestProviderExtenderForPatchSingleWorkerAWS(t *testing.T) {
 	// tests of NewProviderExtenderPatch for provider image version patching AWS only operation is provider-agnostic
 	for tname, tc := range map[string]struct {
@@ -312,3 +394,19 @@ func assertProviderSpecificConfigAWS(t *testing.T, shoot gardener.Shoot, expecte
 
 	assert.Equal(t, expectedZonesCount, len(infrastructureConfig.Networks.Zones))
 }
+
+func fixRuntimeWithWorkerConfig(providerType, workerConfig string) imv1.Runtime {
+	provider := fixProvider(providerType, "gardenlinux", "1312.2.0", []string{"eu-central-1a"})
+	provider.Workers[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(workerConfig)}
+
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Provider: provider,
+				Networking: imv1.Networking{
+					Nodes: "10.250.0.0/22",
+				},
+			},
+		},
+	}
+}
@@ -10,6 +10,32 @@ import (
 	"strings"
 )
 
+// ExtendWithNormalizedResources de-duplicates shoot resources by name and sorts them, so that repeated conversions produce the same output.
+// It must be the last extender touching shoot resources. When a name occurs more than once, the last reference wins.
+func ExtendWithNormalizedResources(_ imv1.Runtime, shoot *gardener.Shoot) error {
+	if len(shoot.Spec.Resources) == 0 {
+		return nil
+	}
+
+	resourcesByName := make(map[string]gardener.NamedResourceReference, len(shoot.Spec.Resources))
+	for _, resource := range shoot.Spec.Resources {
+		resourcesByName[resource.Name] = resource
+	}
+
+	normalized := make([]gardener.NamedResourceReference, 0, len(resourcesByName))
+	for _, resource := range resourcesByName {
+		normalized = append(normalized, resource)
+	}
+
+	slices.SortFunc(normalized, func(a, b gardener.NamedResourceReference) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	shoot.Spec.Resources = normalized
+
+	return nil
+}
+
 func NewResourcesExtenderForPatch(resources []gardener.NamedResourceReference) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 	return func(r imv1.Runtime, shoot *gardener.Shoot) error {
 
@@ -76,3 +76,57 @@ func TestNewResourcesExtenderForPatch(t *testing.T) {
 		assert.Contains(t, shoot.Spec.Resources, gardener.NamedResourceReference{Name: "some-other-resource"})
 	})
 }
+
+func TestExtendWithNormalizedResources(t *testing.T) {
+	t.Run("should de-duplicate resources by name and sort them", func(t *testing.T) {
+		// given
+		auditLogResource := gardener.NamedResourceReference{
+			Name: "auditlog-credentials",
+			ResourceRef: v1.CrossVersionObjectReference{
+				Kind:       "Secret",
+				APIVersion: "v1",
+				Name:       "auditlog-secret",
+			},
+		}
+		certResource := gardener.NamedResourceReference{
+			Name: "cert-credentials",
+			ResourceRef: v1.CrossVersionObjectReference{
+				Kind:       "Secret",
+				APIVersion: "v1",
+				Name:       "cert-secret",
+			},
+		}
+		shoot := gardener.Shoot{
+			Spec: gardener.ShootSpec{
+				Resources: []gardener.NamedResourceReference{certResource, auditLogResource, certResource},
+			},
+		}
+
+		// when
+		err := ExtendWithNormalizedResources(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []gardener.NamedResourceReference{auditLogResource, certResource}, shoot.Spec.Resources)
+	})
+
+	t.Run("should keep the last reference for a duplicated name", func(t *testing.T) {
+		// given
+		shoot := gardener.Shoot{
+			Spec: gardener.ShootSpec{
+				Resources: []gardener.NamedResourceReference{
+					{Name: "auditlog-credentials", ResourceRef: v1.CrossVersionObjectReference{Name: "old-secret"}},
+					{Name: "auditlog-credentials", ResourceRef: v1.CrossVersionObjectReference{Name: "new-secret"}},
+				},
+			},
+		}
+
+		// when
+		err := ExtendWithNormalizedResources(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, shoot.Spec.Resources, 1)
+		assert.Equal(t, "new-secret", shoot.Spec.Resources[0].ResourceRef.Name)
+	})
+}
@@ -0,0 +1,32 @@
+package extender
+
+import (
+	"errors"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+// NewSecretBindingExtender sets the secret binding for the Runtimes which do not specify it.
+// An existing shoot keeps its secret binding, the default secret binding is applied only to new shoots.
+func NewSecretBindingExtender(defaultSecretBindingName, shootSecretBindingName string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		secretBindingName := runtime.Spec.Shoot.SecretBindingName
+		if secretBindingName == "" {
+			secretBindingName = shootSecretBindingName
+		}
+
+		if secretBindingName == "" {
+			secretBindingName = defaultSecretBindingName
+		}
+
+		if secretBindingName == "" {
+			return errors.New("secret binding name is neither set in the Runtime nor configured as default")
+		}
+
+		shoot.Spec.SecretBindingName = ptr.To(secretBindingName)
+
+		return nil
+	}
+}
@@ -0,0 +1,69 @@
+package extender
+
+import (
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretBindingExtender(t *testing.T) {
+	for tname, tc := range map[string]struct {
+		secretBindingName         string
+		shootSecretBindingName    string
+		defaultSecretBindingName  string
+		expectedSecretBindingName string
+		expectedError             string
+	}{
+		"Should use the secret binding of the Runtime": {
+			secretBindingName:         "runtime-binding",
+			defaultSecretBindingName:  "default-binding",
+			expectedSecretBindingName: "runtime-binding",
+		},
+		"Should use the secret binding of the Runtime over the secret binding of the existing shoot": {
+			secretBindingName:         "runtime-binding",
+			shootSecretBindingName:    "shoot-binding",
+			defaultSecretBindingName:  "default-binding",
+			expectedSecretBindingName: "runtime-binding",
+		},
+		"Should keep the secret binding of the existing shoot when the Runtime does not specify it": {
+			shootSecretBindingName:    "shoot-binding",
+			defaultSecretBindingName:  "default-binding",
+			expectedSecretBindingName: "shoot-binding",
+		},
+		"Should use the default secret binding when the Runtime does not specify it": {
+			defaultSecretBindingName:  "default-binding",
+			expectedSecretBindingName: "default-binding",
+		},
+		"Should fail when neither the Runtime nor the config specify the secret binding": {
+			expectedError: "secret binding name is neither set in the Runtime nor configured as default",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			shoot := testutils.FixEmptyGardenerShoot("shoot", "kcp-system")
+			runtime := imv1.Runtime{
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						SecretBindingName: tc.secretBindingName,
+					},
+				},
+			}
+
+			// when
+			err := NewSecretBindingExtender(tc.defaultSecretBindingName, tc.shootSecretBindingName)(runtime, &shoot)
+
+			// then
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, shoot.Spec.SecretBindingName)
+			assert.Equal(t, tc.expectedSecretBindingName, *shoot.Spec.SecretBindingName)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package extender
+
+import (
+	"fmt"
+	"net/url"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+)
+
+// ExtendWithServiceAccountConfig sets the service account token issuer configuration of the kube-apiserver.
+// When not specified in the Runtime, Gardener defaults the issuer to the URL of the API server.
+// It must run after the OIDC extender which initialises the kube-apiserver configuration.
+func ExtendWithServiceAccountConfig(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	serviceAccountConfig := runtime.Spec.Shoot.Kubernetes.KubeAPIServer.ServiceAccountConfig
+	if serviceAccountConfig == nil {
+		return nil
+	}
+
+	if serviceAccountConfig.Issuer != nil {
+		if err := validateIssuerURL(*serviceAccountConfig.Issuer); err != nil {
+			return err
+		}
+	}
+
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{}
+	}
+
+	shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &gardener.ServiceAccountConfig{
+		Issuer:          serviceAccountConfig.Issuer,
+		AcceptedIssuers: serviceAccountConfig.AcceptedIssuers,
+	}
+
+	return nil
+}
+
+func validateIssuerURL(issuer string) error {
+	issuerURL, err := url.ParseRequestURI(issuer)
+	if err != nil || issuerURL.Scheme == "" || issuerURL.Host == "" {
+		return fmt.Errorf("service account issuer must be a valid URL: %s", issuer)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestServiceAccountConfigExtender(t *testing.T) {
+	t.Run("Should set custom issuer and accepted issuers", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithServiceAccountConfig(&imv1.ServiceAccountConfig{
+			Issuer:          ptr.To("https://issuer.example.com"),
+			AcceptedIssuers: []string{"https://old-issuer.example.com"},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewOidcExtender(gardener.OIDCConfig{})(runtime, &shoot)
+		require.NoError(t, err)
+		err = ExtendWithServiceAccountConfig(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig)
+		assert.Equal(t, "https://issuer.example.com", *shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig.Issuer)
+		assert.Equal(t, []string{"https://old-issuer.example.com"}, shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig.AcceptedIssuers)
+		assert.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication)
+	})
+
+	t.Run("Should leave the default issuer when not configured", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithServiceAccountConfig(nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := NewOidcExtender(gardener.OIDCConfig{})(runtime, &shoot)
+		require.NoError(t, err)
+		err = ExtendWithServiceAccountConfig(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig)
+	})
+
+	t.Run("Should return error when issuer is not a URL", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithServiceAccountConfig(&imv1.ServiceAccountConfig{
+			Issuer: ptr.To("not-a-url"),
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithServiceAccountConfig(runtime, &shoot)
+
+		// then
+		require.ErrorContains(t, err, "service account issuer must be a valid URL")
+	})
+}
+
+func fixRuntimeWithServiceAccountConfig(serviceAccountConfig *imv1.ServiceAccountConfig) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name: "shoot",
+				Kubernetes: imv1.Kubernetes{
+					KubeAPIServer: imv1.APIServer{
+						ServiceAccountConfig: serviceAccountConfig,
+					},
+				},
+			},
+		},
+	}
+}
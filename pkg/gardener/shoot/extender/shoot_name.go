@@ -0,0 +1,44 @@
+package extender
+
+import (
+	"fmt"
+	"strings"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// maxProjectAndShootNameLength is the limit of Gardener for the length of the project name and the shoot name together
+const maxProjectAndShootNameLength = 21
+
+// NewShootNameExtender sets the shoot name built with the prefix and the suffix of the config.
+// Without the prefix and the suffix the shoot is named after the Runtime shoot name.
+// The name of an existing shoot is kept, so changing the config does not affect the existing shoots.
+func NewShootNameExtender(gardenerConfig config.GardenerConfig, existingShootName string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		if existingShootName != "" {
+			shoot.Name = existingShootName
+			return nil
+		}
+
+		shootName := gardenerConfig.ShootName(runtime.Spec.Shoot.Name)
+		if shootName == runtime.Spec.Shoot.Name {
+			shoot.Name = shootName
+			return nil
+		}
+
+		if validationErrors := validation.IsDNS1123Label(shootName); len(validationErrors) > 0 {
+			return fmt.Errorf("shoot name %s is invalid: %s", shootName, strings.Join(validationErrors, ", "))
+		}
+
+		if len(gardenerConfig.ProjectName)+len(shootName) > maxProjectAndShootNameLength {
+			return fmt.Errorf("the length of the shoot name %s and the project name %s must not exceed %d characters", shootName, gardenerConfig.ProjectName, maxProjectAndShootNameLength)
+		}
+
+		shoot.Name = shootName
+
+		return nil
+	}
+}
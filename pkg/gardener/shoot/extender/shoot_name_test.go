@@ -0,0 +1,66 @@
+package extender
+
+import (
+	"testing"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShootNameExtender(t *testing.T) {
+	for tname, tc := range map[string]struct {
+		gardenerConfig    config.GardenerConfig
+		existingShootName string
+		expectedShootName string
+		expectedError     string
+	}{
+		"Should name the shoot after the Runtime shoot name by default": {
+			gardenerConfig:    config.GardenerConfig{ProjectName: "kyma-dev"},
+			expectedShootName: "c-1234",
+		},
+		"Should add the prefix and the suffix to the shoot name": {
+			gardenerConfig:    config.GardenerConfig{ProjectName: "kyma-dev", ShootNamePrefix: "p-", ShootNameSuffix: "-s"},
+			expectedShootName: "p-c-1234-s",
+		},
+		"Should keep the name of the existing shoot": {
+			gardenerConfig:    config.GardenerConfig{ProjectName: "kyma-dev", ShootNamePrefix: "p-"},
+			existingShootName: "c-1234",
+			expectedShootName: "c-1234",
+		},
+		"Should fail when the shoot name and the project name are too long": {
+			gardenerConfig: config.GardenerConfig{ProjectName: "kyma-dev", ShootNamePrefix: "long-prefix-"},
+			expectedError:  "the length of the shoot name long-prefix-c-1234 and the project name kyma-dev must not exceed 21 characters",
+		},
+		"Should fail when the shoot name is not a DNS label": {
+			gardenerConfig: config.GardenerConfig{ProjectName: "kyma-dev", ShootNamePrefix: "P_"},
+			expectedError:  "shoot name P_c-1234 is invalid",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			shoot := testutils.FixEmptyGardenerShoot("c-1234", "garden-kyma-dev")
+			runtime := imv1.Runtime{
+				Spec: imv1.RuntimeSpec{
+					Shoot: imv1.RuntimeShoot{
+						Name: "c-1234",
+					},
+				},
+			}
+
+			// when
+			err := NewShootNameExtender(tc.gardenerConfig, tc.existingShootName)(runtime, &shoot)
+
+			// then
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedShootName, shoot.Name)
+		})
+	}
+}
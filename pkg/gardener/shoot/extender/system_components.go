@@ -0,0 +1,36 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+// ExtendWithSystemComponents sets the node local DNS configuration when it is specified in the Runtime
+func ExtendWithSystemComponents(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	systemComponents := runtime.Spec.Shoot.SystemComponents
+	if systemComponents == nil || systemComponents.NodeLocalDNS == nil {
+		return nil
+	}
+
+	if shoot.Spec.SystemComponents == nil {
+		shoot.Spec.SystemComponents = &gardener.SystemComponents{}
+	}
+
+	nodeLocalDNS := systemComponents.NodeLocalDNS
+	shoot.Spec.SystemComponents.NodeLocalDNS = &gardener.NodeLocalDNS{
+		Enabled:                     nodeLocalDNS.Enabled,
+		ForceTCPToClusterDNS:        copyBool(nodeLocalDNS.ForceTCPToClusterDNS),
+		ForceTCPToUpstreamDNS:       copyBool(nodeLocalDNS.ForceTCPToUpstreamDNS),
+		DisableForwardToUpstreamDNS: copyBool(nodeLocalDNS.DisableForwardToUpstreamDNS),
+	}
+
+	return nil
+}
+
+func copyBool(value *bool) *bool {
+	if value == nil {
+		return nil
+	}
+	return ptr.To(*value)
+}
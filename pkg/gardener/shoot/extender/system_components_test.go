@@ -0,0 +1,81 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestSystemComponentsExtender(t *testing.T) {
+	t.Run("Should enable node local DNS with options", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithSystemComponents(&imv1.SystemComponents{
+			NodeLocalDNS: &imv1.NodeLocalDNS{
+				Enabled:                     true,
+				ForceTCPToClusterDNS:        ptr.To(false),
+				ForceTCPToUpstreamDNS:       ptr.To(true),
+				DisableForwardToUpstreamDNS: ptr.To(true),
+			},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithSystemComponents(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.SystemComponents)
+		assert.Equal(t, &gardener.NodeLocalDNS{
+			Enabled:                     true,
+			ForceTCPToClusterDNS:        ptr.To(false),
+			ForceTCPToUpstreamDNS:       ptr.To(true),
+			DisableForwardToUpstreamDNS: ptr.To(true),
+		}, shoot.Spec.SystemComponents.NodeLocalDNS)
+	})
+
+	t.Run("Should disable node local DNS", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithSystemComponents(&imv1.SystemComponents{
+			NodeLocalDNS: &imv1.NodeLocalDNS{Enabled: false},
+		})
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		shoot.Spec.SystemComponents = &gardener.SystemComponents{
+			NodeLocalDNS: &gardener.NodeLocalDNS{Enabled: true},
+		}
+
+		// when
+		err := ExtendWithSystemComponents(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.NodeLocalDNS{Enabled: false}, shoot.Spec.SystemComponents.NodeLocalDNS)
+	})
+
+	t.Run("Should not set system components when not specified", func(t *testing.T) {
+		// given
+		runtime := fixRuntimeWithSystemComponents(nil)
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithSystemComponents(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.SystemComponents)
+	})
+}
+
+func fixRuntimeWithSystemComponents(systemComponents *imv1.SystemComponents) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				SystemComponents: systemComponents,
+			},
+		},
+	}
+}
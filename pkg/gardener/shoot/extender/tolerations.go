@@ -1,19 +1,32 @@
 package extender
 
 import (
+	"slices"
+
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"k8s.io/utils/ptr"
 )
 
-func NewTolerationsExtender(config config.TolerationsConfig) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+// NewTolerationsExtender sets the seed tolerations configured for the region of the Runtime, followed by the tolerations
+// configured for its licence type, for example to schedule the shoots of a licence type on dedicated seeds.
+// A licence type toleration with the key of a region toleration is skipped.
+func NewTolerationsExtender(regionTolerations, licenceTypeTolerations config.TolerationsConfig) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
-		for region, tolerations := range config {
-			if runtime.Spec.Shoot.Region == region {
-				shoot.Spec.Tolerations = make([]gardener.Toleration, len(tolerations))
-				copy(shoot.Spec.Tolerations, tolerations)
+		tolerations := slices.Clone(regionTolerations[runtime.Spec.Shoot.Region])
+
+		licenceType := ptr.Deref(runtime.Spec.Shoot.LicenceType, "")
+		for _, toleration := range licenceTypeTolerations[licenceType] {
+			if !slices.ContainsFunc(tolerations, func(existing gardener.Toleration) bool { return existing.Key == toleration.Key }) {
+				tolerations = append(tolerations, toleration)
 			}
 		}
+
+		if tolerations != nil {
+			shoot.Spec.Tolerations = tolerations
+		}
+
 		return nil
 	}
 }
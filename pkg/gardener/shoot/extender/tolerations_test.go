@@ -10,12 +10,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestTolerationsExtender(t *testing.T) {
 	for _, testCase := range []struct {
 		name                string
 		region              string
+		licenceType         *string
 		expectedTolerations []gardener.Toleration
 	}{
 		{
@@ -32,6 +34,35 @@ func TestTolerationsExtender(t *testing.T) {
 			region:              "eu-de-1",
 			expectedTolerations: nil,
 		},
+		{
+			name:        "Should extend Tolerations for a licence type with dedicated seeds",
+			region:      "eu-de-1",
+			licenceType: ptr.To("dedicated"),
+			expectedTolerations: []gardener.Toleration{
+				{
+					Key: "dedicated-seed",
+				},
+			},
+		},
+		{
+			name:        "Should append licence type Tolerations to region Tolerations",
+			region:      "me-central2",
+			licenceType: ptr.To("dedicated"),
+			expectedTolerations: []gardener.Toleration{
+				{
+					Key: "ksa-assured-workload",
+				},
+				{
+					Key: "dedicated-seed",
+				},
+			},
+		},
+		{
+			name:                "Should not extend Tolerations for a licence type without Tolerations",
+			region:              "eu-de-1",
+			licenceType:         ptr.To("trial"),
+			expectedTolerations: nil,
+		},
 	} {
 		t.Run(testCase.name, func(t *testing.T) {
 			// given
@@ -43,15 +74,21 @@ func TestTolerationsExtender(t *testing.T) {
 				},
 				Spec: imv1.RuntimeSpec{
 					Shoot: imv1.RuntimeShoot{
-						Region: testCase.region,
+						Region:      testCase.region,
+						LicenceType: testCase.licenceType,
 					},
 				},
 			}
 
 			// when
-			extendWithTolerations := NewTolerationsExtender(config.TolerationsConfig{
-				"me-central2": {gardener.Toleration{Key: "ksa-assured-workload"}},
-			})
+			extendWithTolerations := NewTolerationsExtender(
+				config.TolerationsConfig{
+					"me-central2": {gardener.Toleration{Key: "ksa-assured-workload"}},
+				},
+				config.TolerationsConfig{
+					"dedicated": {gardener.Toleration{Key: "dedicated-seed"}},
+				},
+			)
 			err := extendWithTolerations(basicRuntime, &shoot)
 			require.NoError(t, err)
 
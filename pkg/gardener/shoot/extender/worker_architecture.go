@@ -0,0 +1,48 @@
+package extender
+
+import (
+	"fmt"
+	"slices"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"k8s.io/utils/ptr"
+)
+
+// NewWorkerArchitectureExtender validates the CPU architecture requested for worker pools against the architectures supported
+// by the provider and by the machine type of the pool, as configured in `converter_config.json`.
+// Worker pools of a machine type with a configured architecture get the architecture when none is requested.
+// It must run after the provider extender which sets the shoot workers.
+func NewWorkerArchitectureExtender(architecturesConfig config.WorkerArchitecturesConfig) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+		supportedArchitectures, found := architecturesConfig.SupportedByProvider[shoot.Spec.Provider.Type]
+		if !found {
+			supportedArchitectures = v1beta1constants.ValidArchitectures
+		}
+
+		for i := range shoot.Spec.Provider.Workers {
+			worker := &shoot.Spec.Provider.Workers[i]
+			machineTypeArchitecture, machineTypeFound := architecturesConfig.MachineTypes[worker.Machine.Type]
+
+			if worker.Machine.Architecture == nil {
+				if machineTypeFound {
+					worker.Machine.Architecture = ptr.To(machineTypeArchitecture)
+				}
+				continue
+			}
+
+			architecture := *worker.Machine.Architecture
+			if !slices.Contains(supportedArchitectures, architecture) {
+				return fmt.Errorf("architecture %s of worker pool %s is not supported for provider %s, supported architectures: %v", architecture, worker.Name, shoot.Spec.Provider.Type, supportedArchitectures)
+			}
+
+			if machineTypeFound && architecture != machineTypeArchitecture {
+				return fmt.Errorf("architecture %s of worker pool %s does not match the architecture %s of machine type %s", architecture, worker.Name, machineTypeArchitecture, worker.Machine.Type)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,95 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestWorkerArchitectureExtender(t *testing.T) {
+	architecturesConfig := config.WorkerArchitecturesConfig{
+		SupportedByProvider: map[string][]string{
+			"aws":       {"amd64", "arm64"},
+			"openstack": {"amd64"},
+		},
+		MachineTypes: map[string]string{
+			"m6g.large": "arm64",
+			"m6i.large": "amd64",
+		},
+	}
+
+	for tname, tc := range map[string]struct {
+		providerType         string
+		worker               gardener.Worker
+		expectedArchitecture *string
+		expectedError        string
+	}{
+		"Should keep the arm64 architecture of the worker pool": {
+			providerType:         "aws",
+			worker:               fixWorkerWithArchitecture("m6g.large", ptr.To("arm64")),
+			expectedArchitecture: ptr.To("arm64"),
+		},
+		"Should keep the amd64 architecture of the worker pool": {
+			providerType:         "openstack",
+			worker:               fixWorkerWithArchitecture("g_c2_m8", ptr.To("amd64")),
+			expectedArchitecture: ptr.To("amd64"),
+		},
+		"Should set the architecture of the machine type when none is requested": {
+			providerType:         "aws",
+			worker:               fixWorkerWithArchitecture("m6g.large", nil),
+			expectedArchitecture: ptr.To("arm64"),
+		},
+		"Should leave the architecture unset for machine types which are not configured": {
+			providerType: "aws",
+			worker:       fixWorkerWithArchitecture("c7.large", nil),
+		},
+		"Should accept the architectures supported by Gardener for providers which are not configured": {
+			providerType:         "gcp",
+			worker:               fixWorkerWithArchitecture("t2a-standard-4", ptr.To("arm64")),
+			expectedArchitecture: ptr.To("arm64"),
+		},
+		"Should fail when the architecture is not supported for the provider": {
+			providerType:  "openstack",
+			worker:        fixWorkerWithArchitecture("g_c2_m8", ptr.To("arm64")),
+			expectedError: "architecture arm64 of worker pool worker is not supported for provider openstack, supported architectures: [amd64]",
+		},
+		"Should fail when the architecture does not match the machine type": {
+			providerType:  "aws",
+			worker:        fixWorkerWithArchitecture("m6i.large", ptr.To("arm64")),
+			expectedError: "architecture arm64 of worker pool worker does not match the architecture amd64 of machine type m6i.large",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			shoot := fixShootWithWorkers(tc.worker)
+			shoot.Spec.Provider.Type = tc.providerType
+
+			// when
+			err := NewWorkerArchitectureExtender(architecturesConfig)(imv1.Runtime{}, &shoot)
+
+			// then
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedArchitecture, shoot.Spec.Provider.Workers[0].Machine.Architecture)
+		})
+	}
+}
+
+func fixWorkerWithArchitecture(machineType string, architecture *string) gardener.Worker {
+	return gardener.Worker{
+		Name: "worker",
+		Machine: gardener.Machine{
+			Type:         machineType,
+			Architecture: architecture,
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package extender
+
+import (
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+// workerRoleLabel is the worker pool label selecting the role taints from `converter_config.json`
+const workerRoleLabel = "role"
+
+// NewWorkerDefaultsExtender merges the default annotations and taints from `converter_config.json` into every worker pool.
+// Worker pools labeled with a role also get the taints configured for the role.
+// Values set on the worker pool take precedence over the role taints, which take precedence over the default taints.
+// Taints are de-duplicated by key and effect.
+// Worker pools without a volume get the default root volume configured for the provider.
+// It must run after the provider extender which sets the shoot workers.
+func NewWorkerDefaultsExtender(workersConfig config.WorkersConfig) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+		defaultVolume, err := getDefaultWorkerVolume(workersConfig.DefaultVolumes, shoot.Spec.Provider.Type)
+		if err != nil {
+			return err
+		}
+
+		for i := range shoot.Spec.Provider.Workers {
+			worker := &shoot.Spec.Provider.Workers[i]
+			worker.Annotations = mergeWorkerAnnotations(workersConfig.DefaultAnnotations, worker.Annotations)
+			worker.Taints = mergeWorkerTaints(getWorkerRoleTaints(workersConfig.RoleTaints, worker.Labels), worker.Taints)
+			worker.Taints = mergeWorkerTaints(workersConfig.DefaultTaints, worker.Taints)
+
+			if worker.Volume == nil && defaultVolume != nil {
+				worker.Volume = defaultVolume.DeepCopy()
+			}
+		}
+
+		return nil
+	}
+}
+
+func getDefaultWorkerVolume(defaultVolumes map[string]config.WorkerVolumeConfig, providerType string) (*gardener.Volume, error) {
+	volumeConfig, found := defaultVolumes[providerType]
+	if !found {
+		return nil, nil
+	}
+
+	size, err := resource.ParseQuantity(volumeConfig.Size)
+	if err != nil || size.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid default volume size %q for provider %s", volumeConfig.Size, providerType)
+	}
+
+	volume := &gardener.Volume{VolumeSize: volumeConfig.Size}
+	if volumeConfig.Type != "" {
+		volume.Type = ptr.To(volumeConfig.Type)
+	}
+
+	return volume, nil
+}
+
+func getWorkerRoleTaints(roleTaints map[string][]corev1.Taint, workerLabels map[string]string) []corev1.Taint {
+	role, found := workerLabels[workerRoleLabel]
+	if !found {
+		return nil
+	}
+
+	return roleTaints[role]
+}
+
+func mergeWorkerAnnotations(defaults, workerAnnotations map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return workerAnnotations
+	}
+
+	merged := make(map[string]string, len(defaults)+len(workerAnnotations))
+	for key, value := range defaults {
+		merged[key] = value
+	}
+	for key, value := range workerAnnotations {
+		merged[key] = value
+	}
+
+	return merged
+}
+
+func mergeWorkerTaints(defaults, workerTaints []corev1.Taint) []corev1.Taint {
+	if len(defaults) == 0 && len(workerTaints) == 0 {
+		return workerTaints
+	}
+
+	type taintKey struct {
+		key    string
+		effect corev1.TaintEffect
+	}
+
+	seen := make(map[taintKey]bool, len(defaults)+len(workerTaints))
+	merged := make([]corev1.Taint, 0, len(defaults)+len(workerTaints))
+
+	for _, taints := range [][]corev1.Taint{workerTaints, defaults} {
+		for _, taint := range taints {
+			key := taintKey{key: taint.Key, effect: taint.Effect}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, taint)
+		}
+	}
+
+	return merged
+}
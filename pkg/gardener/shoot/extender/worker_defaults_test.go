@@ -0,0 +1,244 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestWorkerDefaultsExtender(t *testing.T) {
+	workersConfig := config.WorkersConfig{
+		DefaultAnnotations: map[string]string{
+			"kyma-project.io/managed": "true",
+			"kyma-project.io/tier":    "default",
+		},
+		DefaultTaints: []corev1.Taint{
+			{Key: "kyma-project.io/dedicated", Value: "default", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "kyma-project.io/maintenance", Value: "true", Effect: corev1.TaintEffectPreferNoSchedule},
+		},
+	}
+
+	t.Run("Should merge default annotations with worker pool precedence", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{
+			Name:        "worker",
+			Annotations: map[string]string{"kyma-project.io/tier": "premium"},
+		})
+
+		// when
+		err := NewWorkerDefaultsExtender(workersConfig)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"kyma-project.io/managed": "true",
+			"kyma-project.io/tier":    "premium",
+		}, shoot.Spec.Provider.Workers[0].Annotations)
+	})
+
+	t.Run("Should de-duplicate taints by key and effect with worker pool precedence", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{
+			Name: "worker",
+			Taints: []corev1.Taint{
+				{Key: "kyma-project.io/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "kyma-project.io/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "kyma-project.io/maintenance", Value: "true", Effect: corev1.TaintEffectNoExecute},
+			},
+		})
+
+		// when
+		err := NewWorkerDefaultsExtender(workersConfig)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []corev1.Taint{
+			{Key: "kyma-project.io/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "kyma-project.io/maintenance", Value: "true", Effect: corev1.TaintEffectNoExecute},
+			{Key: "kyma-project.io/maintenance", Value: "true", Effect: corev1.TaintEffectPreferNoSchedule},
+		}, shoot.Spec.Provider.Workers[0].Taints)
+	})
+
+	t.Run("Should apply defaults to every worker pool", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"}, gardener.Worker{Name: "additional"})
+
+		// when
+		err := NewWorkerDefaultsExtender(workersConfig)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		for _, worker := range shoot.Spec.Provider.Workers {
+			assert.Equal(t, workersConfig.DefaultAnnotations, worker.Annotations)
+			assert.Equal(t, workersConfig.DefaultTaints, worker.Taints)
+		}
+	})
+
+	t.Run("Should leave workers untouched when no defaults are configured", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"})
+
+		// when
+		err := NewWorkerDefaultsExtender(config.WorkersConfig{})(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Provider.Workers[0].Annotations)
+		assert.Nil(t, shoot.Spec.Provider.Workers[0].Taints)
+	})
+
+	t.Run("Should add the role taints to the worker pools labeled with the role", func(t *testing.T) {
+		// given
+		roleConfig := config.WorkersConfig{
+			DefaultTaints: []corev1.Taint{
+				{Key: "kyma-project.io/dedicated", Value: "default", Effect: corev1.TaintEffectNoSchedule},
+			},
+			RoleTaints: map[string][]corev1.Taint{
+				"system": {
+					{Key: "kyma-project.io/dedicated", Value: "system", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "kyma-project.io/critical", Value: "true", Effect: corev1.TaintEffectNoExecute},
+				},
+			},
+		}
+		shoot := fixShootWithWorkers(
+			gardener.Worker{Name: "system", Labels: map[string]string{"role": "system"}},
+			gardener.Worker{Name: "unlabeled"},
+			gardener.Worker{Name: "other", Labels: map[string]string{"role": "gpu"}},
+		)
+
+		// when
+		err := NewWorkerDefaultsExtender(roleConfig)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []corev1.Taint{
+			{Key: "kyma-project.io/dedicated", Value: "system", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "kyma-project.io/critical", Value: "true", Effect: corev1.TaintEffectNoExecute},
+		}, shoot.Spec.Provider.Workers[0].Taints)
+		assert.Equal(t, roleConfig.DefaultTaints, shoot.Spec.Provider.Workers[1].Taints)
+		assert.Equal(t, roleConfig.DefaultTaints, shoot.Spec.Provider.Workers[2].Taints)
+	})
+
+	t.Run("Should not add taints to the worker pools without a role label when only role taints are configured", func(t *testing.T) {
+		// given
+		roleConfig := config.WorkersConfig{
+			RoleTaints: map[string][]corev1.Taint{
+				"system": {{Key: "kyma-project.io/critical", Value: "true", Effect: corev1.TaintEffectNoExecute}},
+			},
+		}
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "unlabeled"})
+
+		// when
+		err := NewWorkerDefaultsExtender(roleConfig)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, shoot.Spec.Provider.Workers[0].Taints)
+	})
+
+	t.Run("Should keep the taint set on the worker pool over the role taint", func(t *testing.T) {
+		// given
+		roleConfig := config.WorkersConfig{
+			RoleTaints: map[string][]corev1.Taint{
+				"system": {{Key: "kyma-project.io/critical", Value: "true", Effect: corev1.TaintEffectNoExecute}},
+			},
+		}
+		shoot := fixShootWithWorkers(gardener.Worker{
+			Name:   "system",
+			Labels: map[string]string{"role": "system"},
+			Taints: []corev1.Taint{{Key: "kyma-project.io/critical", Value: "false", Effect: corev1.TaintEffectNoExecute}},
+		})
+
+		// when
+		err := NewWorkerDefaultsExtender(roleConfig)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []corev1.Taint{
+			{Key: "kyma-project.io/critical", Value: "false", Effect: corev1.TaintEffectNoExecute},
+		}, shoot.Spec.Provider.Workers[0].Taints)
+	})
+
+	t.Run("Should set the default volume of the provider on worker pools without a volume", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"})
+		shoot.Spec.Provider.Type = "aws"
+		volumesConfig := config.WorkersConfig{
+			DefaultVolumes: map[string]config.WorkerVolumeConfig{
+				"aws":   {Type: "gp3", Size: "80Gi"},
+				"azure": {Type: "StandardSSD_LRS", Size: "50Gi"},
+			},
+		}
+
+		// when
+		err := NewWorkerDefaultsExtender(volumesConfig)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.Volume{Type: ptr.To("gp3"), VolumeSize: "80Gi"}, shoot.Spec.Provider.Workers[0].Volume)
+	})
+
+	t.Run("Should keep the volume set on the worker pool", func(t *testing.T) {
+		// given
+		volume := &gardener.Volume{Type: ptr.To("io2"), VolumeSize: "200Gi"}
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker", Volume: volume})
+		shoot.Spec.Provider.Type = "aws"
+		volumesConfig := config.WorkersConfig{
+			DefaultVolumes: map[string]config.WorkerVolumeConfig{"aws": {Type: "gp3", Size: "80Gi"}},
+		}
+
+		// when
+		err := NewWorkerDefaultsExtender(volumesConfig)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, volume, shoot.Spec.Provider.Workers[0].Volume)
+	})
+
+	t.Run("Should leave the volume empty when no default is configured for the provider", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"})
+		shoot.Spec.Provider.Type = "gcp"
+		volumesConfig := config.WorkersConfig{
+			DefaultVolumes: map[string]config.WorkerVolumeConfig{"aws": {Type: "gp3", Size: "80Gi"}},
+		}
+
+		// when
+		err := NewWorkerDefaultsExtender(volumesConfig)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Provider.Workers[0].Volume)
+	})
+
+	for _, size := range []string{"0Gi", "-10Gi", "eighty"} {
+		t.Run("Should return error for invalid default volume size "+size, func(t *testing.T) {
+			// given
+			shoot := fixShootWithWorkers(gardener.Worker{Name: "worker"})
+			shoot.Spec.Provider.Type = "aws"
+			volumesConfig := config.WorkersConfig{
+				DefaultVolumes: map[string]config.WorkerVolumeConfig{"aws": {Type: "gp3", Size: size}},
+			}
+
+			// when
+			err := NewWorkerDefaultsExtender(volumesConfig)(imv1.Runtime{}, &shoot)
+
+			// then
+			require.ErrorContains(t, err, "invalid default volume size")
+		})
+	}
+}
+
+func fixShootWithWorkers(workers ...gardener.Worker) gardener.Shoot {
+	shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+	shoot.Spec.Provider.Workers = workers
+
+	return shoot
+}
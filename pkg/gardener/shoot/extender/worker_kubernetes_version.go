@@ -0,0 +1,72 @@
+package extender
+
+import (
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+// NewWorkerKubernetesVersionExtender applies the Kubernetes version policies of the Runtime to the worker pools.
+// Worker pools with the `inherit` policy follow the Kubernetes version of the Shoot.
+// Worker pools with the `pinned` policy keep the version set in the worker pool, or the version they currently run when it is not set.
+// It must run after the provider extender which sets the shoot workers and after the Kubernetes extenders which set the Shoot version.
+func NewWorkerKubernetesVersionExtender(currentShootKubernetesVersion string, currentWorkers []gardener.Worker) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		policies := runtime.Spec.Shoot.Provider.WorkerKubernetesVersionPolicies
+
+		for workerName := range policies {
+			if findWorker(shoot.Spec.Provider.Workers, workerName) == nil {
+				return fmt.Errorf("kubernetes version policy set for unknown worker pool %s", workerName)
+			}
+		}
+
+		for i := range shoot.Spec.Provider.Workers {
+			worker := &shoot.Spec.Provider.Workers[i]
+
+			switch policies[worker.Name] {
+			case imv1.WorkerKubernetesVersionInherit:
+				if worker.Kubernetes != nil {
+					worker.Kubernetes.Version = nil
+				}
+			case imv1.WorkerKubernetesVersionPinned:
+				if worker.Kubernetes != nil && ptr.Deref(worker.Kubernetes.Version, "") != "" {
+					continue
+				}
+
+				if worker.Kubernetes == nil {
+					worker.Kubernetes = &gardener.WorkerKubernetes{}
+				}
+				worker.Kubernetes.Version = ptr.To(pinnedWorkerKubernetesVersion(worker.Name, currentWorkers, currentShootKubernetesVersion, shoot.Spec.Kubernetes.Version))
+			}
+		}
+
+		return nil
+	}
+}
+
+// pinnedWorkerKubernetesVersion returns the version the worker pool currently runs, new worker pools are pinned to the version of the Shoot
+func pinnedWorkerKubernetesVersion(workerName string, currentWorkers []gardener.Worker, currentShootKubernetesVersion, shootKubernetesVersion string) string {
+	if currentWorker := findWorker(currentWorkers, workerName); currentWorker != nil {
+		if currentWorker.Kubernetes != nil && ptr.Deref(currentWorker.Kubernetes.Version, "") != "" {
+			return *currentWorker.Kubernetes.Version
+		}
+
+		if currentShootKubernetesVersion != "" {
+			return currentShootKubernetesVersion
+		}
+	}
+
+	return shootKubernetesVersion
+}
+
+func findWorker(workers []gardener.Worker, name string) *gardener.Worker {
+	for i := range workers {
+		if workers[i].Name == name {
+			return &workers[i]
+		}
+	}
+
+	return nil
+}
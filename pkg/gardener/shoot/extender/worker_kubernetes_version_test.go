@@ -0,0 +1,97 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestWorkerKubernetesVersionExtender(t *testing.T) {
+	for tname, tc := range map[string]struct {
+		policies              map[string]imv1.WorkerKubernetesVersionPolicy
+		workers               []gardener.Worker
+		currentShootVersion   string
+		currentWorkers        []gardener.Worker
+		expectedVersions      map[string]*string
+		expectedErrorContains string
+	}{
+		"Should keep the worker versions without policies": {
+			workers:             []gardener.Worker{fixWorkerWithKubernetesVersion("worker-0", nil), fixWorkerWithKubernetesVersion("worker-1", ptr.To("1.29.4"))},
+			currentShootVersion: "1.29.4",
+			expectedVersions:    map[string]*string{"worker-0": nil, "worker-1": ptr.To("1.29.4")},
+		},
+		"Should follow the shoot version with inherit policy on upgrade": {
+			policies:            map[string]imv1.WorkerKubernetesVersionPolicy{"worker-0": imv1.WorkerKubernetesVersionInherit},
+			workers:             []gardener.Worker{fixWorkerWithKubernetesVersion("worker-0", ptr.To("1.29.4"))},
+			currentShootVersion: "1.29.4",
+			currentWorkers:      []gardener.Worker{fixWorkerWithKubernetesVersion("worker-0", ptr.To("1.29.4"))},
+			expectedVersions:    map[string]*string{"worker-0": nil},
+		},
+		"Should keep the current version with pinned policy on upgrade": {
+			policies:            map[string]imv1.WorkerKubernetesVersionPolicy{"worker-0": imv1.WorkerKubernetesVersionPinned, "worker-1": imv1.WorkerKubernetesVersionPinned},
+			workers:             []gardener.Worker{fixWorkerWithKubernetesVersion("worker-0", nil), fixWorkerWithKubernetesVersion("worker-1", nil)},
+			currentShootVersion: "1.29.4",
+			currentWorkers:      []gardener.Worker{fixWorkerWithKubernetesVersion("worker-0", nil), fixWorkerWithKubernetesVersion("worker-1", ptr.To("1.28.9"))},
+			expectedVersions:    map[string]*string{"worker-0": ptr.To("1.29.4"), "worker-1": ptr.To("1.28.9")},
+		},
+		"Should keep the version set in the worker pool with pinned policy": {
+			policies:            map[string]imv1.WorkerKubernetesVersionPolicy{"worker-0": imv1.WorkerKubernetesVersionPinned},
+			workers:             []gardener.Worker{fixWorkerWithKubernetesVersion("worker-0", ptr.To("1.29.2"))},
+			currentShootVersion: "1.29.4",
+			currentWorkers:      []gardener.Worker{fixWorkerWithKubernetesVersion("worker-0", ptr.To("1.28.9"))},
+			expectedVersions:    map[string]*string{"worker-0": ptr.To("1.29.2")},
+		},
+		"Should pin new worker pool to the shoot version": {
+			policies:         map[string]imv1.WorkerKubernetesVersionPolicy{"worker-0": imv1.WorkerKubernetesVersionPinned},
+			workers:          []gardener.Worker{fixWorkerWithKubernetesVersion("worker-0", nil)},
+			expectedVersions: map[string]*string{"worker-0": ptr.To("1.30.1")},
+		},
+		"Should fail for policy of unknown worker pool": {
+			policies:              map[string]imv1.WorkerKubernetesVersionPolicy{"worker-1": imv1.WorkerKubernetesVersionPinned},
+			workers:               []gardener.Worker{fixWorkerWithKubernetesVersion("worker-0", nil)},
+			expectedErrorContains: "kubernetes version policy set for unknown worker pool worker-1",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			runtime := imv1.Runtime{}
+			runtime.Spec.Shoot.Provider.WorkerKubernetesVersionPolicies = tc.policies
+
+			shoot := testutils.FixEmptyGardenerShoot("test", "kcp-system")
+			shoot.Spec.Kubernetes.Version = "1.30.1"
+			shoot.Spec.Provider.Workers = tc.workers
+
+			// when
+			err := NewWorkerKubernetesVersionExtender(tc.currentShootVersion, tc.currentWorkers)(runtime, &shoot)
+
+			// then
+			if tc.expectedErrorContains != "" {
+				require.ErrorContains(t, err, tc.expectedErrorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, worker := range shoot.Spec.Provider.Workers {
+				var version *string
+				if worker.Kubernetes != nil {
+					version = worker.Kubernetes.Version
+				}
+				assert.Equal(t, tc.expectedVersions[worker.Name], version, worker.Name)
+			}
+		})
+	}
+}
+
+func fixWorkerWithKubernetesVersion(name string, version *string) gardener.Worker {
+	worker := gardener.Worker{Name: name}
+	if version != nil {
+		worker.Kubernetes = &gardener.WorkerKubernetes{Version: version}
+	}
+
+	return worker
+}
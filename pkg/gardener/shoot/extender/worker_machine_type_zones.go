@@ -0,0 +1,37 @@
+package extender
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+)
+
+// NewWorkerMachineTypeZonesExtender validates that the machine type of every worker pool is available in all zones of the pool.
+// The machine types available in a zone are configured in `converter_config.json` per provider type and region, as zone names are not unique across regions.
+// Zones which are not listed accept any machine type.
+// It must run after the worker zones extender which sets the final zones of the pools.
+func NewWorkerMachineTypeZonesExtender(zoneMachineTypes map[string]map[string]map[string][]string) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+		regionZoneMachineTypes := zoneMachineTypes[shoot.Spec.Provider.Type][shoot.Spec.Region]
+
+		var errs []error
+		for _, worker := range shoot.Spec.Provider.Workers {
+			var unavailableZones []string
+			for _, zone := range worker.Zones {
+				machineTypes, found := regionZoneMachineTypes[zone]
+				if found && !slices.Contains(machineTypes, worker.Machine.Type) {
+					unavailableZones = append(unavailableZones, zone)
+				}
+			}
+
+			if len(unavailableZones) > 0 {
+				errs = append(errs, fmt.Errorf("machine type %s of worker pool %s is not available in zones %v", worker.Machine.Type, worker.Name, unavailableZones))
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
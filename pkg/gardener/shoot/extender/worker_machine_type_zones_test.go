@@ -0,0 +1,114 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerMachineTypeZonesExtender(t *testing.T) {
+	zoneMachineTypes := map[string]map[string]map[string][]string{
+		"aws": {
+			"eu-central-1": {
+				"eu-central-1a": {"m6i.large", "m6g.large"},
+				"eu-central-1b": {"m6i.large"},
+			},
+		},
+		"azure": {
+			"westeurope": {
+				"1": {"Standard_D2s_v5"},
+				"2": {"Standard_D2s_v5"},
+			},
+			"northeurope": {
+				"1": {"Standard_D4s_v5"},
+				"2": {"Standard_D2s_v5", "Standard_D4s_v5"},
+			},
+		},
+	}
+
+	for tname, tc := range map[string]struct {
+		providerType  string
+		region        string
+		workers       []gardener.Worker
+		expectedError string
+	}{
+		"Should accept a machine type which is available in all zones of the worker pool": {
+			providerType: "aws",
+			region:       "eu-central-1",
+			workers:      []gardener.Worker{fixWorkerWithZones("cpu-worker-0", "m6i.large", "eu-central-1a", "eu-central-1b")},
+		},
+		"Should accept any machine type in zones which are not configured": {
+			providerType: "aws",
+			region:       "eu-central-1",
+			workers:      []gardener.Worker{fixWorkerWithZones("cpu-worker-0", "c7.large", "eu-central-1c")},
+		},
+		"Should accept any machine type in regions which are not configured": {
+			providerType: "aws",
+			region:       "eu-west-1",
+			workers:      []gardener.Worker{fixWorkerWithZones("cpu-worker-0", "c7.large", "eu-central-1a")},
+		},
+		"Should accept any machine type for providers which are not configured": {
+			providerType: "gcp",
+			region:       "eu-central-1",
+			workers:      []gardener.Worker{fixWorkerWithZones("cpu-worker-0", "c7.large", "eu-central-1a")},
+		},
+		"Should fail when the machine type is not available in a zone of the worker pool": {
+			providerType:  "aws",
+			region:        "eu-central-1",
+			workers:       []gardener.Worker{fixWorkerWithZones("cpu-worker-0", "m6g.large", "eu-central-1a", "eu-central-1b")},
+			expectedError: "machine type m6g.large of worker pool cpu-worker-0 is not available in zones [eu-central-1b]",
+		},
+		"Should accept a machine type available in the zones of the region when another region shares the zone names": {
+			providerType: "azure",
+			region:       "westeurope",
+			workers:      []gardener.Worker{fixWorkerWithZones("cpu-worker-0", "Standard_D2s_v5", "1", "2")},
+		},
+		"Should fail when the machine type is not available in a zone of the region when another region shares the zone names": {
+			providerType:  "azure",
+			region:        "northeurope",
+			workers:       []gardener.Worker{fixWorkerWithZones("cpu-worker-0", "Standard_D2s_v5", "1", "2")},
+			expectedError: "machine type Standard_D2s_v5 of worker pool cpu-worker-0 is not available in zones [1]",
+		},
+		"Should report every worker pool with an unavailable machine type": {
+			providerType: "aws",
+			region:       "eu-central-1",
+			workers: []gardener.Worker{
+				fixWorkerWithZones("cpu-worker-0", "c7.large", "eu-central-1a", "eu-central-1b"),
+				fixWorkerWithZones("cpu-worker-1", "m6i.large", "eu-central-1a"),
+				fixWorkerWithZones("cpu-worker-2", "m6g.large", "eu-central-1b"),
+			},
+			expectedError: "machine type c7.large of worker pool cpu-worker-0 is not available in zones [eu-central-1a eu-central-1b]\n" +
+				"machine type m6g.large of worker pool cpu-worker-2 is not available in zones [eu-central-1b]",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			shoot := gardener.Shoot{Spec: gardener.ShootSpec{
+				Region:   tc.region,
+				Provider: gardener.Provider{Type: tc.providerType, Workers: tc.workers},
+			}}
+
+			// when
+			err := NewWorkerMachineTypeZonesExtender(zoneMachineTypes)(imv1.Runtime{}, &shoot)
+
+			// then
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func fixWorkerWithZones(name, machineType string, zones ...string) gardener.Worker {
+	return gardener.Worker{
+		Name:    name,
+		Machine: gardener.Machine{Type: machineType},
+		Zones:   zones,
+	}
+}
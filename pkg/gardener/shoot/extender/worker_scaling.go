@@ -0,0 +1,43 @@
+package extender
+
+import (
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ExtendWithWorkerScaling validates the autoscaling boundaries and the surge of every worker pool taken from the Runtime.
+// It must run after the worker defaults extender which may set the surge.
+func ExtendWithWorkerScaling(_ imv1.Runtime, shoot *gardener.Shoot) error {
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if err := validateWorkerScaling(worker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateWorkerScaling(worker gardener.Worker) error {
+	if worker.Minimum > worker.Maximum {
+		return fmt.Errorf("minimum (%d) must not be greater than maximum (%d) in worker pool %s", worker.Minimum, worker.Maximum, worker.Name)
+	}
+
+	// pools scaled down to zero are not rolled, so the surge is irrelevant for them
+	if worker.MaxSurge == nil || worker.Maximum == 0 {
+		return nil
+	}
+
+	surge, err := intstr.GetScaledValueFromIntOrPercent(worker.MaxSurge, int(worker.Maximum), true)
+	if err != nil {
+		return fmt.Errorf("invalid max surge %s in worker pool %s: %w", worker.MaxSurge.String(), worker.Name, err)
+	}
+
+	if surge > int(worker.Maximum) {
+		return fmt.Errorf("max surge (%s) must not be greater than maximum (%d) in worker pool %s", worker.MaxSurge.String(), worker.Maximum, worker.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+func TestWorkerScalingExtender(t *testing.T) {
+	t.Run("Should accept valid worker pools", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(
+			fixWorkerWithScaling("pool", 1, 3, ptr.To(intstr.FromInt32(3))),
+			fixWorkerWithScaling("percent", 2, 10, ptr.To(intstr.FromString("50%"))),
+			fixWorkerWithScaling("without-surge", 0, 0, nil),
+			fixWorkerWithScaling("scaled-down", 0, 0, ptr.To(intstr.FromInt32(1))),
+		)
+
+		// when
+		err := ExtendWithWorkerScaling(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	for _, tc := range []struct {
+		name        string
+		worker      gardener.Worker
+		expectedErr string
+	}{
+		{
+			name:        "Should return error when minimum is greater than maximum",
+			worker:      fixWorkerWithScaling("pool", 5, 3, nil),
+			expectedErr: "minimum (5) must not be greater than maximum (3) in worker pool pool",
+		},
+		{
+			name:        "Should return error when surge is greater than maximum",
+			worker:      fixWorkerWithScaling("pool", 1, 3, ptr.To(intstr.FromInt32(4))),
+			expectedErr: "max surge (4) must not be greater than maximum (3) in worker pool pool",
+		},
+		{
+			name:        "Should return error when surge percentage exceeds maximum",
+			worker:      fixWorkerWithScaling("pool", 1, 3, ptr.To(intstr.FromString("150%"))),
+			expectedErr: "max surge (150%) must not be greater than maximum (3) in worker pool pool",
+		},
+		{
+			name:        "Should return error for invalid surge",
+			worker:      fixWorkerWithScaling("pool", 1, 3, ptr.To(intstr.FromString("many"))),
+			expectedErr: "invalid max surge many in worker pool pool",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			shoot := fixShootWithWorkers(tc.worker)
+
+			// when
+			err := ExtendWithWorkerScaling(imv1.Runtime{}, &shoot)
+
+			// then
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func fixWorkerWithScaling(name string, minimum, maximum int32, maxSurge *intstr.IntOrString) gardener.Worker {
+	return gardener.Worker{
+		Name:     name,
+		Minimum:  minimum,
+		Maximum:  maximum,
+		MaxSurge: maxSurge,
+	}
+}
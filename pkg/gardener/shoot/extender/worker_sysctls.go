@@ -0,0 +1,41 @@
+package extender
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+)
+
+const sysctlNameMaxLength = 253
+
+// sysctlNameRegexp matches the kernel parameter names accepted by Kubernetes, segments are separated with dots or slashes
+var sysctlNameRegexp = regexp.MustCompile(`^([a-z0-9]([-_a-z0-9]*[a-z0-9])?[./])*[a-z0-9]([-_a-z0-9]*[a-z0-9])?$`)
+
+// ExtendWithWorkerSysctls validates the kernel settings of every worker pool taken from the Runtime.
+// Gardener applies the settings on all machines of the worker pool.
+func ExtendWithWorkerSysctls(_ imv1.Runtime, shoot *gardener.Shoot) error {
+	for _, worker := range shoot.Spec.Provider.Workers {
+		for name, value := range worker.Sysctls {
+			if err := validateSysctl(name, value); err != nil {
+				return fmt.Errorf("invalid sysctl in worker pool %s: %w", worker.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateSysctl(name, value string) error {
+	if len(name) > sysctlNameMaxLength || !sysctlNameRegexp.MatchString(name) {
+		return fmt.Errorf("%q is not a valid kernel parameter name", name)
+	}
+
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("kernel parameter %s must have a non-empty value", name)
+	}
+
+	return nil
+}
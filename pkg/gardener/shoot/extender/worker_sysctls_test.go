@@ -0,0 +1,53 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerSysctlsExtender(t *testing.T) {
+	for tname, tc := range map[string]struct {
+		sysctls       map[string]string
+		expectedError string
+	}{
+		"Should keep the worker pool without sysctls": {},
+		"Should keep valid sysctls": {
+			sysctls: map[string]string{
+				"net.core.somaxconn":          "4096",
+				"vm.max_map_count":            "262144",
+				"net/ipv4/tcp_keepalive_time": "600",
+			},
+		},
+		"Should fail for invalid sysctl name": {
+			sysctls:       map[string]string{"net.core.somaxconn=4096": "4096"},
+			expectedError: `invalid sysctl in worker pool worker: "net.core.somaxconn=4096" is not a valid kernel parameter name`,
+		},
+		"Should fail for sysctl without value": {
+			sysctls:       map[string]string{"net.core.somaxconn": " "},
+			expectedError: "invalid sysctl in worker pool worker: kernel parameter net.core.somaxconn must have a non-empty value",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			shoot := testutils.FixEmptyGardenerShoot("shoot", "kcp-system")
+			shoot.Spec.Provider.Workers = []gardener.Worker{{Name: "worker", Sysctls: tc.sysctls}}
+
+			// when
+			err := ExtendWithWorkerSysctls(imv1.Runtime{}, &shoot)
+
+			// then
+			if tc.expectedError != "" {
+				require.EqualError(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.sysctls, shoot.Spec.Provider.Workers[0].Sysctls)
+		})
+	}
+}
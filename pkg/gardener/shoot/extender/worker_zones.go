@@ -0,0 +1,91 @@
+package extender
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/skrdetails"
+)
+
+// NewWorkerZonesExtender orders the zones of worker pools, so Gardener spreads new machines evenly across zones when a pool scales out.
+// Zones are ordered by the desired zone list of the shoot region, zones already used by an existing worker pool keep their position
+// as Gardener rejects changing their order.
+// When HA zones are required, worker pools of Runtimes with zone failure tolerance must span a highly available number of zones.
+// It must run after the provider extender which sets the shoot workers.
+func NewWorkerZonesExtender(zonesConfig config.WorkerZoneBalancingConfig, existingWorkers []gardener.Worker) func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		if zonesConfig.RequireHAZones && hasZoneFailureTolerance(runtime) {
+			for _, worker := range shoot.Spec.Provider.Workers {
+				if !skrdetails.IsHighAvailability(worker.Zones) {
+					return fmt.Errorf("worker pool %s must span an odd number of at least 3 zones for zone failure tolerance, got %d", worker.Name, len(worker.Zones))
+				}
+			}
+		}
+
+		if !zonesConfig.Enabled {
+			return nil
+		}
+
+		desiredZones := zonesConfig.DesiredZones[shoot.Spec.Region]
+		for i := range shoot.Spec.Provider.Workers {
+			worker := &shoot.Spec.Provider.Workers[i]
+
+			var existingZones []string
+			existingIndex := slices.IndexFunc(existingWorkers, func(w gardener.Worker) bool {
+				return w.Name == worker.Name
+			})
+			if existingIndex != -1 {
+				existingZones = existingWorkers[existingIndex].Zones
+			}
+
+			worker.Zones = orderZones(worker.Zones, existingZones, desiredZones)
+		}
+
+		return nil
+	}
+}
+
+func hasZoneFailureTolerance(runtime imv1.Runtime) bool {
+	controlPlane := runtime.Spec.Shoot.ControlPlane
+	return controlPlane != nil &&
+		controlPlane.HighAvailability != nil &&
+		controlPlane.HighAvailability.FailureTolerance.Type == gardener.FailureToleranceTypeZone
+}
+
+func orderZones(zones, existingZones, desiredZones []string) []string {
+	ordered := make([]string, 0, len(zones))
+	for _, zone := range existingZones {
+		if slices.Contains(zones, zone) && !slices.Contains(ordered, zone) {
+			ordered = append(ordered, zone)
+		}
+	}
+
+	var added []string
+	for _, zone := range zones {
+		if !slices.Contains(ordered, zone) && !slices.Contains(added, zone) {
+			added = append(added, zone)
+		}
+	}
+
+	slices.SortFunc(added, func(a, b string) int {
+		aIndex, bIndex := desiredZoneIndex(desiredZones, a), desiredZoneIndex(desiredZones, b)
+		if aIndex != bIndex {
+			return aIndex - bIndex
+		}
+		return strings.Compare(a, b)
+	})
+
+	return append(ordered, added...)
+}
+
+func desiredZoneIndex(desiredZones []string, zone string) int {
+	index := slices.Index(desiredZones, zone)
+	if index == -1 {
+		return len(desiredZones)
+	}
+	return index
+}
@@ -0,0 +1,139 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerZonesExtender(t *testing.T) {
+	zonesConfig := config.WorkerZoneBalancingConfig{
+		Enabled: true,
+		DesiredZones: map[string][]string{
+			"eu-central-1": {"eu-central-1b", "eu-central-1a"},
+		},
+	}
+
+	t.Run("Should order zones of new worker pool by desired zones followed by remaining zones alphabetically", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker", Zones: []string{"eu-central-1d", "eu-central-1a", "eu-central-1c", "eu-central-1b"}})
+		shoot.Spec.Region = "eu-central-1"
+
+		// when
+		err := NewWorkerZonesExtender(zonesConfig, nil)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"eu-central-1b", "eu-central-1a", "eu-central-1c", "eu-central-1d"}, shoot.Spec.Provider.Workers[0].Zones)
+	})
+
+	t.Run("Should order zones alphabetically when no desired zones are configured for the region", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker", Zones: []string{"westeurope-3", "westeurope-1", "westeurope-2"}})
+		shoot.Spec.Region = "westeurope"
+
+		// when
+		err := NewWorkerZonesExtender(zonesConfig, nil)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"westeurope-1", "westeurope-2", "westeurope-3"}, shoot.Spec.Provider.Workers[0].Zones)
+	})
+
+	t.Run("Should keep position of zones used by existing worker pool and order added zones", func(t *testing.T) {
+		// given
+		existingWorkers := []gardener.Worker{{Name: "worker", Zones: []string{"eu-central-1c", "eu-central-1a"}}}
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker", Zones: []string{"eu-central-1c", "eu-central-1a", "eu-central-1d", "eu-central-1b"}})
+		shoot.Spec.Region = "eu-central-1"
+
+		// when
+		err := NewWorkerZonesExtender(zonesConfig, existingWorkers)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"eu-central-1c", "eu-central-1a", "eu-central-1b", "eu-central-1d"}, shoot.Spec.Provider.Workers[0].Zones)
+	})
+
+	t.Run("Should produce the same zone order for differently ordered input", func(t *testing.T) {
+		// given
+		first := fixShootWithWorkers(gardener.Worker{Name: "worker", Zones: []string{"eu-central-1a", "eu-central-1c", "eu-central-1b"}})
+		second := fixShootWithWorkers(gardener.Worker{Name: "worker", Zones: []string{"eu-central-1c", "eu-central-1b", "eu-central-1a"}})
+		first.Spec.Region, second.Spec.Region = "eu-central-1", "eu-central-1"
+		extender := NewWorkerZonesExtender(zonesConfig, nil)
+
+		// when
+		errFirst := extender(imv1.Runtime{}, &first)
+		errSecond := extender(imv1.Runtime{}, &second)
+
+		// then
+		require.NoError(t, errFirst)
+		require.NoError(t, errSecond)
+		assert.Equal(t, first.Spec.Provider.Workers[0].Zones, second.Spec.Provider.Workers[0].Zones)
+	})
+
+	t.Run("Should keep zones untouched when zone balancing is disabled", func(t *testing.T) {
+		// given
+		zones := []string{"eu-central-1c", "eu-central-1a"}
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker", Zones: zones})
+		shoot.Spec.Region = "eu-central-1"
+
+		// when
+		err := NewWorkerZonesExtender(config.WorkerZoneBalancingConfig{}, nil)(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, zones, shoot.Spec.Provider.Workers[0].Zones)
+	})
+
+	t.Run("Should return error when worker pool of Runtime with zone failure tolerance does not span HA zones", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker", Zones: []string{"eu-central-1a", "eu-central-1b"}})
+
+		// when
+		err := NewWorkerZonesExtender(config.WorkerZoneBalancingConfig{RequireHAZones: true}, nil)(fixRuntimeWithFailureTolerance(gardener.FailureToleranceTypeZone), &shoot)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "worker pool worker")
+	})
+
+	t.Run("Should accept worker pool of Runtime with zone failure tolerance spanning HA zones", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker", Zones: []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}})
+
+		// when
+		err := NewWorkerZonesExtender(config.WorkerZoneBalancingConfig{RequireHAZones: true}, nil)(fixRuntimeWithFailureTolerance(gardener.FailureToleranceTypeZone), &shoot)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should not require HA zones for Runtime with node failure tolerance", func(t *testing.T) {
+		// given
+		shoot := fixShootWithWorkers(gardener.Worker{Name: "worker", Zones: []string{"eu-central-1a"}})
+
+		// when
+		err := NewWorkerZonesExtender(config.WorkerZoneBalancingConfig{RequireHAZones: true}, nil)(fixRuntimeWithFailureTolerance(gardener.FailureToleranceTypeNode), &shoot)
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func fixRuntimeWithFailureTolerance(failureToleranceType gardener.FailureToleranceType) imv1.Runtime {
+	return imv1.Runtime{
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				ControlPlane: &gardener.ControlPlane{
+					HighAvailability: &gardener.HighAvailability{
+						FailureTolerance: gardener.FailureTolerance{Type: failureToleranceType},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package shoot
+
+import (
+	"sync"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+)
+
+// shootSubtree identifies the part of the shoot mutated by an extender
+type shootSubtree string
+
+const (
+	subtreeMetadata           shootSubtree = "metadata"
+	subtreeSeedSelector       shootSubtree = "spec.seedSelector"
+	subtreeNetworking         shootSubtree = "spec.networking"
+	subtreeKubernetes         shootSubtree = "spec.kubernetes"
+	subtreeCloudProfile       shootSubtree = "spec.cloudProfileName"
+	subtreeExposureClass      shootSubtree = "spec.exposureClassName"
+	subtreeSystemComponents   shootSubtree = "spec.systemComponents"
+	subtreeAccessRestrictions shootSubtree = "spec.accessRestrictions"
+	subtreeProvider           shootSubtree = "spec.provider"
+	subtreeTolerations        shootSubtree = "spec.tolerations"
+	subtreeAddons             shootSubtree = "spec.addons"
+	subtreeDNS                shootSubtree = "spec.dns"
+	subtreeExtensions         shootSubtree = "spec.extensions"
+	subtreeResources          shootSubtree = "spec.resources"
+	subtreeMaintenance        shootSubtree = "spec.maintenance"
+	subtreeSecretBinding      shootSubtree = "spec.secretBindingName"
+)
+
+// scopedExtender is an extender together with the shoot sub-trees it reads and mutates.
+// Extenders sharing a sub-tree run in the declared order, the others run concurrently.
+type scopedExtender struct {
+	extend    Extend
+	subtrees  []shootSubtree
+	exclusive bool
+}
+
+func mutating(extend Extend, subtrees ...shootSubtree) scopedExtender {
+	return scopedExtender{
+		extend:   extend,
+		subtrees: subtrees,
+	}
+}
+
+// exclusive is used for extenders reading the whole shoot, they run after all the preceding extenders and before all the following ones
+func exclusive(extend Extend) scopedExtender {
+	return scopedExtender{
+		extend:    extend,
+		exclusive: true,
+	}
+}
+
+// runExtenders runs every extender as soon as all the preceding extenders sharing a sub-tree with it are finished.
+// The result is the same as running the extenders one after another, in case of failures the error of the first failed extender in the declared order is returned.
+func runExtenders(runtime imv1.Runtime, shoot *gardener.Shoot, extenders []scopedExtender) error {
+	done := make([]chan struct{}, len(extenders))
+	errs := make([]error, len(extenders))
+	lastBySubtree := make(map[shootSubtree]int)
+	lastExclusive := -1
+
+	var wg sync.WaitGroup
+
+	for i, extender := range extenders {
+		var dependencies []int
+		if lastExclusive >= 0 {
+			dependencies = append(dependencies, lastExclusive)
+		}
+
+		if extender.exclusive {
+			for j := lastExclusive + 1; j < i; j++ {
+				dependencies = append(dependencies, j)
+			}
+			lastExclusive = i
+			clear(lastBySubtree)
+		}
+
+		for _, subtree := range extender.subtrees {
+			if dependency, found := lastBySubtree[subtree]; found {
+				dependencies = append(dependencies, dependency)
+			}
+			lastBySubtree[subtree] = i
+		}
+
+		done[i] = make(chan struct{})
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer close(done[i])
+
+			for _, dependency := range dependencies {
+				<-done[dependency]
+				if errs[dependency] != nil {
+					errs[i] = errs[dependency]
+					return
+				}
+			}
+
+			errs[i] = extender.extend(runtime, shoot)
+		}()
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package shoot
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunExtenders(t *testing.T) {
+	t.Run("Should produce the same shoot as running the extenders sequentially", func(t *testing.T) {
+		// given
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		converters := map[string]Converter{
+			"create": NewConverterCreate(CreateOpts{
+				ConverterConfig: fixConverterConfig(),
+				AuditLogData:    fixAuditLogData(),
+			}),
+			"patch": NewConverterPatch(PatchOpts{
+				ConverterConfig:      fixConverterConfig(),
+				AuditLogData:         fixAuditLogData(),
+				ShootK8SVersion:      "1.28",
+				Workers:              runtime.Spec.Shoot.Provider.Workers,
+				Extensions:           fixAllExtensionsOnTheShoot(),
+				InfrastructureConfig: fixAWSInfrastructureConfig("10.250.0.0/16", []string{"eu-central-1c", "eu-central-1b", "eu-central-1a"}),
+				ControlPlaneConfig:   fixAWSControlPlaneConfig(),
+			}),
+		}
+
+		for name, converter := range converters {
+			expected := converter.baseShoot(runtime)
+			for _, extender := range converter.extenders {
+				require.NoError(t, extender.extend(runtime, &expected), name)
+			}
+
+			for range 20 {
+				// when
+				shoot, err := converter.ToShoot(runtime)
+
+				// then
+				require.NoError(t, err, name)
+				assert.Equal(t, expected, shoot, name)
+			}
+		}
+	})
+
+	t.Run("Should produce the same shoot as running the extenders sequentially with a shoot name prefix and external DNS", func(t *testing.T) {
+		// given
+		// the DNS extender reads the shoot name set by the shoot name extender, a missing sub-tree declaration is reported by the race detector
+		runtime := fixRuntime(gardener.ShootPurposeProduction)
+		runtime.Spec.Shoot.Name = "shoot"
+		converterConfig := fixConverterConfig()
+		converterConfig.Gardener.ShootNamePrefix = "c-"
+		require.False(t, converterConfig.DNS.IsGardenerInternal())
+
+		converters := map[string]Converter{
+			"create": NewConverterCreate(CreateOpts{
+				ConverterConfig: converterConfig,
+				AuditLogData:    fixAuditLogData(),
+			}),
+			"patch": NewConverterPatch(PatchOpts{
+				ConverterConfig:      converterConfig,
+				AuditLogData:         fixAuditLogData(),
+				ShootName:            "c-shoot",
+				ShootK8SVersion:      "1.28",
+				Workers:              runtime.Spec.Shoot.Provider.Workers,
+				Extensions:           fixAllExtensionsOnTheShoot(),
+				InfrastructureConfig: fixAWSInfrastructureConfig("10.250.0.0/16", []string{"eu-central-1c", "eu-central-1b", "eu-central-1a"}),
+				ControlPlaneConfig:   fixAWSControlPlaneConfig(),
+			}),
+		}
+
+		for name, converter := range converters {
+			expected := converter.baseShoot(runtime)
+			for _, extender := range converter.extenders {
+				require.NoError(t, extender.extend(runtime, &expected), name)
+			}
+
+			for range 20 {
+				// when
+				shoot, err := converter.ToShoot(runtime)
+
+				// then
+				require.NoError(t, err, name)
+				assert.Equal(t, expected, shoot, name)
+				assert.Equal(t, "c-shoot", shoot.Name, name)
+			}
+		}
+
+		createdShoot, err := converters["create"].ToShoot(runtime)
+		require.NoError(t, err)
+		require.NotNil(t, createdShoot.Spec.DNS)
+		assert.True(t, strings.HasPrefix(*createdShoot.Spec.DNS.Domain, "c-shoot."), "the domain must be built from the prefixed shoot name")
+	})
+
+	t.Run("Should run extenders sharing a sub-tree in the declared order", func(t *testing.T) {
+		// given
+		appendToleration := func(key string, delay time.Duration) Extend {
+			return func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+				time.Sleep(delay)
+				shoot.Spec.Tolerations = append(shoot.Spec.Tolerations, gardener.Toleration{Key: key})
+				return nil
+			}
+		}
+		setExposureClass := func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+			shoot.Spec.ExposureClassName = &shoot.Name
+			return nil
+		}
+
+		shoot := gardener.Shoot{}
+
+		// when
+		err := runExtenders(imv1.Runtime{}, &shoot, []scopedExtender{
+			mutating(appendToleration("first", 30*time.Millisecond), subtreeTolerations),
+			mutating(setExposureClass, subtreeExposureClass),
+			mutating(appendToleration("second", 10*time.Millisecond), subtreeTolerations),
+			mutating(appendToleration("third", 0), subtreeTolerations, subtreeExposureClass),
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []gardener.Toleration{{Key: "first"}, {Key: "second"}, {Key: "third"}}, shoot.Spec.Tolerations)
+		assert.NotNil(t, shoot.Spec.ExposureClassName)
+	})
+
+	t.Run("Should run exclusive extenders after all the preceding and before all the following extenders", func(t *testing.T) {
+		// given
+		var dnsSetBeforeExclusive, tolerationsSetBeforeExclusive, exposureClassSetBeforeExclusive bool
+
+		delayed := func(delay time.Duration, extend Extend) Extend {
+			return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+				time.Sleep(delay)
+				return extend(runtime, shoot)
+			}
+		}
+		setDNS := func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+			shoot.Spec.DNS = &gardener.DNS{}
+			return nil
+		}
+		setTolerations := func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+			shoot.Spec.Tolerations = []gardener.Toleration{{Key: "key"}}
+			return nil
+		}
+		setExposureClass := func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+			shoot.Spec.ExposureClassName = &shoot.Name
+			return nil
+		}
+		inspectShoot := func(_ imv1.Runtime, shoot *gardener.Shoot) error {
+			dnsSetBeforeExclusive = shoot.Spec.DNS != nil
+			tolerationsSetBeforeExclusive = shoot.Spec.Tolerations != nil
+			exposureClassSetBeforeExclusive = shoot.Spec.ExposureClassName != nil
+			return nil
+		}
+
+		shoot := gardener.Shoot{}
+
+		// when
+		err := runExtenders(imv1.Runtime{}, &shoot, []scopedExtender{
+			mutating(delayed(20*time.Millisecond, setDNS), subtreeDNS),
+			mutating(delayed(10*time.Millisecond, setTolerations), subtreeTolerations),
+			exclusive(inspectShoot),
+			mutating(setExposureClass, subtreeExposureClass),
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, dnsSetBeforeExclusive)
+		assert.True(t, tolerationsSetBeforeExclusive)
+		assert.False(t, exposureClassSetBeforeExclusive)
+		assert.NotNil(t, shoot.Spec.ExposureClassName)
+	})
+
+	t.Run("Should return the error of the first failed extender and skip its dependants", func(t *testing.T) {
+		// given
+		errFirst := errors.New("first")
+		errSecond := errors.New("second")
+		dependantCalled := false
+
+		failWith := func(err error, delay time.Duration) Extend {
+			return func(_ imv1.Runtime, _ *gardener.Shoot) error {
+				time.Sleep(delay)
+				return err
+			}
+		}
+		dependant := func(_ imv1.Runtime, _ *gardener.Shoot) error {
+			dependantCalled = true
+			return nil
+		}
+
+		shoot := gardener.Shoot{}
+
+		// when
+		err := runExtenders(imv1.Runtime{}, &shoot, []scopedExtender{
+			mutating(failWith(errFirst, 20*time.Millisecond), subtreeTolerations),
+			mutating(failWith(errSecond, 0), subtreeDNS),
+			mutating(dependant, subtreeTolerations),
+		})
+
+		// then
+		require.ErrorIs(t, err, errFirst)
+		assert.False(t, dependantCalled)
+	})
+}
@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -22,6 +24,20 @@ func GetInfrastructureConfig(workersCidr string, zones []string) ([]byte, error)
 	return json.Marshal(config)
 }
 
+// GetInfrastructureConfigForExistingVPC returns the infrastructure config which creates the worker zone subnets in an existing VPC
+func GetInfrastructureConfigForExistingVPC(workersCidr string, zones []string, vpcID string) ([]byte, error) {
+	config, err := NewInfrastructureConfig(workersCidr, zones)
+	if err != nil {
+		return nil, err
+	}
+
+	config.Networks.VPC = v1alpha1.VPC{
+		ID: &vpcID,
+	}
+
+	return json.Marshal(config)
+}
+
 func GetInfrastructureConfigForPatch(workersCidr string, zones []string, existingInfrastructureConfigBytes []byte) ([]byte, error) {
 	newConfig, err := NewInfrastructureConfigForPatch(workersCidr, zones, existingInfrastructureConfigBytes)
 	if err != nil {
@@ -48,6 +64,29 @@ func DecodeInfrastructureConfig(data []byte) (*v1alpha1.InfrastructureConfig, er
 	return infrastructureConfig, nil
 }
 
+// DecodeWorkerConfig decodes the worker config, unknown fields and configs of other kinds are rejected
+func DecodeWorkerConfig(data []byte) (*v1alpha1.WorkerConfig, error) {
+	workerConfig := &v1alpha1.WorkerConfig{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(workerConfig); err != nil {
+		return nil, err
+	}
+	if workerConfig.APIVersion != apiVersion || workerConfig.Kind != workerConfigKind {
+		return nil, fmt.Errorf("expected %s of %s, got %s of %s", workerConfigKind, apiVersion, workerConfig.Kind, workerConfig.APIVersion)
+	}
+	return workerConfig, nil
+}
+
+func DecodeControlPlaneConfig(data []byte) (*v1alpha1.ControlPlaneConfig, error) {
+	controlPlaneConfig := &v1alpha1.ControlPlaneConfig{}
+	err := json.Unmarshal(data, controlPlaneConfig)
+	if err != nil {
+		return nil, err
+	}
+	return controlPlaneConfig, nil
+}
+
 func NewInfrastructureConfig(workersCidr string, zones []string) (v1alpha1.InfrastructureConfig, error) {
 	awsZones, err := generateAWSZones(workersCidr, zones)
 	if err != nil {
@@ -85,6 +124,10 @@ func NewInfrastructureConfigForPatch(workersCidr string, zones []string, existin
 	newConfig.DualStack = existingInfrastructureConfig.DualStack
 	newConfig.Networks.VPC.ID = existingInfrastructureConfig.Networks.VPC.ID
 	newConfig.Networks.VPC.GatewayEndpoints = existingInfrastructureConfig.Networks.VPC.GatewayEndpoints
+	if newConfig.Networks.VPC.ID != nil {
+		// the VPC of the shoot is kept as it is, a shoot in an existing VPC references it by ID only
+		newConfig.Networks.VPC.CIDR = existingInfrastructureConfig.Networks.VPC.CIDR
+	}
 
 	for _, zone := range existingInfrastructureConfig.Networks.Zones {
 		for i := 0; i < len(newConfig.Networks.Zones); i++ {
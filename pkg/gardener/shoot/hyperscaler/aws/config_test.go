@@ -309,3 +309,20 @@ func TestWorkerConfig(t *testing.T) {
 		assert.Equal(t, v1alpha1.HTTPTokensRequired, *config.InstanceMetadataOptions.HTTPTokens)
 	})
 }
+
+func TestInfrastructureConfigForExistingVPC(t *testing.T) {
+	t.Run("Create Infrastructure config for existing VPC", func(t *testing.T) {
+		// when
+		infrastructureConfigBytes, err := GetInfrastructureConfigForExistingVPC("10.250.0.0/16", []string{"eu-central-1a"}, "vpc-123456")
+
+		// then
+		require.NoError(t, err)
+
+		var infrastructureConfig v1alpha1.InfrastructureConfig
+		require.NoError(t, json.Unmarshal(infrastructureConfigBytes, &infrastructureConfig))
+		assert.Equal(t, ptr.To("vpc-123456"), infrastructureConfig.Networks.VPC.ID)
+		assert.Nil(t, infrastructureConfig.Networks.VPC.CIDR)
+		require.Len(t, infrastructureConfig.Networks.Zones, 1)
+		assert.Equal(t, "10.250.0.0/19", infrastructureConfig.Networks.Zones[0].Workers)
+	})
+}
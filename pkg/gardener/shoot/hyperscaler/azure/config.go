@@ -2,11 +2,14 @@ package azure
 
 import (
 	"encoding/json"
+	"fmt"
+
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const infrastructureConfigKind = "InfrastructureConfig"
 const controlPlaneConfigKind = "ControlPlaneConfig"
+const workerConfigKind = "WorkerConfig"
 const apiVersion = "azure.provider.extensions.gardener.cloud/v1alpha1"
 
 func GetInfrastructureConfig(workerCIDR string, zones []string) ([]byte, error) {
@@ -19,6 +22,21 @@ func GetInfrastructureConfig(workerCIDR string, zones []string) ([]byte, error)
 	return json.Marshal(config)
 }
 
+// GetInfrastructureConfigForExistingVNet returns the infrastructure config which creates the worker subnets in an existing VNet
+func GetInfrastructureConfigForExistingVNet(workerCIDR string, zones []string, vnetName, resourceGroup string) ([]byte, error) {
+	config, err := NewInfrastructureConfig(workerCIDR, zones)
+	if err != nil {
+		return nil, err
+	}
+
+	config.Networks.VNet = VNet{
+		Name:          &vnetName,
+		ResourceGroup: &resourceGroup,
+	}
+
+	return json.Marshal(config)
+}
+
 func GetInfrastructureConfigForPatch(workersCidr string, zones []string, existingInfrastructureConfigBytes []byte) ([]byte, error) {
 	newConfig, err := NewInfrastructureConfigForPatch(workersCidr, zones, existingInfrastructureConfigBytes)
 	if err != nil {
@@ -50,6 +68,19 @@ func DecodeInfrastructureConfig(data []byte) (*InfrastructureConfig, error) {
 	return infrastructureConfig, nil
 }
 
+// DecodeWorkerConfig checks that the data is a worker config of the Azure provider
+// The settings of the Azure worker config are not modeled, so they are validated by the provider extension
+func DecodeWorkerConfig(data []byte) (*v1.TypeMeta, error) {
+	typeMeta := &v1.TypeMeta{}
+	if err := json.Unmarshal(data, typeMeta); err != nil {
+		return nil, err
+	}
+	if typeMeta.APIVersion != apiVersion || typeMeta.Kind != workerConfigKind {
+		return nil, fmt.Errorf("expected %s of %s, got %s of %s", workerConfigKind, apiVersion, typeMeta.Kind, typeMeta.APIVersion)
+	}
+	return typeMeta, nil
+}
+
 func NewInfrastructureConfig(workerCIDR string, zones []string) (InfrastructureConfig, error) {
 	// All standard Azure shoots are zoned.
 	// No zones - old Azure lite shoots where config should be preserved.
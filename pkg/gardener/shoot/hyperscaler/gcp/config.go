@@ -1,7 +1,9 @@
 package gcp
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/v1alpha1"
 	"github.com/pkg/errors"
@@ -11,6 +13,7 @@ import (
 const (
 	infrastructureConfigKind = "InfrastructureConfig"
 	controlPlaneConfigKind   = "ControlPlaneConfig"
+	workerConfigKind         = "WorkerConfig"
 	apiVersion               = "gcp.provider.extensions.gardener.cloud/v1alpha1"
 )
 
@@ -18,6 +21,29 @@ func GetInfrastructureConfig(workerCIDR string, _ []string) ([]byte, error) {
 	return json.Marshal(NewInfrastructureConfig(workerCIDR))
 }
 
+// GetInfrastructureConfigForExistingVPC returns the infrastructure config which creates the worker subnet in an existing VPC
+func GetInfrastructureConfigForExistingVPC(workerCIDR string, _ []string, vpcName string) ([]byte, error) {
+	config := NewInfrastructureConfig(workerCIDR)
+	config.Networks.VPC = &v1alpha1.VPC{
+		Name: vpcName,
+	}
+
+	return json.Marshal(config)
+}
+
+// GetInfrastructureConfigForPatch returns the infrastructure config which keeps the VPC of the existing infrastructure config
+func GetInfrastructureConfigForPatch(workerCIDR string, _ []string, existingInfrastructureConfigBytes []byte) ([]byte, error) {
+	var existingInfrastructureConfig v1alpha1.InfrastructureConfig
+	if err := json.Unmarshal(existingInfrastructureConfigBytes, &existingInfrastructureConfig); err != nil {
+		return nil, err
+	}
+
+	config := NewInfrastructureConfig(workerCIDR)
+	config.Networks.VPC = existingInfrastructureConfig.Networks.VPC
+
+	return json.Marshal(config)
+}
+
 func GetControlPlaneConfig(zones []string) ([]byte, error) {
 	if len(zones) == 0 {
 		return nil, errors.New("zones list is empty")
@@ -59,3 +85,17 @@ func DecodeControlPlaneConfig(data []byte) (*v1alpha1.ControlPlaneConfig, error)
 	}
 	return controlPlaneConfig, nil
 }
+
+// DecodeWorkerConfig decodes the worker config, unknown fields and configs of other kinds are rejected
+func DecodeWorkerConfig(data []byte) (*v1alpha1.WorkerConfig, error) {
+	workerConfig := &v1alpha1.WorkerConfig{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(workerConfig); err != nil {
+		return nil, err
+	}
+	if workerConfig.APIVersion != apiVersion || workerConfig.Kind != workerConfigKind {
+		return nil, fmt.Errorf("expected %s of %s, got %s of %s", workerConfigKind, apiVersion, workerConfig.Kind, workerConfig.APIVersion)
+	}
+	return workerConfig, nil
+}
@@ -1,7 +1,9 @@
 package openstack
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -10,6 +12,7 @@ import (
 const (
 	infrastructureConfigKind    = "InfrastructureConfig"
 	controlPlaneConfigKind      = "ControlPlaneConfig"
+	workerConfigKind            = "WorkerConfig"
 	apiVersion                  = "openstack.provider.extensions.gardener.cloud/v1alpha1"
 	defaultFloatingPoolName     = "FloatingIP-external-kyma-01"
 	defaultLoadBalancerProvider = "f5"
@@ -45,3 +48,17 @@ func NewControlPlaneConfig() *v1alpha1.ControlPlaneConfig {
 		LoadBalancerProvider: defaultLoadBalancerProvider,
 	}
 }
+
+// DecodeWorkerConfig decodes the worker config, unknown fields and configs of other kinds are rejected
+func DecodeWorkerConfig(data []byte) (*v1alpha1.WorkerConfig, error) {
+	workerConfig := &v1alpha1.WorkerConfig{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(workerConfig); err != nil {
+		return nil, err
+	}
+	if workerConfig.APIVersion != apiVersion || workerConfig.Kind != workerConfigKind {
+		return nil, fmt.Errorf("expected %s of %s, got %s of %s", workerConfigKind, apiVersion, workerConfig.Kind, workerConfig.APIVersion)
+	}
+	return workerConfig, nil
+}
@@ -2,6 +2,9 @@ package structuredauth
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"slices"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1 "k8s.io/api/core/v1"
@@ -19,8 +22,10 @@ type JWTAuthenticator struct {
 }
 
 type Issuer struct {
-	URL       string   `json:"url"`
-	Audiences []string `json:"audiences"`
+	URL                  string   `json:"url"`
+	CertificateAuthority string   `json:"certificateAuthority,omitempty"`
+	Audiences            []string `json:"audiences"`
+	AudienceMatchPolicy  string   `json:"audienceMatchPolicy,omitempty"`
 }
 
 type ClaimMappings struct {
@@ -39,7 +44,14 @@ type AuthenticationConfiguration struct {
 	JWT []JWTAuthenticator `json:"jwt"`
 }
 
-func toAuthenticationConfiguration(oidcConfig gardener.OIDCConfig) AuthenticationConfiguration {
+// audienceMatchAny is required by the API server for issuers with more than one audience
+const audienceMatchAny = "MatchAny"
+
+// toAuthenticationConfiguration creates a JWT authenticator for the primary OIDC config followed by the additional ones.
+// The API server requires unique issuer URLs, so the client IDs of configs sharing an issuer are added as audiences
+// of the authenticator created for the first of them. Configs sharing an issuer must map the claims with the same prefixes,
+// as an authenticator has a single claim mapping.
+func toAuthenticationConfiguration(oidcConfig gardener.OIDCConfig, additionalOidcConfigs ...gardener.OIDCConfig) (AuthenticationConfiguration, error) {
 
 	toJWTAuthenticator := func(oidcConfig gardener.OIDCConfig) JWTAuthenticator {
 		// If Groups prefix is not set by the KEB, default is set as Gardener requires non-empty value
@@ -51,8 +63,9 @@ func toAuthenticationConfiguration(oidcConfig gardener.OIDCConfig) Authenticatio
 
 		return JWTAuthenticator{
 			Issuer: Issuer{
-				URL:       ptr.Deref(oidcConfig.IssuerURL, ""),
-				Audiences: []string{ptr.Deref(oidcConfig.ClientID, "")},
+				URL:                  ptr.Deref(oidcConfig.IssuerURL, ""),
+				CertificateAuthority: ptr.Deref(oidcConfig.CABundle, ""),
+				Audiences:            []string{ptr.Deref(oidcConfig.ClientID, "")},
 			},
 			ClaimMappings: ClaimMappings{
 				Username: PrefixedClaim{
@@ -68,7 +81,27 @@ func toAuthenticationConfiguration(oidcConfig gardener.OIDCConfig) Authenticatio
 	}
 
 	jwtAuthenticators := make([]JWTAuthenticator, 0)
-	jwtAuthenticators = append(jwtAuthenticators, toJWTAuthenticator(oidcConfig))
+	for _, config := range append([]gardener.OIDCConfig{oidcConfig}, additionalOidcConfigs...) {
+		index := slices.IndexFunc(jwtAuthenticators, func(authenticator JWTAuthenticator) bool {
+			return authenticator.Issuer.URL == ptr.Deref(config.IssuerURL, "")
+		})
+
+		jwtAuthenticator := toJWTAuthenticator(config)
+		if index < 0 {
+			jwtAuthenticators = append(jwtAuthenticators, jwtAuthenticator)
+			continue
+		}
+
+		if !reflect.DeepEqual(jwtAuthenticators[index].ClaimMappings, jwtAuthenticator.ClaimMappings) {
+			return AuthenticationConfiguration{}, fmt.Errorf("OIDC configs of issuer %s have conflicting claim mappings, the claims and prefixes of all clients of an issuer must be the same", jwtAuthenticator.Issuer.URL)
+		}
+
+		issuer := &jwtAuthenticators[index].Issuer
+		if !slices.Contains(issuer.Audiences, ptr.Deref(config.ClientID, "")) {
+			issuer.Audiences = append(issuer.Audiences, ptr.Deref(config.ClientID, ""))
+			issuer.AudienceMatchPolicy = audienceMatchAny
+		}
+	}
 
 	return AuthenticationConfiguration{
 		TypeMeta: metav1.TypeMeta{
@@ -76,12 +109,16 @@ func toAuthenticationConfiguration(oidcConfig gardener.OIDCConfig) Authenticatio
 			APIVersion: "apiserver.config.k8s.io/v1beta1",
 		},
 		JWT: jwtAuthenticators,
-	}
+	}, nil
 }
 
-func CreateOrUpdateStructuredAuthConfigMap(ctx context.Context, gardenClient client.Client, cmKey types.NamespacedName, oidcConfig gardener.OIDCConfig) error {
+func CreateOrUpdateStructuredAuthConfigMap(ctx context.Context, gardenClient client.Client, cmKey types.NamespacedName, oidcConfig gardener.OIDCConfig, additionalOidcConfigs ...gardener.OIDCConfig) error {
 	creteConfigMapObject := func() (v1.ConfigMap, error) {
-		authenticationConfig := toAuthenticationConfiguration(oidcConfig)
+		authenticationConfig, err := toAuthenticationConfiguration(oidcConfig, additionalOidcConfigs...)
+		if err != nil {
+			return v1.ConfigMap{}, err
+		}
+
 		authConfigBytes, err := yaml.Marshal(authenticationConfig)
 		if err != nil {
 			return v1.ConfigMap{}, err
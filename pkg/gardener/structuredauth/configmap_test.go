@@ -149,6 +149,151 @@ func TestCreateOrUpdateConfigMap(t *testing.T) {
 	}
 }
 
+func TestUpdateConfigMapWithChangedOIDCConfig(t *testing.T) {
+	// given
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	cmKey := types.NamespacedName{Namespace: "default", Name: "structured-auth-config-shoot"}
+
+	err := CreateOrUpdateStructuredAuthConfigMap(context.Background(), fakeClient, cmKey, gardener.OIDCConfig{
+		ClientID:       ptr.To("client"),
+		IssuerURL:      ptr.To("https://issuer.example.com"),
+		UsernameClaim:  ptr.To("sub"),
+		UsernamePrefix: ptr.To("user-"),
+		GroupsClaim:    ptr.To("groups"),
+		GroupsPrefix:   ptr.To("group-"),
+	})
+	require.NoError(t, err)
+
+	// when
+	err = CreateOrUpdateStructuredAuthConfigMap(context.Background(), fakeClient, cmKey, gardener.OIDCConfig{
+		ClientID:      ptr.To("new-client"),
+		IssuerURL:     ptr.To("https://new-issuer.example.com"),
+		UsernameClaim: ptr.To("sub"),
+		GroupsClaim:   ptr.To("groups"),
+	})
+
+	// then
+	require.NoError(t, err)
+
+	var cm corev1.ConfigMap
+	require.NoError(t, fakeClient.Get(context.Background(), cmKey, &cm))
+
+	var authenticationConfiguration AuthenticationConfiguration
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data["config.yaml"]), &authenticationConfiguration))
+	require.Len(t, authenticationConfiguration.JWT, 1)
+
+	jwtAuthenticator := authenticationConfiguration.JWT[0]
+	assert.Equal(t, "https://new-issuer.example.com", jwtAuthenticator.Issuer.URL)
+	assert.Equal(t, []string{"new-client"}, jwtAuthenticator.Issuer.Audiences)
+	assert.Nil(t, jwtAuthenticator.ClaimMappings.Username.Prefix)
+	assert.Equal(t, ptr.To(""), jwtAuthenticator.ClaimMappings.Groups.Prefix)
+}
+
+func TestCreateConfigMapWithAdditionalOIDCConfigs(t *testing.T) {
+	// given
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	cmKey := types.NamespacedName{Namespace: "default", Name: "structured-auth-config-shoot"}
+
+	// when
+	err := CreateOrUpdateStructuredAuthConfigMap(context.Background(), fakeClient, cmKey,
+		gardener.OIDCConfig{
+			ClientID:      ptr.To("client"),
+			IssuerURL:     ptr.To("https://issuer.example.com"),
+			UsernameClaim: ptr.To("sub"),
+			GroupsClaim:   ptr.To("groups"),
+		},
+		gardener.OIDCConfig{
+			ClientID:      ptr.To("additional-client"),
+			IssuerURL:     ptr.To("https://issuer.example.com"),
+			UsernameClaim: ptr.To("sub"),
+			GroupsClaim:   ptr.To("groups"),
+		},
+		gardener.OIDCConfig{
+			ClientID:       ptr.To("other-client"),
+			IssuerURL:      ptr.To("https://other-issuer.example.com"),
+			UsernameClaim:  ptr.To("email"),
+			UsernamePrefix: ptr.To("other-"),
+			GroupsClaim:    ptr.To("roles"),
+		},
+	)
+
+	// then
+	require.NoError(t, err)
+
+	var cm corev1.ConfigMap
+	require.NoError(t, fakeClient.Get(context.Background(), cmKey, &cm))
+
+	var authenticationConfiguration AuthenticationConfiguration
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data["config.yaml"]), &authenticationConfiguration))
+	require.Len(t, authenticationConfiguration.JWT, 2)
+
+	primaryAuthenticator := authenticationConfiguration.JWT[0]
+	assert.Equal(t, "https://issuer.example.com", primaryAuthenticator.Issuer.URL)
+	assert.Equal(t, []string{"client", "additional-client"}, primaryAuthenticator.Issuer.Audiences)
+	assert.Equal(t, "MatchAny", primaryAuthenticator.Issuer.AudienceMatchPolicy)
+
+	additionalAuthenticator := authenticationConfiguration.JWT[1]
+	assert.Equal(t, "https://other-issuer.example.com", additionalAuthenticator.Issuer.URL)
+	assert.Equal(t, []string{"other-client"}, additionalAuthenticator.Issuer.Audiences)
+	assert.Empty(t, additionalAuthenticator.Issuer.AudienceMatchPolicy)
+	assert.Equal(t, "email", additionalAuthenticator.ClaimMappings.Username.Claim)
+	assert.Equal(t, ptr.To("other-"), additionalAuthenticator.ClaimMappings.Username.Prefix)
+}
+
+func TestCreateConfigMapWithConflictingClaimMappingsOfIssuer(t *testing.T) {
+	for tname, additionalOidcConfig := range map[string]gardener.OIDCConfig{
+		"Should reject a different username claim of the same issuer": {
+			ClientID:      ptr.To("additional-client"),
+			IssuerURL:     ptr.To("https://issuer.example.com"),
+			UsernameClaim: ptr.To("email"),
+			GroupsClaim:   ptr.To("groups"),
+		},
+		"Should reject a different username prefix of the same issuer": {
+			ClientID:       ptr.To("additional-client"),
+			IssuerURL:      ptr.To("https://issuer.example.com"),
+			UsernameClaim:  ptr.To("sub"),
+			UsernamePrefix: ptr.To("additional-"),
+			GroupsClaim:    ptr.To("groups"),
+		},
+		"Should reject a different groups prefix of the same issuer": {
+			ClientID:      ptr.To("additional-client"),
+			IssuerURL:     ptr.To("https://issuer.example.com"),
+			UsernameClaim: ptr.To("sub"),
+			GroupsClaim:   ptr.To("groups"),
+			GroupsPrefix:  ptr.To("additional:"),
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			scheme := runtime.NewScheme()
+			require.NoError(t, corev1.AddToScheme(scheme))
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+			cmKey := types.NamespacedName{Namespace: "default", Name: "structured-auth-config-shoot"}
+
+			// when
+			err := CreateOrUpdateStructuredAuthConfigMap(context.Background(), fakeClient, cmKey,
+				gardener.OIDCConfig{
+					ClientID:      ptr.To("client"),
+					IssuerURL:     ptr.To("https://issuer.example.com"),
+					UsernameClaim: ptr.To("sub"),
+					GroupsClaim:   ptr.To("groups"),
+				},
+				additionalOidcConfig,
+			)
+
+			// then
+			require.EqualError(t, err, "OIDC configs of issuer https://issuer.example.com have conflicting claim mappings, the claims and prefixes of all clients of an issuer must be the same")
+
+			var cm corev1.ConfigMap
+			require.True(t, errors.IsNotFound(fakeClient.Get(context.Background(), cmKey, &cm)))
+		})
+	}
+}
+
 func TestDeleteStructuredConfigMap(t *testing.T) {
 
 	scheme := runtime.NewScheme()
@@ -15,6 +15,24 @@ func GetOIDCConfigOrDefault(runtime imv1.Runtime, defaultOIDC gardener.OIDCConfi
 	return oidcConfig
 }
 
+// GetAdditionalOIDCConfigs returns the additional OIDC configs of the Runtime which have both the issuer URL and the client ID set
+func GetAdditionalOIDCConfigs(runtime imv1.Runtime) []gardener.OIDCConfig {
+	additionalOidcConfig := runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig
+	if additionalOidcConfig == nil {
+		return nil
+	}
+
+	oidcConfigs := make([]gardener.OIDCConfig, 0, len(*additionalOidcConfig))
+	for _, oidcConfig := range *additionalOidcConfig {
+		if oidcConfig.IssuerURL == nil || oidcConfig.ClientID == nil {
+			continue
+		}
+		oidcConfigs = append(oidcConfigs, oidcConfig.OIDCConfig)
+	}
+
+	return oidcConfigs
+}
+
 func OIDCConfigured(shoot gardener.Shoot) bool {
 	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
 		return false
@@ -0,0 +1,63 @@
+package structuredauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrInvalidOIDCConfigReference is returned when the referenced object or key does not exist, as opposed to failures reading the referenced object
+var ErrInvalidOIDCConfigReference = errors.New("invalid OIDC config reference")
+
+// ResolveOIDCConfigReferences returns the OIDC config with the values read from the ConfigMap referenced in the namespace of the Runtime.
+// The referenced CA bundle ends up as the certificate authority of the JWT issuer in the structured authentication config.
+// The referenced object and key must exist unless the reference is optional.
+func ResolveOIDCConfigReferences(ctx context.Context, kcpClient client.Client, namespace string, references *imv1.OIDCConfigReferences, oidcConfig gardener.OIDCConfig) (gardener.OIDCConfig, error) {
+	if references == nil {
+		return oidcConfig, nil
+	}
+
+	if references.CABundle != nil {
+		caBundle, found, err := getConfigMapValue(ctx, kcpClient, namespace, *references.CABundle)
+		if err != nil {
+			return gardener.OIDCConfig{}, err
+		}
+
+		if found {
+			oidcConfig.CABundle = ptr.To(caBundle)
+		}
+	}
+
+	return oidcConfig, nil
+}
+
+func getConfigMapValue(ctx context.Context, kcpClient client.Client, namespace string, selector v1.ConfigMapKeySelector) (string, bool, error) {
+	optional := ptr.Deref(selector.Optional, false)
+
+	var configMap v1.ConfigMap
+	err := kcpClient.Get(ctx, types.NamespacedName{Name: selector.Name, Namespace: namespace}, &configMap)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if optional {
+				return "", false, nil
+			}
+			return "", false, fmt.Errorf("%w: failed to get config map %s referenced in OIDC config: %w", ErrInvalidOIDCConfigReference, selector.Name, err)
+		}
+		return "", false, fmt.Errorf("failed to get config map %s referenced in OIDC config: %w", selector.Name, err)
+	}
+
+	value, found := configMap.Data[selector.Key]
+	if !found && !optional {
+		return "", false, fmt.Errorf("%w: key %s not found in config map %s referenced in OIDC config", ErrInvalidOIDCConfigReference, selector.Key, selector.Name)
+	}
+
+	return value, found, nil
+}
@@ -0,0 +1,151 @@
+package structuredauth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
+)
+
+func TestResolveOIDCConfigReferences(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	oidcConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "oidc-ca", Namespace: "kcp-system"},
+		Data:       map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----"},
+	}
+
+	oidcConfig := gardener.OIDCConfig{
+		ClientID:  ptr.To("client-id"),
+		IssuerURL: ptr.To("https://issuer.example.com"),
+		CABundle:  ptr.To("inline-ca"),
+	}
+
+	for tname, tc := range map[string]struct {
+		objects            []client.Object
+		references         *imv1.OIDCConfigReferences
+		expectedOIDCConfig gardener.OIDCConfig
+		expectedError      string
+		invalidReference   bool
+	}{
+		"Should return the OIDC config unchanged without references": {
+			expectedOIDCConfig: oidcConfig,
+		},
+		"Should resolve the CA bundle": {
+			objects: []client.Object{oidcConfigMap},
+			references: &imv1.OIDCConfigReferences{
+				CABundle: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "oidc-ca"}, Key: "ca.crt"},
+			},
+			expectedOIDCConfig: gardener.OIDCConfig{
+				ClientID:  ptr.To("client-id"),
+				IssuerURL: ptr.To("https://issuer.example.com"),
+				CABundle:  ptr.To("-----BEGIN CERTIFICATE-----"),
+			},
+		},
+		"Should skip missing optional reference": {
+			references: &imv1.OIDCConfigReferences{
+				CABundle: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "oidc-ca"}, Key: "ca.crt", Optional: ptr.To(true)},
+			},
+			expectedOIDCConfig: oidcConfig,
+		},
+		"Should fail for missing config map": {
+			references: &imv1.OIDCConfigReferences{
+				CABundle: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "oidc-ca"}, Key: "ca.crt"},
+			},
+			expectedError:    "failed to get config map oidc-ca referenced in OIDC config",
+			invalidReference: true,
+		},
+		"Should fail for missing key in config map": {
+			objects: []client.Object{oidcConfigMap},
+			references: &imv1.OIDCConfigReferences{
+				CABundle: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "oidc-ca"}, Key: "tls.crt"},
+			},
+			expectedError:    "key tls.crt not found in config map oidc-ca referenced in OIDC config",
+			invalidReference: true,
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			kcpClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build()
+
+			// when
+			resolved, err := ResolveOIDCConfigReferences(context.Background(), kcpClient, "kcp-system", tc.references, oidcConfig)
+
+			// then
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+				assert.Equal(t, tc.invalidReference, errors.Is(err, ErrInvalidOIDCConfigReference))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedOIDCConfig, resolved)
+		})
+	}
+}
+
+func TestResolveOIDCConfigReferencesWithFailingClient(t *testing.T) {
+	// given
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	kcpClient := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+			return k8serrors.NewServiceUnavailable("test unavailable")
+		},
+	}).Build()
+
+	// when
+	_, err := ResolveOIDCConfigReferences(context.Background(), kcpClient, "kcp-system", &imv1.OIDCConfigReferences{
+		CABundle: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "oidc-ca"}, Key: "ca.crt", Optional: ptr.To(true)},
+	}, gardener.OIDCConfig{})
+
+	// then
+	require.ErrorContains(t, err, "test unavailable")
+	assert.False(t, errors.Is(err, ErrInvalidOIDCConfigReference))
+}
+
+func TestAuthenticationConfigurationWithReferencedCABundle(t *testing.T) {
+	// given
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	kcpClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "oidc-ca", Namespace: "kcp-system"},
+		Data:       map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----"},
+	}).Build()
+
+	oidcConfig, err := ResolveOIDCConfigReferences(context.Background(), kcpClient, "kcp-system", &imv1.OIDCConfigReferences{
+		CABundle: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "oidc-ca"}, Key: "ca.crt"},
+	}, gardener.OIDCConfig{
+		ClientID:  ptr.To("client-id"),
+		IssuerURL: ptr.To("https://issuer.example.com"),
+	})
+	require.NoError(t, err)
+
+	// when
+	authenticationConfig, err := toAuthenticationConfiguration(oidcConfig)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, authenticationConfig.JWT, 1)
+	assert.Equal(t, "-----BEGIN CERTIFICATE-----", authenticationConfig.JWT[0].Issuer.CertificateAuthority)
+
+	configBytes, err := yaml.Marshal(authenticationConfig)
+	require.NoError(t, err)
+	assert.Contains(t, string(configBytes), "certificateAuthority: '-----BEGIN CERTIFICATE-----'")
+}
@@ -1,8 +1,44 @@
 package reconciler
 
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
 const (
-	ForceReconcileAnnotation   = "operator.kyma-project.io/force-patch-reconciliation"
-	SuspendReconcileAnnotation = "operator.kyma-project.io/suspend-patch-reconciliation"
+	ForceReconcileAnnotation     = "operator.kyma-project.io/force-patch-reconciliation"
+	SuspendReconcileAnnotation   = "operator.kyma-project.io/suspend-patch-reconciliation"
+	ReconcileNowAnnotation       = "operator.kyma-project.io/reconcile-now"
+	DeletionProtectionAnnotation = "operator.kyma-project.io/deletion-protection"
+	RequeueSecondsAnnotation     = "operator.kyma-project.io/requeue-seconds"
+	RotateCredentialsAnnotation  = "operator.kyma-project.io/rotate-credentials"
+
+	DisableDefaultNetworkPoliciesAnnotation = "operator.kyma-project.io/disable-default-network-policies"
+)
+
+// Gardener operations which can be requested with the rotate-credentials annotation
+var credentialsRotationOperations = []string{ //nolint:gochecknoglobals
+	v1beta1constants.OperationRotateCredentialsStart,
+	v1beta1constants.OperationRotateCredentialsStartWithoutWorkersRollout,
+	v1beta1constants.OperationRotateCredentialsComplete,
+	v1beta1constants.OperationRotateCAStart,
+	v1beta1constants.OperationRotateCAStartWithoutWorkersRollout,
+	v1beta1constants.OperationRotateCAComplete,
+	v1beta1constants.OperationRotateServiceAccountKeyStart,
+	v1beta1constants.OperationRotateServiceAccountKeyStartWithoutWorkersRollout,
+	v1beta1constants.OperationRotateServiceAccountKeyComplete,
+	v1beta1constants.OperationRotateETCDEncryptionKeyStart,
+	v1beta1constants.OperationRotateETCDEncryptionKeyComplete,
+}
+
+// Bounds of the requeue duration which can be set with the requeue-seconds annotation
+const (
+	MinRequeueSeconds = 1
+	MaxRequeueSeconds = 600
 )
 
 func ShouldSuspendReconciliation(annotations map[string]string) bool {
@@ -20,3 +56,56 @@ func ShouldForceReconciliation(annotations map[string]string) bool {
 	}
 	return false
 }
+
+// ShouldReconcileNow returns true when the reconcile-now annotation is present, regardless of its value
+func ShouldReconcileNow(annotations map[string]string) bool {
+	_, found := annotations[ReconcileNowAnnotation]
+	return found
+}
+
+// IsDeletionProtected returns true when the deletion-protection annotation is set to `true`
+func IsDeletionProtected(annotations map[string]string) bool {
+	deletionProtection, found := annotations[DeletionProtectionAnnotation]
+	return found && deletionProtection == "true"
+}
+
+// RequeueDurationOverride returns the requeue duration set with the requeue-seconds annotation.
+// The second value is false when the annotation is not present, an error is returned when the value is not a number of seconds within bounds.
+func RequeueDurationOverride(annotations map[string]string) (time.Duration, bool, error) {
+	requeueSeconds, found := annotations[RequeueSecondsAnnotation]
+	if !found {
+		return 0, false, nil
+	}
+
+	seconds, err := strconv.Atoi(requeueSeconds)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid value %q of %s annotation: %w", requeueSeconds, RequeueSecondsAnnotation, err)
+	}
+
+	if seconds < MinRequeueSeconds || seconds > MaxRequeueSeconds {
+		return 0, false, fmt.Errorf("value %d of %s annotation must be between %d and %d", seconds, RequeueSecondsAnnotation, MinRequeueSeconds, MaxRequeueSeconds)
+	}
+
+	return time.Duration(seconds) * time.Second, true, nil
+}
+
+// ShouldDisableDefaultNetworkPolicies returns true when the disable-default-network-policies annotation is set to `true`
+func ShouldDisableDefaultNetworkPolicies(annotations map[string]string) bool {
+	disableNetworkPolicies, found := annotations[DisableDefaultNetworkPoliciesAnnotation]
+	return found && disableNetworkPolicies == "true"
+}
+
+// CredentialsRotationOperation returns the Gardener operation requested with the rotate-credentials annotation.
+// The second value is false when the annotation is not present, an error is returned when the value is not a credentials rotation operation.
+func CredentialsRotationOperation(annotations map[string]string) (string, bool, error) {
+	operation, found := annotations[RotateCredentialsAnnotation]
+	if !found {
+		return "", false, nil
+	}
+
+	if !slices.Contains(credentialsRotationOperations, operation) {
+		return "", false, fmt.Errorf("invalid value %q of %s annotation, allowed values: %v", operation, RotateCredentialsAnnotation, credentialsRotationOperations)
+	}
+
+	return operation, true, nil
+}
@@ -3,6 +3,7 @@ package reconciler
 import (
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestShouldForceReconciliation(t *testing.T) {
@@ -72,3 +73,211 @@ func TestShouldSuspendReconciliation(t *testing.T) {
 		})
 	}
 }
+
+func TestShouldReconcileNow(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult bool
+	}{
+		{
+			name:           "Should reconcile now for `operator.kyma-project.io/reconcile-now` set to `true`",
+			annotations:    map[string]string{"operator.kyma-project.io/reconcile-now": "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should reconcile now for `operator.kyma-project.io/reconcile-now` with empty value",
+			annotations:    map[string]string{"operator.kyma-project.io/reconcile-now": ""},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not reconcile now for nil annotations",
+			annotations:    nil,
+			expectedResult: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+
+			// when
+			reconcileNow := ShouldReconcileNow(testCase.annotations)
+
+			// then
+			assert.Equal(t, testCase.expectedResult, reconcileNow)
+		})
+	}
+}
+
+func TestIsDeletionProtected(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult bool
+	}{
+		{
+			name:           "Should protect against deletion for `operator.kyma-project.io/deletion-protection` set to `true`",
+			annotations:    map[string]string{"operator.kyma-project.io/deletion-protection": "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not protect against deletion for `operator.kyma-project.io/deletion-protection` set to `false`",
+			annotations:    map[string]string{"operator.kyma-project.io/deletion-protection": "false"},
+			expectedResult: false,
+		},
+		{
+			name:           "Should not protect against deletion for nil annotations",
+			annotations:    nil,
+			expectedResult: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+
+			// when
+			deletionProtected := IsDeletionProtected(testCase.annotations)
+
+			// then
+			assert.Equal(t, testCase.expectedResult, deletionProtected)
+		})
+	}
+}
+
+func TestRequeueDurationOverride(t *testing.T) {
+	for _, testCase := range []struct {
+		name             string
+		annotations      map[string]string
+		expectedDuration time.Duration
+		expectedFound    bool
+		expectError      bool
+	}{
+		{
+			name:             "Should override requeue duration for `operator.kyma-project.io/requeue-seconds` set to `5`",
+			annotations:      map[string]string{"operator.kyma-project.io/requeue-seconds": "5"},
+			expectedDuration: 5 * time.Second,
+			expectedFound:    true,
+		},
+		{
+			name:             "Should override requeue duration for `operator.kyma-project.io/requeue-seconds` set to the maximum",
+			annotations:      map[string]string{"operator.kyma-project.io/requeue-seconds": "600"},
+			expectedDuration: 10 * time.Minute,
+			expectedFound:    true,
+		},
+		{
+			name:        "Should return error for `operator.kyma-project.io/requeue-seconds` set to `0`",
+			annotations: map[string]string{"operator.kyma-project.io/requeue-seconds": "0"},
+			expectError: true,
+		},
+		{
+			name:        "Should return error for `operator.kyma-project.io/requeue-seconds` above the maximum",
+			annotations: map[string]string{"operator.kyma-project.io/requeue-seconds": "601"},
+			expectError: true,
+		},
+		{
+			name:        "Should return error for `operator.kyma-project.io/requeue-seconds` set to `kaloryfer`",
+			annotations: map[string]string{"operator.kyma-project.io/requeue-seconds": "kaloryfer"},
+			expectError: true,
+		},
+		{
+			name:        "Should not override requeue duration for nil annotations",
+			annotations: nil,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+
+			// when
+			duration, found, err := RequeueDurationOverride(testCase.annotations)
+
+			// then
+			if testCase.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.expectedFound, found)
+			assert.Equal(t, testCase.expectedDuration, duration)
+		})
+	}
+}
+
+func TestShouldDisableDefaultNetworkPolicies(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		annotations    map[string]string
+		expectedResult bool
+	}{
+		{
+			name:           "Should disable default network policies for `operator.kyma-project.io/disable-default-network-policies` set to `true`",
+			annotations:    map[string]string{"operator.kyma-project.io/disable-default-network-policies": "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not disable default network policies for `operator.kyma-project.io/disable-default-network-policies` set to `false`",
+			annotations:    map[string]string{"operator.kyma-project.io/disable-default-network-policies": "false"},
+			expectedResult: false,
+		},
+		{
+			name:           "Should not disable default network policies for nil annotations",
+			annotations:    nil,
+			expectedResult: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+
+			// when
+			disabled := ShouldDisableDefaultNetworkPolicies(testCase.annotations)
+
+			// then
+			assert.Equal(t, testCase.expectedResult, disabled)
+		})
+	}
+}
+
+func TestCredentialsRotationOperation(t *testing.T) {
+	for _, testCase := range []struct {
+		name              string
+		annotations       map[string]string
+		expectedOperation string
+		expectedFound     bool
+		expectError       bool
+	}{
+		{
+			name:              "Should return operation for `operator.kyma-project.io/rotate-credentials` set to `rotate-credentials-start`",
+			annotations:       map[string]string{"operator.kyma-project.io/rotate-credentials": "rotate-credentials-start"},
+			expectedOperation: "rotate-credentials-start",
+			expectedFound:     true,
+		},
+		{
+			name:              "Should return operation for `operator.kyma-project.io/rotate-credentials` set to `rotate-ca-complete`",
+			annotations:       map[string]string{"operator.kyma-project.io/rotate-credentials": "rotate-ca-complete"},
+			expectedOperation: "rotate-ca-complete",
+			expectedFound:     true,
+		},
+		{
+			name:        "Should return error for `operator.kyma-project.io/rotate-credentials` set to `reconcile`",
+			annotations: map[string]string{"operator.kyma-project.io/rotate-credentials": "reconcile"},
+			expectError: true,
+		},
+		{
+			name:        "Should not return operation for nil annotations",
+			annotations: nil,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+
+			// when
+			operation, found, err := CredentialsRotationOperation(testCase.annotations)
+
+			// then
+			if testCase.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.expectedFound, found)
+			assert.Equal(t, testCase.expectedOperation, operation)
+		})
+	}
+}